@@ -0,0 +1,900 @@
+package ci
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+var (
+	// ErrDeviceClosed represents an inquiry made on, or interrupted by, a closed Device.
+	ErrDeviceClosed error = errors.New("MIDI-CI device closed")
+
+	// ErrNAK represents an inquiry that the remote device rejected with a NAK message.
+	ErrNAK error = errors.New("MIDI-CI inquiry rejected")
+
+	// ErrPropertyStatus represents a Property Exchange reply without a successful status.
+	ErrPropertyStatus error = errors.New("MIDI-CI property inquiry failed")
+)
+
+const (
+	// DefaultMaxSystemExclusiveSize represents the size of the largest System Exclusive message that a Device receives,
+	// unless WithMaxSystemExclusiveSize is used.
+	DefaultMaxSystemExclusiveSize uint32 = 512
+)
+
+// replySubIDs maps the sub-ID #2 of every inquiry that a Device sends to the sub-ID #2 of the message that answers it.
+var replySubIDs = map[byte]byte{
+	DiscoverySubID:                    DiscoveryReplySubID,
+	ProfileInquirySubID:               ProfileInquiryReplySubID,
+	SetProfileOnSubID:                 ProfileEnabledReportSubID,
+	SetProfileOffSubID:                ProfileDisabledReportSubID,
+	PropertyExchangeCapabilitiesSubID: PropertyExchangeCapabilitiesReplySubID,
+	byte(GetPropertyTransaction):      byte(GetPropertyReplyTransaction),
+	byte(SetPropertyTransaction):      byte(SetPropertyReplyTransaction),
+	byte(SubscriptionTransaction):     byte(SubscriptionReplyTransaction),
+}
+
+// Remote represents a MIDI-CI device that replied to a Discovery message, or sent one.
+type Remote struct {
+	// MUID represents the MUID of the remote device.
+	MUID MUID
+
+	// Identity represents the identity of the remote device.
+	Identity Identity
+
+	// Category represents the MIDI-CI features that the remote device supports.
+	Category Category
+
+	// MaxSystemExclusiveSize represents the size of the largest System Exclusive message that the remote device receives.
+	MaxSystemExclusiveSize uint32
+}
+
+// Property represents a custom Property Exchange resource of a Device.
+type Property struct {
+	// Entry represents the entry of the resource in the ResourceList resource. The CanSet field is filled in from Set when
+	// it is empty.
+	Entry ResourceListEntry
+
+	// Get returns the body of the resource. A nil Get makes the resource unreadable. The body must be 7-bit data, such as
+	// JSON from MarshalPropertyData, or the inquiry is answered with StatusInternalError.
+	Get func(header RequestHeader) ([]byte, error)
+
+	// Set replaces the body of the resource. A nil Set makes the resource read-only.
+	Set func(header RequestHeader, body []byte) error
+}
+
+// waiterKey identifies the reply that an inquiry is waiting for.
+type waiterKey struct {
+	source    MUID
+	subID     byte
+	requestID byte
+}
+
+// Device represents a MIDI-CI device that acts as both an Initiator and a Responder over a byte transport, such as a
+// serial port or an in-memory pipe.
+//
+// Serve must be running for the Device to answer inquiries from other devices or to receive the replies to its own.
+// Messages are written by a separate goroutine, so a Device never blocks Serve on a transport that only accepts writes
+// while the other end is reading. A Device is safe for concurrent use.
+type Device struct {
+	// rw is the transport that messages are read from and written to
+	rw io.ReadWriter
+
+	// deviceID is the device ID that inquiries are addressed to
+	deviceID midiv1.DeviceID
+
+	// identity is the identity sent in Discovery messages and the DeviceInfo resource
+	identity Identity
+
+	// info is the DeviceInfo resource served to other devices
+	info DeviceInfo
+
+	// maxSize is the largest System Exclusive message, in bytes, that the Device accepts
+	maxSize uint32
+
+	// mu guards every field below it, and is the lock of queueCond
+	mu sync.Mutex
+
+	// random is the source of random numbers for new MUIDs
+	random *rand.Rand
+
+	// muid is the current MUID, which changes when another device is found with the same MUID
+	muid MUID
+
+	// profiles holds the supported profiles and whether each is enabled
+	profiles map[ProfileID]bool
+
+	// properties holds the Property Exchange resources served to other devices, by resource name
+	properties map[string]Property
+
+	// remotes holds the devices found by Discovery, by MUID
+	remotes map[MUID]Remote
+
+	// waiters holds the channels of the inquiries that are waiting for a reply
+	waiters map[waiterKey]chan Message
+
+	// requestID is the Property Exchange request ID of the next inquiry, which wraps back to 0 after 127
+	requestID byte
+
+	// assembler collects the chunks of Property Exchange inquiries and replies from every remote device
+	assembler *PropertyAssembler
+
+	// queue holds the raw messages that are waiting to be written, oldest first
+	queue [][]byte
+
+	// queueCond wakes the writer goroutine when messages are queued or the Device is closed
+	queueCond *sync.Cond
+
+	// closed is whether the Device has been closed
+	closed bool
+
+	// done is closed when the Device is closed, to release the inquiries that are waiting for a reply
+	done chan struct{}
+}
+
+// DeviceOption represents a functional option for configuring a Device.
+type DeviceOption func(*Device)
+
+// WithMUID sets the MUID of the Device instead of a random one.
+func WithMUID(muid MUID) DeviceOption {
+	return func(d *Device) {
+		d.muid = muid
+	}
+}
+
+// WithRandom sets the source of random numbers for the MUIDs of the Device.
+func WithRandom(random *rand.Rand) DeviceOption {
+	return func(d *Device) {
+		d.random = random
+	}
+}
+
+// WithIdentity sets the identity that the Device sends in Discovery messages and the DeviceInfo resource.
+func WithIdentity(identity Identity) DeviceOption {
+	return func(d *Device) {
+		d.identity = identity
+	}
+}
+
+// WithDeviceInfo sets the names that the Device serves in the DeviceInfo resource. Its IDs are replaced with those of
+// the identity of the Device.
+func WithDeviceInfo(info DeviceInfo) DeviceOption {
+	return func(d *Device) {
+		d.info = info
+	}
+}
+
+// WithDeviceID sets the device ID that the Device sends its messages with, which is FunctionBlockDeviceID by default.
+func WithDeviceID(deviceID midiv1.DeviceID) DeviceOption {
+	return func(d *Device) {
+		d.deviceID = deviceID
+	}
+}
+
+// WithMaxSystemExclusiveSize sets the size of the largest System Exclusive message that the Device receives.
+func WithMaxSystemExclusiveSize(size uint32) DeviceOption {
+	return func(d *Device) {
+		d.maxSize = size
+	}
+}
+
+// WithProfile adds a Profile that other devices can enable and disable on the Device.
+func WithProfile(profile ProfileID, enabled bool) DeviceOption {
+	return func(d *Device) {
+		d.profiles[profile] = enabled
+	}
+}
+
+// WithProperty adds a custom Property Exchange resource to the Device.
+func WithProperty(property Property) DeviceOption {
+	return func(d *Device) {
+		d.properties[property.Entry.Resource] = property
+	}
+}
+
+// NewDevice returns a new Device that reads and writes MIDI-CI messages over rw. The Device supports Property Exchange,
+// and Profile Configuration when it has any Profiles.
+func NewDevice(rw io.ReadWriter, options ...DeviceOption) *Device {
+	d := &Device{
+		rw:         rw,
+		deviceID:   FunctionBlockDeviceID,
+		maxSize:    DefaultMaxSystemExclusiveSize,
+		muid:       BroadcastMUID,
+		profiles:   map[ProfileID]bool{},
+		properties: map[string]Property{},
+		remotes:    map[MUID]Remote{},
+		waiters:    map[waiterKey]chan Message{},
+		assembler:  NewPropertyAssembler(),
+		done:       make(chan struct{}),
+	}
+	d.queueCond = sync.NewCond(&d.mu)
+	for _, option := range options {
+		option(d)
+	}
+	if d.random == nil {
+		d.random = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	if d.muid > MaxMUID {
+		d.muid = NewRandomMUID(d.random)
+	}
+	d.info.SetIdentity(d.identity)
+
+	go d.write()
+	return d
+}
+
+// MUID returns the current MUID of the Device, which changes when another device is found with the same MUID.
+func (d *Device) MUID() MUID {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.muid
+}
+
+// Category returns the MIDI-CI features that the Device supports.
+func (d *Device) Category() Category {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.category()
+}
+
+// category returns the MIDI-CI features that the Device supports. The caller must hold the lock.
+func (d *Device) category() Category {
+	category := PropertyExchangeCategory
+	if len(d.profiles) > 0 {
+		category |= ProfileConfigurationCategory
+	}
+	return category
+}
+
+// Remotes returns the remote devices that the Device knows about, in order of MUID.
+func (d *Device) Remotes() []Remote {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	remotes := make([]Remote, 0, len(d.remotes))
+	for _, remote := range d.remotes {
+		remotes = append(remotes, remote)
+	}
+	sort.Slice(remotes, func(i, j int) bool {
+		return remotes[i].MUID < remotes[j].MUID
+	})
+	return remotes
+}
+
+// Profiles returns whether each Profile of the Device is enabled.
+func (d *Device) Profiles() map[ProfileID]bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	profiles := make(map[ProfileID]bool, len(d.profiles))
+	for profile, enabled := range d.profiles {
+		profiles[profile] = enabled
+	}
+	return profiles
+}
+
+// Close stops the Device from writing messages and interrupts any inquiries in progress. The transport is not closed,
+// so closing it remains the way to stop Serve.
+func (d *Device) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.closed {
+		d.closed = true
+		close(d.done)
+		d.queueCond.Broadcast()
+	}
+	return nil
+}
+
+// Serve reads MIDI-CI messages from the transport and handles them until the transport ends, returning nil when it ends
+// between messages. Malformed messages, messages other than MIDI-CI messages and System Exclusive messages larger than
+// the size set by WithMaxSystemExclusiveSize are skipped.
+func (d *Device) Serve() error {
+	reader := midiv1.NewReader(d.rw, midiv1.WithMaxSystemExclusiveSize(int(d.maxSize)))
+	for {
+		message, err := reader.ReadMessage()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if errors.Is(err, midiv1.ErrUnmarshallingMessage) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		sem, ok := message.(*midiv1.SystemExclusiveMessage)
+		if !ok {
+			continue
+		}
+		b, err := sem.MarshalMIDI()
+		if err != nil || !IsMessage(b) {
+			continue
+		}
+		d.handleMessage(b)
+	}
+}
+
+// Discover broadcasts a Discovery message and returns the remote devices that replied before the context is done.
+func (d *Device) Discover(ctx context.Context) ([]Remote, error) {
+	d.mu.Lock()
+	message := DiscoveryMessage{
+		Header:                 d.header(BroadcastMUID),
+		Identity:               d.identity,
+		Category:               d.category(),
+		MaxSystemExclusiveSize: d.maxSize,
+	}
+	d.mu.Unlock()
+	if err := d.send(message); err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-ctx.Done():
+	case <-d.done:
+		return nil, ErrDeviceClosed
+	}
+	return d.Remotes(), nil
+}
+
+// Invalidate broadcasts an Invalidate MUID message for the target MUID and forgets the remote device with that MUID.
+// Invalidating the MUID of the Device itself also gives the Device a new random MUID, as a device does before it
+// disconnects or restarts discovery.
+func (d *Device) Invalidate(target MUID) error {
+	d.mu.Lock()
+	message := InvalidateMUIDMessage{Header: d.header(BroadcastMUID), Target: target}
+	delete(d.remotes, target)
+	if target == d.muid {
+		d.remotes = map[MUID]Remote{}
+		d.muid = d.newMUID()
+	}
+	d.mu.Unlock()
+	return d.send(message)
+}
+
+// ProfileInquiry asks the remote device which Profiles it supports and returns its enabled and disabled Profiles.
+func (d *Device) ProfileInquiry(ctx context.Context, destination MUID) ([]ProfileID, []ProfileID, error) {
+	d.mu.Lock()
+	message := ProfileInquiryMessage{Header: d.header(destination)}
+	d.mu.Unlock()
+	reply, err := d.request(ctx, waiterKey{source: destination, subID: ProfileInquiryReplySubID}, message)
+	if err != nil {
+		return nil, nil, err
+	}
+	profileReply := reply.(*ProfileInquiryReplyMessage)
+	return profileReply.Enabled, profileReply.Disabled, nil
+}
+
+// SetProfile asks the remote device to enable or disable a Profile and waits for the report that it has.
+func (d *Device) SetProfile(ctx context.Context, destination MUID, profile ProfileID, enabled bool) error {
+	d.mu.Lock()
+	header := d.header(destination)
+	d.mu.Unlock()
+
+	var message MessageMarshaler = SetProfileOffMessage{Header: header, Profile: profile}
+	key := waiterKey{source: destination, subID: ProfileDisabledReportSubID}
+	if enabled {
+		message = SetProfileOnMessage{Header: header, Profile: profile}
+		key.subID = ProfileEnabledReportSubID
+	}
+	_, err := d.request(ctx, key, message)
+	return err
+}
+
+// PropertyExchangeCapabilities asks the remote device how many Property Exchange inquiries it can handle at once.
+func (d *Device) PropertyExchangeCapabilities(ctx context.Context, destination MUID) (byte, error) {
+	d.mu.Lock()
+	message := PropertyExchangeCapabilitiesMessage{
+		Header:               d.header(destination),
+		SimultaneousRequests: 1,
+		MajorVersion:         PropertyExchangeMajorVersion,
+		MinorVersion:         PropertyExchangeMinorVersion,
+	}
+	d.mu.Unlock()
+	reply, err := d.request(ctx, waiterKey{source: destination, subID: PropertyExchangeCapabilitiesReplySubID}, message)
+	if err != nil {
+		return 0, err
+	}
+	return reply.(*PropertyExchangeCapabilitiesReplyMessage).SimultaneousRequests, nil
+}
+
+// GetProperty asks the remote device for the body of a resource. A reply header without StatusOK is returned with
+// ErrPropertyStatus.
+func (d *Device) GetProperty(ctx context.Context, destination MUID, header RequestHeader) (ReplyHeader, []byte, error) {
+	return d.propertyInquiry(ctx, destination, GetPropertyTransaction, header, nil)
+}
+
+// SetProperty asks the remote device to replace the body of a resource. A reply header without StatusOK is returned
+// with ErrPropertyStatus.
+func (d *Device) SetProperty(ctx context.Context, destination MUID, header RequestHeader, body []byte) (ReplyHeader, error) {
+	replyHeader, _, err := d.propertyInquiry(ctx, destination, SetPropertyTransaction, header, body)
+	return replyHeader, err
+}
+
+// GetResourceList returns the ResourceList resource of the remote device.
+func (d *Device) GetResourceList(ctx context.Context, destination MUID) ([]ResourceListEntry, error) {
+	_, body, err := d.GetProperty(ctx, destination, RequestHeader{Resource: ResourceListResource})
+	if err != nil {
+		return nil, err
+	}
+	var entries []ResourceListEntry
+	if err := json.Unmarshal(body, &entries); err != nil {
+		return nil, fmt.Errorf("%s resource could not be unmarshalled (%v): %w", ResourceListResource, err, ErrUnmarshallingMessage)
+	}
+	return entries, nil
+}
+
+// GetDeviceInfo returns the DeviceInfo resource of the remote device.
+func (d *Device) GetDeviceInfo(ctx context.Context, destination MUID) (DeviceInfo, error) {
+	_, body, err := d.GetProperty(ctx, destination, RequestHeader{Resource: DeviceInfoResource})
+	if err != nil {
+		return DeviceInfo{}, err
+	}
+	var info DeviceInfo
+	if err := json.Unmarshal(body, &info); err != nil {
+		return DeviceInfo{}, fmt.Errorf("%s resource could not be unmarshalled (%v): %w", DeviceInfoResource, err, ErrUnmarshallingMessage)
+	}
+	return info, nil
+}
+
+// propertyInquiry sends a Property Exchange inquiry in as many chunks as the remote device can receive and returns the
+// reply.
+func (d *Device) propertyInquiry(ctx context.Context, destination MUID, transaction PropertyTransaction, header RequestHeader, body []byte) (ReplyHeader, []byte, error) {
+	headerData, err := MarshalPropertyData(header)
+	if err != nil {
+		return ReplyHeader{}, nil, err
+	}
+
+	d.mu.Lock()
+	requestID := d.requestID
+	d.requestID = (d.requestID + 1) & MaxPropertyRequestID
+	chunks, err := NewPropertyDataMessages(d.header(destination), transaction, requestID, headerData, body, d.remoteMaxSize(destination))
+	d.mu.Unlock()
+	if err != nil {
+		return ReplyHeader{}, nil, err
+	}
+
+	messages := make([]MessageMarshaler, len(chunks))
+	for i := range chunks {
+		messages[i] = chunks[i]
+	}
+	key := waiterKey{source: destination, subID: replySubIDs[byte(transaction)], requestID: requestID}
+	reply, err := d.request(ctx, key, messages...)
+	if err != nil {
+		return ReplyHeader{}, nil, err
+	}
+
+	data := reply.(*PropertyDataMessage)
+	var replyHeader ReplyHeader
+	if err := json.Unmarshal(data.HeaderData, &replyHeader); err != nil {
+		return ReplyHeader{}, nil, fmt.Errorf("%s header could not be unmarshalled (%v): %w", data.GetMessageName(), err, ErrUnmarshallingMessage)
+	}
+	if replyHeader.Status != StatusOK {
+		return replyHeader, data.Data, fmt.Errorf("%s resource returned status %d %q: %w", header.Resource, replyHeader.Status, replyHeader.Message, ErrPropertyStatus)
+	}
+	return replyHeader, data.Data, nil
+}
+
+// request registers a waiter for the reply, sends the messages and waits for the reply, a NAK message, the context to
+// be done or the Device to be closed.
+func (d *Device) request(ctx context.Context, key waiterKey, messages ...MessageMarshaler) (Message, error) {
+	reply := make(chan Message, 1)
+	d.mu.Lock()
+	d.waiters[key] = reply
+	d.mu.Unlock()
+	defer func() {
+		d.mu.Lock()
+		if d.waiters[key] == reply {
+			delete(d.waiters, key)
+		}
+		d.mu.Unlock()
+	}()
+
+	if err := d.send(messages...); err != nil {
+		return nil, err
+	}
+	select {
+	case message := <-reply:
+		if nak, ok := message.(*NAKMessage); ok {
+			return nil, fmt.Errorf("%s rejected the inquiry with status %#x %q: %w", nak.Source, byte(nak.Status), nak.Text, ErrNAK)
+		}
+		return message, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-d.done:
+		return nil, ErrDeviceClosed
+	}
+}
+
+// deliver hands the message to the inquiry that is waiting for it, if any. NAK messages are handed to every inquiry
+// that is waiting for a reply from their source to the rejected message.
+func (d *Device) deliver(message Message) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for key, reply := range d.waiters {
+		if !waiterMatches(key, message) {
+			continue
+		}
+		delete(d.waiters, key)
+		reply <- message
+	}
+}
+
+// waiterMatches returns whether the message is the reply that the waiter is waiting for.
+func waiterMatches(key waiterKey, message Message) bool {
+	if key.source != message.GetHeader().Source {
+		return false
+	}
+	switch m := message.(type) {
+	case *NAKMessage:
+		return replySubIDs[m.OriginalSubID] == key.subID
+	case *PropertyDataMessage:
+		return byte(m.Transaction) == key.subID && m.RequestID == key.requestID
+	case *ProfileInquiryReplyMessage:
+		return key.subID == ProfileInquiryReplySubID
+	case *ProfileEnabledReportMessage:
+		return key.subID == ProfileEnabledReportSubID
+	case *ProfileDisabledReportMessage:
+		return key.subID == ProfileDisabledReportSubID
+	case *PropertyExchangeCapabilitiesReplyMessage:
+		return key.subID == PropertyExchangeCapabilitiesReplySubID
+	}
+	return false
+}
+
+// handleMessage handles the raw bytes of a MIDI-CI message that is addressed to the Device or broadcast.
+func (d *Device) handleMessage(b []byte) {
+	message, err := Unmarshal(b)
+	if errors.Is(err, ErrUnsupportedMessage) {
+		d.rejectUnsupported(b)
+		return
+	}
+	if err != nil {
+		return
+	}
+
+	header := message.GetHeader()
+	d.mu.Lock()
+	muid := d.muid
+	d.mu.Unlock()
+	if discovery, ok := message.(*DiscoveryMessage); ok {
+		d.handleDiscovery(discovery)
+		return
+	}
+	if header.Source == muid || (header.Destination != muid && !header.Destination.IsBroadcast()) {
+		return
+	}
+
+	switch m := message.(type) {
+	case *DiscoveryReplyMessage:
+		d.addRemote(Remote{MUID: m.Source, Identity: m.Identity, Category: m.Category, MaxSystemExclusiveSize: m.MaxSystemExclusiveSize})
+	case *InvalidateMUIDMessage:
+		d.mu.Lock()
+		delete(d.remotes, m.Target)
+		d.mu.Unlock()
+	case *ProfileInquiryMessage:
+		d.handleProfileInquiry(m)
+	case *SetProfileOnMessage:
+		d.handleSetProfile(m.Header, SetProfileOnSubID, m.Profile, m.Channels, true)
+	case *SetProfileOffMessage:
+		d.handleSetProfile(m.Header, SetProfileOffSubID, m.Profile, m.Channels, false)
+	case *PropertyExchangeCapabilitiesMessage:
+		d.mu.Lock()
+		reply := PropertyExchangeCapabilitiesReplyMessage{
+			Header:               d.header(m.Source),
+			SimultaneousRequests: 1,
+			MajorVersion:         PropertyExchangeMajorVersion,
+			MinorVersion:         PropertyExchangeMinorVersion,
+		}
+		d.mu.Unlock()
+		_ = d.send(reply)
+	case *PropertyDataMessage:
+		d.handlePropertyData(m)
+	default:
+		d.deliver(message)
+	}
+}
+
+// handleDiscovery replies to a Discovery message. When the Initiator has the same MUID as the Device, the Device first
+// picks a new MUID and broadcasts an Invalidate MUID message for the old one, so that both devices can be told apart.
+func (d *Device) handleDiscovery(m *DiscoveryMessage) {
+	d.mu.Lock()
+	var invalidate *InvalidateMUIDMessage
+	if m.Source == d.muid {
+		old := d.muid
+		for d.muid == old {
+			d.muid = d.newMUID()
+		}
+		invalidate = &InvalidateMUIDMessage{Header: d.header(BroadcastMUID), Target: old}
+	}
+	d.remotes[m.Source] = Remote{MUID: m.Source, Identity: m.Identity, Category: m.Category, MaxSystemExclusiveSize: m.MaxSystemExclusiveSize}
+	reply := DiscoveryReplyMessage{
+		Header:                 d.header(m.Source),
+		Identity:               d.identity,
+		Category:               d.category(),
+		MaxSystemExclusiveSize: d.maxSize,
+		OutputPathID:           m.OutputPathID,
+		FunctionBlock:          0x7F,
+	}
+	d.mu.Unlock()
+
+	if invalidate != nil {
+		_ = d.send(*invalidate)
+	}
+	_ = d.send(reply)
+}
+
+// handleProfileInquiry replies to a Profile Inquiry message with the enabled and disabled Profiles of the Device.
+func (d *Device) handleProfileInquiry(m *ProfileInquiryMessage) {
+	d.mu.Lock()
+	reply := ProfileInquiryReplyMessage{Header: d.header(m.Source), Enabled: []ProfileID{}, Disabled: []ProfileID{}}
+	reply.DeviceID = m.DeviceID
+	for profile, enabled := range d.profiles {
+		if enabled {
+			reply.Enabled = append(reply.Enabled, profile)
+		} else {
+			reply.Disabled = append(reply.Disabled, profile)
+		}
+	}
+	d.mu.Unlock()
+	sortProfileIDs(reply.Enabled)
+	sortProfileIDs(reply.Disabled)
+	_ = d.send(reply)
+}
+
+// handleSetProfile enables or disables a Profile of the Device and broadcasts a report, or rejects the message with a
+// NAK message when the Device does not have the Profile.
+func (d *Device) handleSetProfile(header Header, subID byte, profile ProfileID, channels uint16, enabled bool) {
+	d.mu.Lock()
+	if _, ok := d.profiles[profile]; !ok {
+		nak := d.nak(header, subID, NAKStatusProfileNotFound, "profile not supported")
+		d.mu.Unlock()
+		_ = d.send(nak)
+		return
+	}
+	d.profiles[profile] = enabled
+	reportHeader := d.header(BroadcastMUID)
+	reportHeader.DeviceID = header.DeviceID
+	d.mu.Unlock()
+
+	if enabled {
+		_ = d.send(ProfileEnabledReportMessage{Header: reportHeader, Profile: profile, Channels: channels})
+		return
+	}
+	_ = d.send(ProfileDisabledReportMessage{Header: reportHeader, Profile: profile, Channels: channels})
+}
+
+// handlePropertyData collects the chunks of a Property Exchange data message and then answers the inquiry or hands the
+// reply to the inquiry that is waiting for it.
+func (d *Device) handlePropertyData(m *PropertyDataMessage) {
+	d.mu.Lock()
+	message, complete, err := d.assembler.Add(*m)
+	d.mu.Unlock()
+	if err != nil || !complete {
+		return
+	}
+
+	switch message.Transaction {
+	case GetPropertyTransaction, SetPropertyTransaction, SubscriptionTransaction:
+		replyHeader, body := d.answerProperty(message)
+		if err := d.replyProperty(message, replyHeader, body); err != nil && !errors.Is(err, ErrDeviceClosed) {
+			// the Initiator would otherwise wait for a reply until its context expires
+			_ = d.replyProperty(message, ReplyHeader{Status: StatusInternalError, Message: "reply could not be sent"}, nil)
+		}
+	case NotifyTransaction:
+	default:
+		d.deliver(&message)
+	}
+}
+
+// replyProperty sends the reply to a complete Property Exchange inquiry, split into as many chunks as the Initiator
+// accepts.
+func (d *Device) replyProperty(message PropertyDataMessage, replyHeader ReplyHeader, body []byte) error {
+	headerData, err := MarshalPropertyData(replyHeader)
+	if err != nil {
+		return err
+	}
+	d.mu.Lock()
+	chunks, err := NewPropertyDataMessages(d.header(message.Source), PropertyTransaction(replySubIDs[byte(message.Transaction)]), message.RequestID, headerData, body, d.remoteMaxSize(message.Source))
+	d.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	replies := make([]MessageMarshaler, len(chunks))
+	for i := range chunks {
+		replies[i] = chunks[i]
+	}
+	return d.send(replies...)
+}
+
+// answerProperty returns the reply header and body for a complete Property Exchange inquiry.
+func (d *Device) answerProperty(message PropertyDataMessage) (ReplyHeader, []byte) {
+	var header RequestHeader
+	if err := json.Unmarshal(message.HeaderData, &header); err != nil {
+		return ReplyHeader{Status: StatusBadData, Message: "header is not valid JSON"}, nil
+	}
+	if message.Transaction == SubscriptionTransaction {
+		return ReplyHeader{Status: StatusNotSupported, Message: "subscriptions are not supported"}, nil
+	}
+
+	var get func(RequestHeader) ([]byte, error)
+	var set func(RequestHeader, []byte) error
+	switch header.Resource {
+	case ResourceListResource:
+		get = d.resourceList
+	case DeviceInfoResource:
+		get = func(RequestHeader) ([]byte, error) {
+			return MarshalPropertyData(d.info)
+		}
+	default:
+		d.mu.Lock()
+		property, ok := d.properties[header.Resource]
+		d.mu.Unlock()
+		if !ok {
+			return ReplyHeader{Status: StatusNotFound, Message: fmt.Sprintf("resource %q not found", header.Resource)}, nil
+		}
+		get, set = property.Get, property.Set
+	}
+
+	if message.Transaction == SetPropertyTransaction {
+		if set == nil {
+			return ReplyHeader{Status: StatusNotSupported, Message: fmt.Sprintf("resource %q cannot be set", header.Resource)}, nil
+		}
+		if err := set(header, message.Data); err != nil {
+			return ReplyHeader{Status: StatusBadData, Message: err.Error()}, nil
+		}
+		return ReplyHeader{Status: StatusOK}, nil
+	}
+	if get == nil {
+		return ReplyHeader{Status: StatusNotSupported, Message: fmt.Sprintf("resource %q cannot be read", header.Resource)}, nil
+	}
+	body, err := get(header)
+	if err != nil {
+		return ReplyHeader{Status: StatusInternalError, Message: err.Error()}, nil
+	}
+	for _, b := range body {
+		if midiv1.ByteHasStatusMSB(b) {
+			return ReplyHeader{Status: StatusInternalError, Message: fmt.Sprintf("resource %q is not 7-bit data", header.Resource)}, nil
+		}
+	}
+	return ReplyHeader{Status: StatusOK}, body
+}
+
+// resourceList returns the body of the ResourceList resource, which lists DeviceInfo and every custom resource.
+func (d *Device) resourceList(RequestHeader) ([]byte, error) {
+	d.mu.Lock()
+	entries := make([]ResourceListEntry, 0, len(d.properties)+1)
+	entries = append(entries, ResourceListEntry{Resource: DeviceInfoResource})
+	resources := make([]string, 0, len(d.properties))
+	for resource := range d.properties {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+	for _, resource := range resources {
+		property := d.properties[resource]
+		entry := property.Entry
+		if entry.CanSet == "" && property.Set != nil {
+			entry.CanSet = CanSetFull
+		}
+		if entry.CanGet == nil && property.Get == nil {
+			canGet := false
+			entry.CanGet = &canGet
+		}
+		entries = append(entries, entry)
+	}
+	d.mu.Unlock()
+	return MarshalPropertyData(entries)
+}
+
+// rejectUnsupported replies to a MIDI-CI message that this package does not support with a NAK message, unless the
+// message is broadcast or addressed to another device.
+func (d *Device) rejectUnsupported(b []byte) {
+	header, _, err := unmarshalMessage("MIDI-CI", b[4], b)
+	if err != nil {
+		return
+	}
+	d.mu.Lock()
+	if header.Destination != d.muid || header.Source == d.muid || b[4] == NAKSubID || b[4] == ACKSubID {
+		d.mu.Unlock()
+		return
+	}
+	nak := d.nak(header, b[4], NAKStatusUnsupported, "message not supported")
+	d.mu.Unlock()
+	_ = d.send(nak)
+}
+
+// addRemote records a remote device that replied to a Discovery message. Discover collects the replies from the
+// recorded remote devices once its context is done.
+func (d *Device) addRemote(remote Remote) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.remotes[remote.MUID] = remote
+}
+
+// header returns the header of a message from the Device to the destination. The caller must hold the lock.
+func (d *Device) header(destination MUID) Header {
+	return Header{DeviceID: d.deviceID, Version: Version, Source: d.muid, Destination: destination}
+}
+
+// nak returns a NAK message that rejects the message with the header and sub-ID #2. The caller must hold the lock.
+func (d *Device) nak(header Header, subID byte, status NAKStatus, text string) NAKMessage {
+	reply := NAKMessage{Header: d.header(header.Source), OriginalSubID: subID, Status: status, Text: text}
+	reply.DeviceID = header.DeviceID
+	return reply
+}
+
+// newMUID returns a new random MUID. The caller must hold the lock.
+func (d *Device) newMUID() MUID {
+	return NewRandomMUID(d.random)
+}
+
+// remoteMaxSize returns the size of the largest System Exclusive message that the remote device receives, or the size
+// that the Device itself receives when the remote device is unknown. The caller must hold the lock.
+func (d *Device) remoteMaxSize(muid MUID) int {
+	if remote, ok := d.remotes[muid]; ok && remote.MaxSystemExclusiveSize >= MinSystemExclusiveSize {
+		return int(remote.MaxSystemExclusiveSize)
+	}
+	return int(d.maxSize)
+}
+
+// send marshals the messages and queues them for the writer goroutine.
+func (d *Device) send(messages ...MessageMarshaler) error {
+	raw := make([][]byte, 0, len(messages))
+	for _, message := range messages {
+		b, err := message.MarshalMIDI()
+		if err != nil {
+			return err
+		}
+		raw = append(raw, b)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		return ErrDeviceClosed
+	}
+	d.queue = append(d.queue, raw...)
+	d.queueCond.Signal()
+	return nil
+}
+
+// write writes the queued messages to the transport until the Device is closed.
+func (d *Device) write() {
+	for {
+		d.mu.Lock()
+		for len(d.queue) == 0 && !d.closed {
+			d.queueCond.Wait()
+		}
+		if d.closed {
+			d.mu.Unlock()
+			return
+		}
+		b := d.queue[0]
+		d.queue = d.queue[1:]
+		d.mu.Unlock()
+
+		if _, err := d.rw.Write(b); err != nil {
+			_ = d.Close()
+			return
+		}
+	}
+}
+
+// sortProfileIDs sorts the Profile IDs in byte order.
+func sortProfileIDs(profiles []ProfileID) {
+	sort.Slice(profiles, func(i, j int) bool {
+		for k := range profiles[i] {
+			if profiles[i][k] != profiles[j][k] {
+				return profiles[i][k] < profiles[j][k]
+			}
+		}
+		return false
+	})
+}
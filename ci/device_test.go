@@ -0,0 +1,401 @@
+package ci
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+var (
+	testInitiatorIdentity = Identity{Manufacturer: midiv1.RolandManufacturerID, Family: 1, Model: 2, Version: [4]byte{0, 1, 0, 0}}
+	testResponderIdentity = Identity{Manufacturer: midiv1.YamahaManufacturerID, Family: 3, Model: 4, Version: [4]byte{1, 0, 0, 0}}
+	testProfile           = ProfileID{StandardProfileBank, 0x00, 0x00, 0x01, 0x01}
+)
+
+// newTestDevices returns an Initiator and a Responder that talk over an in-memory pipe until the test ends.
+func newTestDevices(t *testing.T, initiatorOptions []DeviceOption, responderOptions []DeviceOption) (*Device, *Device) {
+	t.Helper()
+	initiatorConn, responderConn := net.Pipe()
+	initiator := NewDevice(initiatorConn, append([]DeviceOption{WithIdentity(testInitiatorIdentity), WithRandom(rand.New(rand.NewSource(1)))}, initiatorOptions...)...)
+	responder := NewDevice(responderConn, append([]DeviceOption{WithIdentity(testResponderIdentity), WithRandom(rand.New(rand.NewSource(2)))}, responderOptions...)...)
+
+	var wg sync.WaitGroup
+	for _, device := range []*Device{initiator, responder} {
+		wg.Add(1)
+		go func(device *Device) {
+			defer wg.Done()
+			_ = device.Serve()
+		}(device)
+	}
+	t.Cleanup(func() {
+		_ = initiator.Close()
+		_ = responder.Close()
+		_ = initiatorConn.Close()
+		_ = responderConn.Close()
+		wg.Wait()
+	})
+	return initiator, responder
+}
+
+// discover runs Discovery from the Initiator and returns the single Responder that it finds.
+func discover(t *testing.T, initiator *Device) Remote {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	remotes, err := initiator.Discover(ctx)
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if len(remotes) != 1 {
+		t.Fatalf("expected 1 remote device, got %d", len(remotes))
+	}
+	return remotes[0]
+}
+
+// testContext returns a context for a single inquiry.
+func testContext(t *testing.T) context.Context {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+func Test_Device_Discover(t *testing.T) {
+	t.Parallel()
+	initiator, responder := newTestDevices(t, nil, []DeviceOption{WithProfile(testProfile, false), WithMaxSystemExclusiveSize(1024)})
+
+	remote := discover(t, initiator)
+	expected := Remote{
+		MUID:                   responder.MUID(),
+		Identity:               testResponderIdentity,
+		Category:               ProfileConfigurationCategory | PropertyExchangeCategory,
+		MaxSystemExclusiveSize: 1024,
+	}
+	if !reflect.DeepEqual(expected, remote) {
+		t.Fatalf("expected %#v, got %#v", expected, remote)
+	}
+
+	expected = Remote{
+		MUID:                   initiator.MUID(),
+		Identity:               testInitiatorIdentity,
+		Category:               PropertyExchangeCategory,
+		MaxSystemExclusiveSize: DefaultMaxSystemExclusiveSize,
+	}
+	if remotes := responder.Remotes(); len(remotes) != 1 || !reflect.DeepEqual(expected, remotes[0]) {
+		t.Fatalf("expected the Responder to know %#v, got %#v", expected, remotes)
+	}
+}
+
+func Test_Device_Discover_MUIDCollision(t *testing.T) {
+	t.Parallel()
+	initiator, responder := newTestDevices(t, []DeviceOption{WithMUID(0x05)}, []DeviceOption{WithMUID(0x05)})
+
+	remote := discover(t, initiator)
+	if initiator.MUID() != 0x05 {
+		t.Fatalf("expected the Initiator to keep MUID 0x05, got %s", initiator.MUID())
+	}
+	if responder.MUID() == 0x05 {
+		t.Fatalf("expected the Responder to pick a new MUID")
+	}
+	if remote.MUID != responder.MUID() {
+		t.Fatalf("expected the Initiator to know the new MUID %s, got %s", responder.MUID(), remote.MUID)
+	}
+}
+
+func Test_Device_Invalidate(t *testing.T) {
+	t.Parallel()
+	initiator, responder := newTestDevices(t, nil, nil)
+	discover(t, initiator)
+
+	old := responder.MUID()
+	if err := responder.Invalidate(old); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if responder.MUID() == old {
+		t.Fatalf("expected the Responder to pick a new MUID")
+	}
+	deadline := time.Now().Add(2 * time.Second)
+	for len(initiator.Remotes()) != 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected the Initiator to forget %s, got %#v", old, initiator.Remotes())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func Test_Device_ProfileInquiry(t *testing.T) {
+	t.Parallel()
+	other := ProfileID{0x41, 0x00, 0x00, 0x02, 0x00}
+	initiator, _ := newTestDevices(t, nil, []DeviceOption{WithProfile(testProfile, false), WithProfile(other, true)})
+	remote := discover(t, initiator)
+
+	enabled, disabled, err := initiator.ProfileInquiry(testContext(t), remote.MUID)
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if !reflect.DeepEqual([]ProfileID{other}, enabled) {
+		t.Fatalf("expected enabled %v, got %v", []ProfileID{other}, enabled)
+	}
+	if !reflect.DeepEqual([]ProfileID{testProfile}, disabled) {
+		t.Fatalf("expected disabled %v, got %v", []ProfileID{testProfile}, disabled)
+	}
+}
+
+func Test_Device_SetProfile(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		profile  ProfileID
+		enabled  bool
+		expected map[ProfileID]bool
+		err      error
+	}{
+		"Profile is enabled": {
+			profile:  testProfile,
+			enabled:  true,
+			expected: map[ProfileID]bool{testProfile: true},
+		},
+		"Profile is disabled": {
+			profile:  testProfile,
+			expected: map[ProfileID]bool{testProfile: false},
+		},
+		"unknown Profile is rejected": {
+			profile:  ProfileID{0x41},
+			enabled:  true,
+			expected: map[ProfileID]bool{testProfile: false},
+			err:      ErrNAK,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			initiator, responder := newTestDevices(t, nil, []DeviceOption{WithProfile(testProfile, !test.enabled)})
+			remote := discover(t, initiator)
+
+			err := initiator.SetProfile(testContext(t), remote.MUID, test.profile, test.enabled)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if got := responder.Profiles(); !reflect.DeepEqual(test.expected, got) {
+				t.Fatalf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_Device_PropertyExchangeCapabilities(t *testing.T) {
+	t.Parallel()
+	initiator, _ := newTestDevices(t, nil, nil)
+	remote := discover(t, initiator)
+
+	requests, err := initiator.PropertyExchangeCapabilities(testContext(t), remote.MUID)
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if requests != 1 {
+		t.Fatalf("expected 1 simultaneous request, got %d", requests)
+	}
+}
+
+func Test_Device_GetDeviceInfo(t *testing.T) {
+	t.Parallel()
+	info := DeviceInfo{Manufacturer: "Yamaha", Family: "Synthesizers", Model: "Café", Version: "1.0.0"}
+	initiator, _ := newTestDevices(t, nil, []DeviceOption{WithDeviceInfo(info)})
+	remote := discover(t, initiator)
+
+	got, err := initiator.GetDeviceInfo(testContext(t), remote.MUID)
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	info.SetIdentity(testResponderIdentity)
+	if !reflect.DeepEqual(info, got) {
+		t.Fatalf("expected %#v, got %#v", info, got)
+	}
+}
+
+func Test_Device_GetResourceList(t *testing.T) {
+	t.Parallel()
+	initiator, _ := newTestDevices(t, nil, []DeviceOption{
+		WithProperty(Property{Entry: ResourceListEntry{Resource: "X-Settings"}, Get: func(RequestHeader) ([]byte, error) { return []byte("{}"), nil }, Set: func(RequestHeader, []byte) error { return nil }}),
+		WithProperty(Property{Entry: ResourceListEntry{Resource: "X-Command", CanSet: CanSetFull}, Set: func(RequestHeader, []byte) error { return nil }}),
+	})
+	remote := discover(t, initiator)
+
+	got, err := initiator.GetResourceList(testContext(t), remote.MUID)
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	canGet := false
+	expected := []ResourceListEntry{
+		{Resource: DeviceInfoResource},
+		{Resource: "X-Command", CanGet: &canGet, CanSet: CanSetFull},
+		{Resource: "X-Settings", CanSet: CanSetFull},
+	}
+	if !reflect.DeepEqual(expected, got) {
+		t.Fatalf("expected %#v, got %#v", expected, got)
+	}
+}
+
+func Test_Device_Property(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	settings := []byte(`{"name":"default"}`)
+	property := Property{
+		Entry: ResourceListEntry{Resource: "X-Settings"},
+		Get: func(RequestHeader) ([]byte, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			return settings, nil
+		},
+		Set: func(header RequestHeader, body []byte) error {
+			if !bytes.HasPrefix(body, []byte("{")) {
+				return fmt.Errorf("settings must be an object")
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			settings = body
+			return nil
+		},
+	}
+	readOnly := Property{
+		Entry: ResourceListEntry{Resource: "X-Status"},
+		Get: func(RequestHeader) ([]byte, error) {
+			return []byte(`"ok"`), nil
+		},
+	}
+	unescaped := Property{
+		Entry: ResourceListEntry{Resource: "X-Name"},
+		Get: func(RequestHeader) ([]byte, error) {
+			return []byte(`"Café"`), nil
+		},
+	}
+	initiator, _ := newTestDevices(t,
+		[]DeviceOption{WithMaxSystemExclusiveSize(MinSystemExclusiveSize)},
+		[]DeviceOption{WithMaxSystemExclusiveSize(MinSystemExclusiveSize), WithProperty(property), WithProperty(readOnly), WithProperty(unescaped)},
+	)
+	remote := discover(t, initiator)
+
+	// a body much larger than a single System Exclusive message is sent and returned in chunks
+	large := []byte(`{"name":"` + strings.Repeat("x", 1000) + `"}`)
+	if _, err := initiator.SetProperty(testContext(t), remote.MUID, RequestHeader{Resource: "X-Settings"}, large); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	header, got, err := initiator.GetProperty(testContext(t), remote.MUID, RequestHeader{Resource: "X-Settings"})
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if header.Status != StatusOK || !bytes.Equal(large, got) {
+		t.Fatalf("expected status %d and %s, got status %d and %s", StatusOK, large, header.Status, got)
+	}
+
+	tests := map[string]struct {
+		header         RequestHeader
+		body           []byte
+		set            bool
+		expectedStatus int
+	}{
+		"unknown resource is not found": {
+			header:         RequestHeader{Resource: "X-Unknown"},
+			expectedStatus: StatusNotFound,
+		},
+		"read-only resource cannot be set": {
+			header:         RequestHeader{Resource: "X-Status"},
+			body:           []byte(`"broken"`),
+			set:            true,
+			expectedStatus: StatusNotSupported,
+		},
+		"body that is not 7-bit is an internal error": {
+			header:         RequestHeader{Resource: "X-Name"},
+			expectedStatus: StatusInternalError,
+		},
+		"invalid body is rejected": {
+			header:         RequestHeader{Resource: "X-Settings"},
+			body:           []byte(`[]`),
+			set:            true,
+			expectedStatus: StatusBadData,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var header ReplyHeader
+			var err error
+			if test.set {
+				header, err = initiator.SetProperty(testContext(t), remote.MUID, test.header, test.body)
+			} else {
+				header, _, err = initiator.GetProperty(testContext(t), remote.MUID, test.header)
+			}
+			if !errors.Is(err, ErrPropertyStatus) {
+				t.Fatalf("expected %v error, got %v", ErrPropertyStatus, err)
+			}
+			if header.Status != test.expectedStatus {
+				t.Fatalf("expected status %d, got %d", test.expectedStatus, header.Status)
+			}
+		})
+	}
+}
+
+func Test_Device_Serve_MaxSystemExclusiveSize(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	settings := []byte(`{}`)
+	property := Property{
+		Entry: ResourceListEntry{Resource: "X-Settings"},
+		Set: func(header RequestHeader, body []byte) error {
+			mu.Lock()
+			defer mu.Unlock()
+			settings = body
+			return nil
+		},
+	}
+	initiator, responder := newTestDevices(t, nil, []DeviceOption{WithMaxSystemExclusiveSize(MinSystemExclusiveSize), WithProperty(property)})
+	body := []byte(`{"name":"` + strings.Repeat("x", 2*int(MinSystemExclusiveSize)) + `"}`)
+
+	// before Discovery the Initiator does not know the limit of the Responder and sends a single message that is too large
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := initiator.SetProperty(ctx, responder.MUID(), RequestHeader{Resource: "X-Settings"}, body); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected %v error, got %v", context.DeadlineExceeded, err)
+	}
+	mu.Lock()
+	if !bytes.Equal([]byte(`{}`), settings) {
+		t.Fatalf("expected the oversize message to be dropped, got settings %s", settings)
+	}
+	mu.Unlock()
+
+	// the Responder keeps serving and accepts the same body once it is sent in chunks that fit
+	remote := discover(t, initiator)
+	if _, err := initiator.SetProperty(testContext(t), remote.MUID, RequestHeader{Resource: "X-Settings"}, body); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if !bytes.Equal(body, settings) {
+		t.Fatalf("expected settings %s, got %s", body, settings)
+	}
+}
+
+func Test_Device_Close(t *testing.T) {
+	t.Parallel()
+	initiator, _ := newTestDevices(t, nil, nil)
+	if err := initiator.Close(); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if _, _, err := initiator.ProfileInquiry(testContext(t), 0x01); !errors.Is(err, ErrDeviceClosed) {
+		t.Fatalf("expected %v error, got %v", ErrDeviceClosed, err)
+	}
+}
@@ -0,0 +1,354 @@
+package ci
+
+import (
+	"fmt"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+const (
+	// MaxIdentityNumber represents the highest family or model number, which are each sent as two 7-bit data bytes.
+	MaxIdentityNumber uint16 = 0x3FFF
+
+	// MinSystemExclusiveSize represents the smallest maximum System Exclusive message size that a device can report.
+	MinSystemExclusiveSize uint32 = 128
+
+	// discoveryPayloadLength represents the number of payload bytes of a Discovery message, without the output path ID.
+	discoveryPayloadLength int = 16
+
+	// nakPayloadLength represents the number of payload bytes of a NAK message before its message text.
+	nakPayloadLength int = 10
+)
+
+// Identity represents the device identity fields of Discovery messages, which match those of an Identity Reply message.
+type Identity struct {
+	// Manufacturer represents the manufacturer ID of the device.
+	Manufacturer midiv1.ManufacturerID
+
+	// Family represents the device family code, between 0 and 0x3FFF inclusive.
+	Family uint16
+
+	// Model represents the device family member (model) code, between 0 and 0x3FFF inclusive.
+	Model uint16
+
+	// Version represents the four software revision level bytes of the device.
+	Version [4]byte
+}
+
+// appendIdentity appends the identity as three manufacturer ID bytes, two family bytes, two model bytes and four version
+// bytes. One-byte manufacturer IDs are padded with two zero bytes.
+func (i Identity) appendIdentity(name string, b []byte) ([]byte, error) {
+	if i.Family > MaxIdentityNumber || i.Model > MaxIdentityNumber {
+		return nil, fmt.Errorf("%s messages must have family and model codes between 0 and %#x, inclusive: %w", name, MaxIdentityNumber, ErrMarshallingMessage)
+	}
+	b = append(b, i.Manufacturer[:]...)
+	b = appendSevenBitValue(b, uint32(i.Family), 2)
+	b = appendSevenBitValue(b, uint32(i.Model), 2)
+	return append(b, i.Version[:]...), nil
+}
+
+// parseIdentity returns the identity from the first 11 bytes of a Discovery payload.
+func parseIdentity(b []byte) Identity {
+	identity := Identity{
+		Manufacturer: midiv1.ManufacturerID{b[0], b[1], b[2]},
+		Family:       uint16(parseSevenBitValue(b[3:5])),
+		Model:        uint16(parseSevenBitValue(b[5:7])),
+	}
+	copy(identity.Version[:], b[7:11])
+	return identity
+}
+
+// DiscoveryMessage represents a MIDI-CI Discovery message, which an Initiator broadcasts to find the MIDI-CI devices it
+// is connected to.
+type DiscoveryMessage struct {
+	Header
+
+	// Identity represents the identity of the Initiator.
+	Identity Identity
+
+	// Category represents the MIDI-CI features that the Initiator supports.
+	Category Category
+
+	// MaxSystemExclusiveSize represents the size of the largest System Exclusive message that the Initiator can receive.
+	MaxSystemExclusiveSize uint32
+
+	// OutputPathID represents the output of the Initiator that the message was sent from.
+	OutputPathID byte
+}
+
+// GetMessageName returns the name of this Discovery message.
+func (dm *DiscoveryMessage) GetMessageName() string {
+	return "Discovery"
+}
+
+// MarshalMIDI marshalls a DiscoveryMessage MIDI-CI message into its raw bytes
+func (dm DiscoveryMessage) MarshalMIDI() ([]byte, error) {
+	payload, err := marshalDiscoveryPayload(dm.GetMessageName(), dm.Identity, dm.Category, dm.MaxSystemExclusiveSize)
+	if err != nil {
+		return nil, err
+	}
+	payload = append(payload, dm.OutputPathID&0x7F)
+	return marshalMessage(dm.GetMessageName(), DiscoverySubID, dm.Header, payload)
+}
+
+// String returns the human-readable representation of the MIDI-CI message.
+func (dm *DiscoveryMessage) String() string {
+	return dm.Header.format(dm.GetMessageName(), dm.Identity.Manufacturer, dm.Identity.Family, dm.Identity.Model, dm.Category, dm.MaxSystemExclusiveSize)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a DiscoveryMessage struct pointer. Discovery messages are represented by
+// (left to right): the MIDI-CI header with sub-ID #2 0x70, three manufacturer ID bytes, two family bytes, two model
+// bytes, four version bytes, the category byte, four maximum System Exclusive size bytes and, from MIDI-CI 1.2, the
+// output path ID byte. Multi-byte values are sent least significant byte first.
+//
+// Example: []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x70, 0x02, 0x01, 0x00, 0x00, 0x00, 0x7F, 0x7F, 0x7F, 0x7F, 0x41, 0x00, 0x00, 0x01, 0x00, 0x02, 0x00, 0x01, 0x00, 0x00, 0x00, 0x0C, 0x00, 0x02, 0x00, 0x00, 0x00, 0xF7}
+//
+// The example forms a Discovery message from MUID 0x01 for a Roland device that supports Profile Configuration and
+// Property Exchange and receives System Exclusive messages of up to 256 bytes.
+func (dm *DiscoveryMessage) UnmarshalMIDI(b []byte) error {
+	header, payload, err := unmarshalMessage(dm.GetMessageName(), DiscoverySubID, b)
+	if err != nil {
+		return err
+	}
+	if err := checkPayloadLength(dm.GetMessageName(), payload, discoveryPayloadLength); err != nil {
+		return err
+	}
+
+	dm.Header = header
+	dm.Identity, dm.Category, dm.MaxSystemExclusiveSize = unmarshalDiscoveryPayload(payload)
+	dm.OutputPathID = 0
+	if len(payload) > discoveryPayloadLength {
+		dm.OutputPathID = payload[discoveryPayloadLength]
+	}
+	return nil
+}
+
+// DiscoveryReplyMessage represents a MIDI-CI Reply to Discovery message, which a Responder sends back to the Initiator
+// of a Discovery message.
+type DiscoveryReplyMessage struct {
+	Header
+
+	// Identity represents the identity of the Responder.
+	Identity Identity
+
+	// Category represents the MIDI-CI features that the Responder supports.
+	Category Category
+
+	// MaxSystemExclusiveSize represents the size of the largest System Exclusive message that the Responder can receive.
+	MaxSystemExclusiveSize uint32
+
+	// OutputPathID represents the output path ID of the Discovery message that is being replied to.
+	OutputPathID byte
+
+	// FunctionBlock represents the Function Block of the Responder, or 0x7F when it has none.
+	FunctionBlock byte
+}
+
+// GetMessageName returns the name of this Reply to Discovery message.
+func (drm *DiscoveryReplyMessage) GetMessageName() string {
+	return "Reply to Discovery"
+}
+
+// MarshalMIDI marshalls a DiscoveryReplyMessage MIDI-CI message into its raw bytes
+func (drm DiscoveryReplyMessage) MarshalMIDI() ([]byte, error) {
+	payload, err := marshalDiscoveryPayload(drm.GetMessageName(), drm.Identity, drm.Category, drm.MaxSystemExclusiveSize)
+	if err != nil {
+		return nil, err
+	}
+	payload = append(payload, drm.OutputPathID&0x7F, drm.FunctionBlock&0x7F)
+	return marshalMessage(drm.GetMessageName(), DiscoveryReplySubID, drm.Header, payload)
+}
+
+// String returns the human-readable representation of the MIDI-CI message.
+func (drm *DiscoveryReplyMessage) String() string {
+	return drm.Header.format(drm.GetMessageName(), drm.Identity.Manufacturer, drm.Identity.Family, drm.Identity.Model, drm.Category, drm.MaxSystemExclusiveSize)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a DiscoveryReplyMessage struct pointer. Reply to Discovery messages are
+// represented by (left to right): the MIDI-CI header with sub-ID #2 0x71, the same fields as a Discovery message and,
+// from MIDI-CI 1.2, the Function Block byte.
+//
+// Example: []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x71, 0x02, 0x02, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x43, 0x00, 0x00, 0x01, 0x00, 0x02, 0x00, 0x01, 0x00, 0x00, 0x00, 0x08, 0x00, 0x02, 0x00, 0x00, 0x00, 0x7F, 0xF7}
+//
+// The example forms a Reply to Discovery message from MUID 0x02 to MUID 0x01 for a Yamaha device that supports Property
+// Exchange, receives System Exclusive messages of up to 256 bytes and has no Function Block.
+func (drm *DiscoveryReplyMessage) UnmarshalMIDI(b []byte) error {
+	header, payload, err := unmarshalMessage(drm.GetMessageName(), DiscoveryReplySubID, b)
+	if err != nil {
+		return err
+	}
+	if err := checkPayloadLength(drm.GetMessageName(), payload, discoveryPayloadLength); err != nil {
+		return err
+	}
+
+	drm.Header = header
+	drm.Identity, drm.Category, drm.MaxSystemExclusiveSize = unmarshalDiscoveryPayload(payload)
+	drm.OutputPathID, drm.FunctionBlock = 0, 0x7F
+	if len(payload) > discoveryPayloadLength+1 {
+		drm.OutputPathID, drm.FunctionBlock = payload[discoveryPayloadLength], payload[discoveryPayloadLength+1]
+	}
+	return nil
+}
+
+// marshalDiscoveryPayload returns the payload fields shared by Discovery and Reply to Discovery messages.
+func marshalDiscoveryPayload(name string, identity Identity, category Category, maxSize uint32) ([]byte, error) {
+	if maxSize >= 1<<28 {
+		return nil, fmt.Errorf("%s messages must have a maximum System Exclusive size below %#x: %w", name, 1<<28, ErrMarshallingMessage)
+	}
+	payload, err := identity.appendIdentity(name, make([]byte, 0, discoveryPayloadLength+2))
+	if err != nil {
+		return nil, err
+	}
+	payload = append(payload, byte(category)&0x7F)
+	return appendSevenBitValue(payload, maxSize, 4), nil
+}
+
+// unmarshalDiscoveryPayload returns the payload fields shared by Discovery and Reply to Discovery messages.
+func unmarshalDiscoveryPayload(payload []byte) (Identity, Category, uint32) {
+	return parseIdentity(payload), Category(payload[11]), parseSevenBitValue(payload[12:16])
+}
+
+// InvalidateMUIDMessage represents a MIDI-CI Invalidate MUID message, which tells every device to forget a MUID, such
+// as when a device has picked a new MUID after a collision.
+type InvalidateMUIDMessage struct {
+	Header
+
+	// Target represents the MUID that should be forgotten.
+	Target MUID
+}
+
+// GetMessageName returns the name of this Invalidate MUID message.
+func (imm *InvalidateMUIDMessage) GetMessageName() string {
+	return "Invalidate MUID"
+}
+
+// MarshalMIDI marshalls an InvalidateMUIDMessage MIDI-CI message into its raw bytes
+func (imm InvalidateMUIDMessage) MarshalMIDI() ([]byte, error) {
+	if imm.Target > BroadcastMUID {
+		return nil, fmt.Errorf("%s messages must have a 28-bit target MUID, received %s: %w", imm.GetMessageName(), imm.Target, ErrMarshallingMessage)
+	}
+	return marshalMessage(imm.GetMessageName(), InvalidateMUIDSubID, imm.Header, appendSevenBitValue(nil, uint32(imm.Target), 4))
+}
+
+// String returns the human-readable representation of the MIDI-CI message.
+func (imm *InvalidateMUIDMessage) String() string {
+	return imm.Header.format(imm.GetMessageName(), imm.Target)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into an InvalidateMUIDMessage struct pointer. Invalidate MUID messages are
+// represented by (left to right): the MIDI-CI header with sub-ID #2 0x7E and the four target MUID bytes.
+//
+// Example: []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x7E, 0x02, 0x03, 0x00, 0x00, 0x00, 0x7F, 0x7F, 0x7F, 0x7F, 0x01, 0x00, 0x00, 0x00, 0xF7}
+//
+// The example forms an Invalidate MUID message broadcast from MUID 0x03 that invalidates MUID 0x01.
+func (imm *InvalidateMUIDMessage) UnmarshalMIDI(b []byte) error {
+	header, payload, err := unmarshalMessage(imm.GetMessageName(), InvalidateMUIDSubID, b)
+	if err != nil {
+		return err
+	}
+	if err := checkPayloadLength(imm.GetMessageName(), payload, 4); err != nil {
+		return err
+	}
+	imm.Header = header
+	imm.Target = MUID(parseSevenBitValue(payload[:4]))
+	return nil
+}
+
+// NAKStatus represents the status code of a NAK message, which explains why a message was rejected.
+type NAKStatus byte
+
+const (
+	// NAKStatusUnsupported represents a message that the device does not support.
+	NAKStatusUnsupported NAKStatus = 0x01
+
+	// NAKStatusUnsupportedVersion represents a message with a MIDI-CI version that the device does not support.
+	NAKStatusUnsupportedVersion NAKStatus = 0x02
+
+	// NAKStatusTargetNotFound represents a message for a channel, group or Function Block that does not exist.
+	NAKStatusTargetNotFound NAKStatus = 0x03
+
+	// NAKStatusProfileNotFound represents a message for a Profile that the device does not support.
+	NAKStatusProfileNotFound NAKStatus = 0x04
+
+	// NAKStatusTerminateInquiry represents a message that the device will not act on without a new Discovery.
+	NAKStatusTerminateInquiry NAKStatus = 0x20
+
+	// NAKStatusMalformed represents a message that the device could not parse.
+	NAKStatusMalformed NAKStatus = 0x41
+)
+
+// NAKMessage represents a MIDI-CI NAK message, which a device sends when it rejects a message.
+type NAKMessage struct {
+	Header
+
+	// OriginalSubID represents the sub-ID #2 of the rejected message.
+	OriginalSubID byte
+
+	// Status represents the reason that the message was rejected.
+	Status NAKStatus
+
+	// StatusData represents any extra data for the status code.
+	StatusData byte
+
+	// Details represents any extra details about the rejection, which are specific to the rejected message.
+	Details [5]byte
+
+	// Text represents a human-readable explanation of the rejection.
+	Text string
+}
+
+// GetMessageName returns the name of this NAK message.
+func (nm *NAKMessage) GetMessageName() string {
+	return "NAK"
+}
+
+// MarshalMIDI marshalls a NAKMessage MIDI-CI message into its raw bytes
+func (nm NAKMessage) MarshalMIDI() ([]byte, error) {
+	if len(nm.Text) > 1<<14-1 {
+		return nil, fmt.Errorf("%s messages must have text of no more than %d bytes: %w", nm.GetMessageName(), 1<<14-1, ErrMarshallingMessage)
+	}
+	payload := make([]byte, 0, nakPayloadLength+len(nm.Text))
+	payload = append(payload, nm.OriginalSubID&0x7F, byte(nm.Status)&0x7F, nm.StatusData&0x7F)
+	for _, detail := range nm.Details {
+		payload = append(payload, detail&0x7F)
+	}
+	payload = appendSevenBitValue(payload, uint32(len(nm.Text)), 2)
+	payload = append(payload, nm.Text...)
+	return marshalMessage(nm.GetMessageName(), NAKSubID, nm.Header, payload)
+}
+
+// String returns the human-readable representation of the MIDI-CI message.
+func (nm *NAKMessage) String() string {
+	return nm.Header.format(nm.GetMessageName(), fmt.Sprintf("%#x", nm.OriginalSubID), fmt.Sprintf("%#x", byte(nm.Status)), nm.Text)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a NAKMessage struct pointer. NAK messages are represented by (left to
+// right): the MIDI-CI header with sub-ID #2 0x7F, the original sub-ID #2 byte, the status code byte, the status data
+// byte, five details bytes, two message length bytes and the message text. NAK messages from MIDI-CI 1.1 devices have no
+// payload at all.
+//
+// Example: []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x7F, 0x02, 0x02, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x20, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xF7}
+//
+// The example forms a NAK message from MUID 0x02 to MUID 0x01 that rejects a Profile Inquiry message as unsupported.
+func (nm *NAKMessage) UnmarshalMIDI(b []byte) error {
+	header, payload, err := unmarshalMessage(nm.GetMessageName(), NAKSubID, b)
+	if err != nil {
+		return err
+	}
+
+	*nm = NAKMessage{Header: header}
+	if len(payload) == 0 {
+		return nil
+	}
+	if err := checkPayloadLength(nm.GetMessageName(), payload, nakPayloadLength); err != nil {
+		return err
+	}
+	textLength := int(parseSevenBitValue(payload[8:10]))
+	if err := checkPayloadLength(nm.GetMessageName(), payload, nakPayloadLength+textLength); err != nil {
+		return err
+	}
+	nm.OriginalSubID, nm.Status, nm.StatusData = payload[0], NAKStatus(payload[1]), payload[2]
+	copy(nm.Details[:], payload[3:8])
+	nm.Text = string(payload[nakPayloadLength : nakPayloadLength+textLength])
+	return nil
+}
@@ -0,0 +1,222 @@
+package ci
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+func Test_DiscoveryMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  DiscoveryMessage
+		expected []byte
+		err      error
+	}{
+		"family is out of range": {
+			message: DiscoveryMessage{Identity: Identity{Family: 0x4000}},
+			err:     ErrMarshallingMessage,
+		},
+		"maximum size is out of range": {
+			message: DiscoveryMessage{MaxSystemExclusiveSize: 1 << 28},
+			err:     ErrMarshallingMessage,
+		},
+		"source MUID is out of range": {
+			message: DiscoveryMessage{Header: Header{Source: BroadcastMUID + 1}},
+			err:     ErrMarshallingMessage,
+		},
+		"message marshalls into expected bytes": {
+			message: DiscoveryMessage{
+				Header:                 Header{DeviceID: FunctionBlockDeviceID, Version: Version, Source: 0x01, Destination: BroadcastMUID},
+				Identity:               Identity{Manufacturer: midiv1.RolandManufacturerID, Family: 1, Model: 2, Version: [4]byte{0x01}},
+				Category:               ProfileConfigurationCategory | PropertyExchangeCategory,
+				MaxSystemExclusiveSize: 256,
+			},
+			expected: []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x70, 0x02, 0x01, 0x00, 0x00, 0x00, 0x7F, 0x7F, 0x7F, 0x7F, 0x41, 0x00, 0x00, 0x01, 0x00, 0x02, 0x00, 0x01, 0x00, 0x00, 0x00, 0x0C, 0x00, 0x02, 0x00, 0x00, 0x00, 0xF7},
+		},
+		"message with three-byte manufacturer ID marshalls into expected bytes": {
+			message: DiscoveryMessage{
+				Header:                 Header{DeviceID: FunctionBlockDeviceID, Version: Version, Source: 0x0ABCDEF, Destination: BroadcastMUID},
+				Identity:               Identity{Manufacturer: midiv1.NovationManufacturerID},
+				MaxSystemExclusiveSize: 128,
+				OutputPathID:           3,
+			},
+			expected: []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x70, 0x02, 0x6F, 0x1B, 0x2F, 0x05, 0x7F, 0x7F, 0x7F, 0x7F, 0x00, 0x20, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x03, 0xF7},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_DiscoveryMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage DiscoveryMessage
+		err             error
+	}{
+		"payload is too short": {
+			b:   []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x70, 0x01, 0x01, 0x00, 0x00, 0x00, 0x7F, 0x7F, 0x7F, 0x7F, 0x41, 0x00, 0x00, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"wrong sub-ID #2 returns an error": {
+			b:   []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x71, 0x02, 0x01, 0x00, 0x00, 0x00, 0x7F, 0x7F, 0x7F, 0x7F, 0x41, 0x00, 0x00, 0x01, 0x00, 0x02, 0x00, 0x01, 0x00, 0x00, 0x00, 0x0C, 0x00, 0x02, 0x00, 0x00, 0x00, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"MIDI-CI 1.1 message without an output path ID": {
+			b: []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x70, 0x01, 0x01, 0x00, 0x00, 0x00, 0x7F, 0x7F, 0x7F, 0x7F, 0x41, 0x00, 0x00, 0x01, 0x00, 0x02, 0x00, 0x01, 0x00, 0x00, 0x00, 0x0C, 0x00, 0x02, 0x00, 0x00, 0xF7},
+			expectedMessage: DiscoveryMessage{
+				Header:                 Header{DeviceID: FunctionBlockDeviceID, Version: 0x01, Source: 0x01, Destination: BroadcastMUID},
+				Identity:               Identity{Manufacturer: midiv1.RolandManufacturerID, Family: 1, Model: 2, Version: [4]byte{0x01}},
+				Category:               ProfileConfigurationCategory | PropertyExchangeCategory,
+				MaxSystemExclusiveSize: 256,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got DiscoveryMessage
+			err := got.UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %#v, got %#v", test.expectedMessage, got)
+			}
+		})
+	}
+}
+
+func Test_DiscoveryReplyMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	message := DiscoveryReplyMessage{
+		Header:                 Header{DeviceID: FunctionBlockDeviceID, Version: Version, Source: 0x02, Destination: 0x01},
+		Identity:               Identity{Manufacturer: midiv1.YamahaManufacturerID, Family: 1, Model: 2, Version: [4]byte{0x01}},
+		Category:               PropertyExchangeCategory,
+		MaxSystemExclusiveSize: 256,
+		FunctionBlock:          0x7F,
+	}
+	expected := []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x71, 0x02, 0x02, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x43, 0x00, 0x00, 0x01, 0x00, 0x02, 0x00, 0x01, 0x00, 0x00, 0x00, 0x08, 0x00, 0x02, 0x00, 0x00, 0x00, 0x7F, 0xF7}
+	got, err := message.MarshalMIDI()
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if !bytes.Equal(expected, got) {
+		t.Fatalf("expected %#v, got %#v", expected, got)
+	}
+}
+
+func Test_InvalidateMUIDMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  InvalidateMUIDMessage
+		expected []byte
+		err      error
+	}{
+		"target MUID is out of range": {
+			message: InvalidateMUIDMessage{Target: BroadcastMUID + 1},
+			err:     ErrMarshallingMessage,
+		},
+		"message marshalls into expected bytes": {
+			message: InvalidateMUIDMessage{
+				Header: Header{DeviceID: FunctionBlockDeviceID, Version: Version, Source: 0x03, Destination: BroadcastMUID},
+				Target: 0x01,
+			},
+			expected: []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x7E, 0x02, 0x03, 0x00, 0x00, 0x00, 0x7F, 0x7F, 0x7F, 0x7F, 0x01, 0x00, 0x00, 0x00, 0xF7},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_NAKMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	header := Header{DeviceID: FunctionBlockDeviceID, Version: Version, Source: 0x02, Destination: 0x01}
+	tests := map[string]struct {
+		message         NAKMessage
+		b               []byte
+		expectedMessage NAKMessage
+		err             error
+	}{
+		"MIDI-CI 1.1 message without a payload": {
+			b:               []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x7F, 0x01, 0x02, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0xF7},
+			expectedMessage: NAKMessage{Header: Header{DeviceID: FunctionBlockDeviceID, Version: 0x01, Source: 0x02, Destination: 0x01}},
+		},
+		"message text is longer than the payload": {
+			b:   []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x7F, 0x02, 0x02, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x20, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x00, 0x4E, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"marshalled message with text round trips": {
+			message: NAKMessage{
+				Header:        header,
+				OriginalSubID: SetProfileOnSubID,
+				Status:        NAKStatusProfileNotFound,
+				Details:       [5]byte{0x7E, 0x00, 0x00, 0x01, 0x01},
+				Text:          "profile not supported",
+			},
+			expectedMessage: NAKMessage{
+				Header:        header,
+				OriginalSubID: SetProfileOnSubID,
+				Status:        NAKStatusProfileNotFound,
+				Details:       [5]byte{0x7E, 0x00, 0x00, 0x01, 0x01},
+				Text:          "profile not supported",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			b := test.b
+			if b == nil {
+				var err error
+				if b, err = test.message.MarshalMIDI(); err != nil {
+					t.Fatalf("expected nil error, got %v", err)
+				}
+			}
+			var got NAKMessage
+			err := got.UnmarshalMIDI(b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if test.err == nil && !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %#v, got %#v", test.expectedMessage, got)
+			}
+		})
+	}
+}
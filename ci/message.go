@@ -0,0 +1,356 @@
+// Package ci implements MIDI Capability Inquiry (MIDI-CI), the Universal Non-Real-Time System Exclusive messages that
+// MIDI devices use to discover each other, turn Profiles on and off and exchange properties as JSON.
+//
+// Every MIDI-CI message is addressed with MUIDs, 28-bit identifiers that each device picks at random, and is sent as a
+// System Exclusive message with sub-ID #1 0x0D. The message types in this package marshal into and unmarshal from the
+// raw bytes of those System Exclusive messages, and a Device sends and answers them over any byte transport.
+package ci
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+var (
+	// ErrMarshallingMessage represents an error marshalling a MIDI-CI message.
+	ErrMarshallingMessage error = errors.New("error marshalling MIDI-CI message")
+
+	// ErrUnmarshallingMessage represents an error unmarshalling a MIDI-CI message.
+	ErrUnmarshallingMessage error = errors.New("error unmarshalling MIDI-CI message")
+
+	// ErrUnsupportedMessage represents a MIDI-CI message with a sub-ID #2 that does not map to a supported message type.
+	ErrUnsupportedMessage error = fmt.Errorf("unsupported MIDI-CI message: %w", ErrUnmarshallingMessage)
+
+	// ErrInvalidMUID represents a MUID outside of the range that devices can use.
+	ErrInvalidMUID error = errors.New("invalid MIDI-CI MUID")
+)
+
+const (
+	// SubID represents the sub-ID #1 of every MIDI-CI message.
+	SubID byte = 0x0D
+
+	// Version represents the MIDI-CI message format version sent by this package (MIDI-CI 1.2).
+	Version byte = 0x02
+
+	// MessageVersion represents the version string for the human-readable representation of MIDI-CI messages.
+	MessageVersion string = "MIDI-CI"
+
+	// HeaderStringFormat represents the printf-compatible format for the start of every MIDI-CI message string.
+	HeaderStringFormat string = "%s:%s:%d:%s:%s"
+
+	// FunctionBlockDeviceID represents the device ID of MIDI-CI messages sent to or from a whole Function Block (or
+	// port), rather than a single channel.
+	FunctionBlockDeviceID midiv1.DeviceID = 0x7F
+
+	// GroupDeviceID represents the device ID of MIDI-CI messages sent to or from a whole group.
+	GroupDeviceID midiv1.DeviceID = 0x7E
+
+	// headerLength represents the number of bytes of a MIDI-CI message between the manufacturer ID and the payload: the
+	// device ID, both sub-IDs, the version and the source and destination MUIDs.
+	headerLength int = 12
+
+	// framingLength represents the number of bytes of a MIDI-CI message other than its payload: the System Exclusive
+	// status byte, the manufacturer ID, the header and the EOX byte.
+	framingLength int = headerLength + 3
+)
+
+const (
+	// DiscoverySubID represents the sub-ID #2 of a Discovery message.
+	DiscoverySubID byte = 0x70
+
+	// DiscoveryReplySubID represents the sub-ID #2 of a Reply to Discovery message.
+	DiscoveryReplySubID byte = 0x71
+
+	// ACKSubID represents the sub-ID #2 of an ACK message.
+	ACKSubID byte = 0x7D
+
+	// InvalidateMUIDSubID represents the sub-ID #2 of an Invalidate MUID message.
+	InvalidateMUIDSubID byte = 0x7E
+
+	// NAKSubID represents the sub-ID #2 of a NAK message.
+	NAKSubID byte = 0x7F
+
+	// ProfileInquirySubID represents the sub-ID #2 of a Profile Inquiry message.
+	ProfileInquirySubID byte = 0x20
+
+	// ProfileInquiryReplySubID represents the sub-ID #2 of a Reply to Profile Inquiry message.
+	ProfileInquiryReplySubID byte = 0x21
+
+	// SetProfileOnSubID represents the sub-ID #2 of a Set Profile On message.
+	SetProfileOnSubID byte = 0x22
+
+	// SetProfileOffSubID represents the sub-ID #2 of a Set Profile Off message.
+	SetProfileOffSubID byte = 0x23
+
+	// ProfileEnabledReportSubID represents the sub-ID #2 of a Profile Enabled Report message.
+	ProfileEnabledReportSubID byte = 0x24
+
+	// ProfileDisabledReportSubID represents the sub-ID #2 of a Profile Disabled Report message.
+	ProfileDisabledReportSubID byte = 0x25
+
+	// PropertyExchangeCapabilitiesSubID represents the sub-ID #2 of an Inquiry: Property Exchange Capabilities message.
+	PropertyExchangeCapabilitiesSubID byte = 0x30
+
+	// PropertyExchangeCapabilitiesReplySubID represents the sub-ID #2 of a Reply to Property Exchange Capabilities
+	// message.
+	PropertyExchangeCapabilitiesReplySubID byte = 0x31
+)
+
+// MUID represents the 28-bit MIDI Unique Identifier of a MIDI-CI device, sent as four 7-bit data bytes, least
+// significant byte first.
+type MUID uint32
+
+const (
+	// MinMUID is the lowest MUID that a device can use.
+	MinMUID MUID = 0x00000000
+
+	// MaxMUID is the highest MUID that a device can use. The MUIDs above it are reserved.
+	MaxMUID MUID = 0x0FFFFEFF
+
+	// BroadcastMUID is the destination MUID of messages sent to every device.
+	BroadcastMUID MUID = 0x0FFFFFFF
+)
+
+// NewMUID returns a MUID based on the integer argument.
+func NewMUID(muid int) (MUID, error) {
+	if muid < int(MinMUID) || muid > int(MaxMUID) {
+		return MinMUID, fmt.Errorf("valid MUIDs are between %#x and %#x, inclusive: %w", MinMUID, MaxMUID, ErrInvalidMUID)
+	}
+	return MUID(muid), nil
+}
+
+// NewRandomMUID returns a random MUID from the supplied source of random numbers, as every device picks its MUID.
+func NewRandomMUID(r *rand.Rand) MUID {
+	return MUID(r.Int63n(int64(MaxMUID) + 1))
+}
+
+// IsBroadcast returns whether the MUID addresses every device.
+func (m MUID) IsBroadcast() bool {
+	return m == BroadcastMUID
+}
+
+// String returns the hexadecimal representation of the MUID.
+func (m MUID) String() string {
+	return fmt.Sprintf("%#07x", uint32(m))
+}
+
+// Category represents the bit flags of the MIDI-CI features that a device supports.
+type Category byte
+
+const (
+	// ProfileConfigurationCategory represents support for Profile Configuration.
+	ProfileConfigurationCategory Category = 0x04
+
+	// PropertyExchangeCategory represents support for Property Exchange.
+	PropertyExchangeCategory Category = 0x08
+
+	// ProcessInquiryCategory represents support for Process Inquiry.
+	ProcessInquiryCategory Category = 0x10
+)
+
+// Has returns whether every feature of the supplied category is supported.
+func (c Category) Has(category Category) bool {
+	return c&category == category
+}
+
+// Header represents the fields at the start of every MIDI-CI message.
+type Header struct {
+	// DeviceID represents the channel (0 through 15) that the message is sent to or from, or FunctionBlockDeviceID or
+	// GroupDeviceID.
+	DeviceID midiv1.DeviceID
+
+	// Version represents the MIDI-CI message format version.
+	Version byte
+
+	// Source represents the MUID of the device that sent the message.
+	Source MUID
+
+	// Destination represents the MUID of the device that the message is sent to, or BroadcastMUID.
+	Destination MUID
+}
+
+// GetHeader returns the header of the MIDI-CI message.
+func (h Header) GetHeader() Header {
+	return h
+}
+
+// format returns the human-readable representation of a MIDI-CI message with the supplied name, header and any
+// message-specific details.
+func (h Header) format(name string, details ...interface{}) string {
+	s := fmt.Sprintf(HeaderStringFormat, MessageVersion, name, h.DeviceID, h.Source, h.Destination)
+	for _, detail := range details {
+		s += fmt.Sprintf(":%v", detail)
+	}
+	return s
+}
+
+// Message represents a MIDI-CI message.
+type Message interface {
+	// GetMessageName returns the human-readable name of the MIDI-CI message.
+	GetMessageName() string
+
+	// GetHeader returns the header of the MIDI-CI message.
+	GetHeader() Header
+}
+
+// MessageMarshaler represents MIDI-CI message data that can be marshalled.
+type MessageMarshaler interface {
+	// MarshalMIDI marshalls a MIDI-CI message into the raw bytes of its System Exclusive message.
+	MarshalMIDI() ([]byte, error)
+}
+
+// MessageUnmarshaler represents MIDI-CI message data that can be unmarshalled.
+type MessageUnmarshaler interface {
+	// UnmarshalMIDI unmarshalls the raw bytes of a System Exclusive message into a MIDI-CI message.
+	UnmarshalMIDI(b []byte) error
+}
+
+// MessageBuilder represents MIDI-CI message data that can be both marshalled and unmarshalled.
+type MessageBuilder interface {
+	MessageMarshaler
+	MessageUnmarshaler
+}
+
+// unmarshalerMessage represents a MIDI-CI message that can unmarshal itself.
+type unmarshalerMessage interface {
+	Message
+	MessageUnmarshaler
+}
+
+// IsMessage returns whether the raw bytes are a MIDI-CI System Exclusive message.
+func IsMessage(b []byte) bool {
+	return len(b) > 4 &&
+		b[0] == midiv1.SystemExclusiveMessageStatus &&
+		b[1] == midiv1.UniversalNonRealTimeManufacturerID[0] &&
+		b[3] == SubID
+}
+
+// Unmarshal unmarshalls the raw bytes of a MIDI-CI System Exclusive message into the message type identified by its
+// sub-ID #2.
+//
+// Example: []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x20, 0x02, 0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0xF7}
+//
+// The example returns a *ProfileInquiryMessage from MUID 0x01 to MUID 0x02.
+func Unmarshal(b []byte) (Message, error) {
+	if !IsMessage(b) {
+		return nil, fmt.Errorf("raw bytes are not a MIDI-CI message: %w", ErrUnmarshallingMessage)
+	}
+
+	var message unmarshalerMessage
+	switch subID := b[4]; subID {
+	case DiscoverySubID:
+		message = &DiscoveryMessage{}
+	case DiscoveryReplySubID:
+		message = &DiscoveryReplyMessage{}
+	case InvalidateMUIDSubID:
+		message = &InvalidateMUIDMessage{}
+	case NAKSubID:
+		message = &NAKMessage{}
+	case ProfileInquirySubID:
+		message = &ProfileInquiryMessage{}
+	case ProfileInquiryReplySubID:
+		message = &ProfileInquiryReplyMessage{}
+	case SetProfileOnSubID:
+		message = &SetProfileOnMessage{}
+	case SetProfileOffSubID:
+		message = &SetProfileOffMessage{}
+	case ProfileEnabledReportSubID:
+		message = &ProfileEnabledReportMessage{}
+	case ProfileDisabledReportSubID:
+		message = &ProfileDisabledReportMessage{}
+	case PropertyExchangeCapabilitiesSubID:
+		message = &PropertyExchangeCapabilitiesMessage{}
+	case PropertyExchangeCapabilitiesReplySubID:
+		message = &PropertyExchangeCapabilitiesReplyMessage{}
+	default:
+		if PropertyTransaction(subID).valid() {
+			message = &PropertyDataMessage{}
+			break
+		}
+		return nil, fmt.Errorf("no supported MIDI-CI message for sub-ID #2 %#x: %w", subID, ErrUnsupportedMessage)
+	}
+	if err := message.UnmarshalMIDI(b); err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+// marshalMessage returns the raw bytes of the MIDI-CI message with the supplied sub-ID #2, header and payload. The name
+// is used to describe the message in any returned errors.
+func marshalMessage(name string, subID byte, header Header, payload []byte) ([]byte, error) {
+	if header.DeviceID > midiv1.MaxDeviceID {
+		return nil, fmt.Errorf("%s messages must have a device ID between %d and %d, inclusive, received %d: %w", name, midiv1.MinDeviceID, midiv1.MaxDeviceID, header.DeviceID, ErrMarshallingMessage)
+	}
+	if header.Source > BroadcastMUID || header.Destination > BroadcastMUID {
+		return nil, fmt.Errorf("%s messages must have 28-bit MUIDs, received %s and %s: %w", name, header.Source, header.Destination, ErrMarshallingMessage)
+	}
+	data := make([]byte, 0, headerLength+len(payload))
+	data = append(data, byte(header.DeviceID), SubID, subID, header.Version)
+	data = appendSevenBitValue(data, uint32(header.Source), 4)
+	data = appendSevenBitValue(data, uint32(header.Destination), 4)
+	data = append(data, payload...)
+
+	b, err := midiv1.SystemExclusiveMessage{
+		Manufacturer: midiv1.UniversalNonRealTimeManufacturerID,
+		Data:         data,
+	}.MarshalMIDI()
+	if err != nil {
+		return nil, fmt.Errorf("%s messages could not be marshalled (%v): %w", name, err, ErrMarshallingMessage)
+	}
+	return b, nil
+}
+
+// unmarshalMessage validates the raw bytes of a MIDI-CI message with the supplied sub-ID #2 and returns its header and
+// payload. The name is used to describe the message in any returned errors.
+func unmarshalMessage(name string, subID byte, b []byte) (Header, []byte, error) {
+	var sem midiv1.SystemExclusiveMessage
+	if err := sem.UnmarshalMIDI(b); err != nil {
+		return Header{}, nil, fmt.Errorf("%s messages must be System Exclusive messages (%v): %w", name, err, ErrUnmarshallingMessage)
+	}
+	if sem.Manufacturer != midiv1.UniversalNonRealTimeManufacturerID {
+		return Header{}, nil, fmt.Errorf("%s messages must be Universal Non-Real-Time messages, received manufacturer ID % X: %w", name, sem.Manufacturer.Bytes(), ErrUnmarshallingMessage)
+	}
+	if len(sem.Data) < headerLength {
+		return Header{}, nil, fmt.Errorf("%s messages must contain a %d-byte header, received %d byte(s): %w", name, headerLength, len(sem.Data), ErrUnmarshallingMessage)
+	}
+	if sem.Data[1] != SubID || sem.Data[2] != subID {
+		return Header{}, nil, fmt.Errorf("%s messages must have sub-IDs %#x and %#x, received %#x and %#x: %w", name, SubID, subID, sem.Data[1], sem.Data[2], ErrUnmarshallingMessage)
+	}
+	header := Header{
+		DeviceID:    midiv1.DeviceID(sem.Data[0]),
+		Version:     sem.Data[3],
+		Source:      MUID(parseSevenBitValue(sem.Data[4:8])),
+		Destination: MUID(parseSevenBitValue(sem.Data[8:12])),
+	}
+	return header, sem.Data[headerLength:], nil
+}
+
+// checkPayloadLength returns an error if the payload of a MIDI-CI message is shorter than the supplied length. The name
+// is used to describe the message in any returned errors.
+func checkPayloadLength(name string, payload []byte, length int) error {
+	if len(payload) < length {
+		return fmt.Errorf("%s messages must contain at least %d payload bytes, received %d byte(s): %w", name, length, len(payload), ErrUnmarshallingMessage)
+	}
+	return nil
+}
+
+// appendSevenBitValue appends the value as n data bytes of 7 bits each, least significant byte first, as used by the
+// multi-byte fields of MIDI-CI messages.
+func appendSevenBitValue(b []byte, value uint32, n int) []byte {
+	for i := 0; i < n; i++ {
+		b = append(b, byte(value>>(7*i))&0x7F)
+	}
+	return b
+}
+
+// parseSevenBitValue returns the value of data bytes of 7 bits each, least significant byte first.
+func parseSevenBitValue(b []byte) uint32 {
+	var value uint32
+	for i := len(b) - 1; i >= 0; i-- {
+		value = value<<7 | uint32(b[i]&0x7F)
+	}
+	return value
+}
@@ -0,0 +1,260 @@
+package ci
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+func Test_NewMUID(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		muid     int
+		expected MUID
+		err      error
+	}{
+		"negative MUID is invalid": {
+			muid: -1,
+			err:  ErrInvalidMUID,
+		},
+		"reserved MUID is invalid": {
+			muid: int(MaxMUID) + 1,
+			err:  ErrInvalidMUID,
+		},
+		"broadcast MUID is invalid": {
+			muid: int(BroadcastMUID),
+			err:  ErrInvalidMUID,
+		},
+		"highest MUID is valid": {
+			muid:     int(MaxMUID),
+			expected: MaxMUID,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewMUID(test.muid)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if got != test.expected {
+				t.Fatalf("expected %s, got %s", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_NewRandomMUID(t *testing.T) {
+	t.Parallel()
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		if muid := NewRandomMUID(random); muid > MaxMUID {
+			t.Fatalf("expected a MUID no higher than %s, got %s", MaxMUID, muid)
+		}
+	}
+}
+
+func Test_MUID_String(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		muid     MUID
+		expected string
+	}{
+		"lowest MUID": {
+			muid:     MinMUID,
+			expected: "0x0000000",
+		},
+		"broadcast MUID": {
+			muid:     BroadcastMUID,
+			expected: "0xfffffff",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := test.muid.String(); got != test.expected {
+				t.Fatalf("expected %s, got %s", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_Category_Has(t *testing.T) {
+	t.Parallel()
+	category := ProfileConfigurationCategory | PropertyExchangeCategory
+	if !category.Has(PropertyExchangeCategory) {
+		t.Fatalf("expected %#x to have Property Exchange", category)
+	}
+	if category.Has(ProcessInquiryCategory) {
+		t.Fatalf("expected %#x not to have Process Inquiry", category)
+	}
+}
+
+func Test_Unmarshal(t *testing.T) {
+	t.Parallel()
+	initiator := Header{DeviceID: FunctionBlockDeviceID, Version: Version, Source: 0x01, Destination: 0x02}
+	responder := Header{DeviceID: FunctionBlockDeviceID, Version: Version, Source: 0x02, Destination: 0x01}
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage Message
+		err             error
+	}{
+		"non-MIDI-CI System Exclusive message returns an error": {
+			b:   []byte{0xF0, 0x7E, 0x7F, 0x06, 0x01, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"unsupported sub-ID #2 returns an unsupported error": {
+			b:   []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x40, 0x02, 0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0xF7},
+			err: ErrUnsupportedMessage,
+		},
+		"truncated header returns an error": {
+			b:   []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x20, 0x02, 0x01, 0x00, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"Discovery message": {
+			b: []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x70, 0x02, 0x01, 0x00, 0x00, 0x00, 0x7F, 0x7F, 0x7F, 0x7F, 0x41, 0x00, 0x00, 0x01, 0x00, 0x02, 0x00, 0x01, 0x00, 0x00, 0x00, 0x0C, 0x00, 0x02, 0x00, 0x00, 0x00, 0xF7},
+			expectedMessage: &DiscoveryMessage{
+				Header:                 Header{DeviceID: FunctionBlockDeviceID, Version: Version, Source: 0x01, Destination: BroadcastMUID},
+				Identity:               Identity{Manufacturer: midiv1.RolandManufacturerID, Family: 1, Model: 2, Version: [4]byte{0x01}},
+				Category:               ProfileConfigurationCategory | PropertyExchangeCategory,
+				MaxSystemExclusiveSize: 256,
+			},
+		},
+		"Reply to Discovery message": {
+			b: []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x71, 0x02, 0x02, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x43, 0x00, 0x00, 0x01, 0x00, 0x02, 0x00, 0x01, 0x00, 0x00, 0x00, 0x08, 0x00, 0x02, 0x00, 0x00, 0x00, 0x7F, 0xF7},
+			expectedMessage: &DiscoveryReplyMessage{
+				Header:                 responder,
+				Identity:               Identity{Manufacturer: midiv1.YamahaManufacturerID, Family: 1, Model: 2, Version: [4]byte{0x01}},
+				Category:               PropertyExchangeCategory,
+				MaxSystemExclusiveSize: 256,
+				FunctionBlock:          0x7F,
+			},
+		},
+		"Invalidate MUID message": {
+			b: []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x7E, 0x02, 0x03, 0x00, 0x00, 0x00, 0x7F, 0x7F, 0x7F, 0x7F, 0x01, 0x00, 0x00, 0x00, 0xF7},
+			expectedMessage: &InvalidateMUIDMessage{
+				Header: Header{DeviceID: FunctionBlockDeviceID, Version: Version, Source: 0x03, Destination: BroadcastMUID},
+				Target: 0x01,
+			},
+		},
+		"NAK message": {
+			b: []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x7F, 0x02, 0x02, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x20, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xF7},
+			expectedMessage: &NAKMessage{
+				Header:        responder,
+				OriginalSubID: ProfileInquirySubID,
+				Status:        NAKStatusUnsupported,
+			},
+		},
+		"Profile Inquiry message": {
+			b:               []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x20, 0x02, 0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0xF7},
+			expectedMessage: &ProfileInquiryMessage{Header: initiator},
+		},
+		"Reply to Profile Inquiry message": {
+			b: []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x21, 0x02, 0x02, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x01, 0x00, 0x7E, 0x00, 0x00, 0x01, 0x01, 0x00, 0x00, 0xF7},
+			expectedMessage: &ProfileInquiryReplyMessage{
+				Header:   responder,
+				Enabled:  []ProfileID{{0x7E, 0x00, 0x00, 0x01, 0x01}},
+				Disabled: []ProfileID{},
+			},
+		},
+		"Set Profile On message": {
+			b: []byte{0xF0, 0x7E, 0x00, 0x0D, 0x22, 0x02, 0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x7E, 0x00, 0x00, 0x01, 0x01, 0x00, 0x00, 0xF7},
+			expectedMessage: &SetProfileOnMessage{
+				Header:  Header{DeviceID: 0, Version: Version, Source: 0x01, Destination: 0x02},
+				Profile: ProfileID{0x7E, 0x00, 0x00, 0x01, 0x01},
+			},
+		},
+		"Set Profile Off message": {
+			b: []byte{0xF0, 0x7E, 0x00, 0x0D, 0x23, 0x02, 0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x7E, 0x00, 0x00, 0x01, 0x01, 0x00, 0x00, 0xF7},
+			expectedMessage: &SetProfileOffMessage{
+				Header:  Header{DeviceID: 0, Version: Version, Source: 0x01, Destination: 0x02},
+				Profile: ProfileID{0x7E, 0x00, 0x00, 0x01, 0x01},
+			},
+		},
+		"Profile Enabled Report message": {
+			b: []byte{0xF0, 0x7E, 0x00, 0x0D, 0x24, 0x02, 0x02, 0x00, 0x00, 0x00, 0x7F, 0x7F, 0x7F, 0x7F, 0x7E, 0x00, 0x00, 0x01, 0x01, 0x00, 0x00, 0xF7},
+			expectedMessage: &ProfileEnabledReportMessage{
+				Header:  Header{DeviceID: 0, Version: Version, Source: 0x02, Destination: BroadcastMUID},
+				Profile: ProfileID{0x7E, 0x00, 0x00, 0x01, 0x01},
+			},
+		},
+		"Profile Disabled Report message": {
+			b: []byte{0xF0, 0x7E, 0x00, 0x0D, 0x25, 0x02, 0x02, 0x00, 0x00, 0x00, 0x7F, 0x7F, 0x7F, 0x7F, 0x7E, 0x00, 0x00, 0x01, 0x01, 0x00, 0x00, 0xF7},
+			expectedMessage: &ProfileDisabledReportMessage{
+				Header:  Header{DeviceID: 0, Version: Version, Source: 0x02, Destination: BroadcastMUID},
+				Profile: ProfileID{0x7E, 0x00, 0x00, 0x01, 0x01},
+			},
+		},
+		"Inquiry: Property Exchange Capabilities message": {
+			b:               []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x30, 0x02, 0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0xF7},
+			expectedMessage: &PropertyExchangeCapabilitiesMessage{Header: initiator, SimultaneousRequests: 4},
+		},
+		"Reply to Property Exchange Capabilities message": {
+			b:               []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x31, 0x02, 0x02, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0xF7},
+			expectedMessage: &PropertyExchangeCapabilitiesReplyMessage{Header: responder, SimultaneousRequests: 1},
+		},
+		"Inquiry: Get Property Data message": {
+			b: []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x34, 0x02, 0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x01, 0x02, 0x00, 0x7B, 0x7D, 0x01, 0x00, 0x01, 0x00, 0x00, 0x00, 0xF7},
+			expectedMessage: &PropertyDataMessage{
+				Header:      initiator,
+				Transaction: GetPropertyTransaction,
+				RequestID:   1,
+				HeaderData:  []byte("{}"),
+				ChunkCount:  1,
+				ChunkNumber: 1,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := Unmarshal(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %#v, got %#v", test.expectedMessage, got)
+			}
+		})
+	}
+}
+
+func Test_parseSevenBitValue(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		value uint32
+		n     int
+		b     []byte
+	}{
+		"two bytes are LSB first": {
+			value: 0x3FFF,
+			n:     2,
+			b:     []byte{0x7F, 0x7F},
+		},
+		"four bytes hold a 28-bit value": {
+			value: 0x0ABCDEF,
+			n:     4,
+			b:     []byte{0x6F, 0x1B, 0x2F, 0x05},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := appendSevenBitValue(nil, test.value, test.n); !reflect.DeepEqual(got, test.b) {
+				t.Fatalf("expected %#v, got %#v", test.b, got)
+			}
+			if got := parseSevenBitValue(test.b); got != test.value {
+				t.Fatalf("expected %#x, got %#x", test.value, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,350 @@
+package ci
+
+import (
+	"fmt"
+)
+
+const (
+	// ProfileIDLength represents the number of bytes in a Profile ID.
+	ProfileIDLength int = 5
+
+	// StandardProfileBank represents the first byte of the Profile IDs defined by the MIDI Association.
+	StandardProfileBank byte = 0x7E
+
+	// maxProfileCount represents the highest number of Profiles in each list of a Reply to Profile Inquiry message,
+	// which is sent as two 7-bit data bytes.
+	maxProfileCount int = 0x3FFF
+)
+
+// ProfileID represents the five-byte identifier of a Profile. Standard Profiles start with 0x7E and the other Profiles
+// start with the manufacturer ID of the company that defined them.
+type ProfileID [ProfileIDLength]byte
+
+// String returns the hexadecimal representation of the Profile ID.
+func (p ProfileID) String() string {
+	return fmt.Sprintf("% X", p[:])
+}
+
+// ProfileInquiryMessage represents a MIDI-CI Profile Inquiry message, which asks a device which Profiles it supports
+// on the channel, group or Function Block of the device ID.
+type ProfileInquiryMessage struct {
+	Header
+}
+
+// GetMessageName returns the name of this Profile Inquiry message.
+func (pim *ProfileInquiryMessage) GetMessageName() string {
+	return "Profile Inquiry"
+}
+
+// MarshalMIDI marshalls a ProfileInquiryMessage MIDI-CI message into its raw bytes
+func (pim ProfileInquiryMessage) MarshalMIDI() ([]byte, error) {
+	return marshalMessage(pim.GetMessageName(), ProfileInquirySubID, pim.Header, nil)
+}
+
+// String returns the human-readable representation of the MIDI-CI message.
+func (pim *ProfileInquiryMessage) String() string {
+	return pim.Header.format(pim.GetMessageName())
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a ProfileInquiryMessage struct pointer. Profile Inquiry messages are
+// represented by the MIDI-CI header with sub-ID #2 0x20 and no payload.
+//
+// Example: []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x20, 0x02, 0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0xF7}
+//
+// The example forms a Profile Inquiry message from MUID 0x01 to MUID 0x02 for the whole Function Block.
+func (pim *ProfileInquiryMessage) UnmarshalMIDI(b []byte) error {
+	header, _, err := unmarshalMessage(pim.GetMessageName(), ProfileInquirySubID, b)
+	if err != nil {
+		return err
+	}
+	pim.Header = header
+	return nil
+}
+
+// ProfileInquiryReplyMessage represents a MIDI-CI Reply to Profile Inquiry message, which lists the Profiles that a
+// device supports on the channel, group or Function Block of the device ID.
+type ProfileInquiryReplyMessage struct {
+	Header
+
+	// Enabled represents the Profiles that are enabled.
+	Enabled []ProfileID
+
+	// Disabled represents the Profiles that are supported but disabled.
+	Disabled []ProfileID
+}
+
+// GetMessageName returns the name of this Reply to Profile Inquiry message.
+func (pirm *ProfileInquiryReplyMessage) GetMessageName() string {
+	return "Reply to Profile Inquiry"
+}
+
+// MarshalMIDI marshalls a ProfileInquiryReplyMessage MIDI-CI message into its raw bytes
+func (pirm ProfileInquiryReplyMessage) MarshalMIDI() ([]byte, error) {
+	if len(pirm.Enabled) > maxProfileCount || len(pirm.Disabled) > maxProfileCount {
+		return nil, fmt.Errorf("%s messages must list no more than %d enabled and %d disabled Profiles: %w", pirm.GetMessageName(), maxProfileCount, maxProfileCount, ErrMarshallingMessage)
+	}
+	payload := make([]byte, 0, 4+ProfileIDLength*(len(pirm.Enabled)+len(pirm.Disabled)))
+	payload = appendProfileIDs(payload, pirm.Enabled)
+	payload = appendProfileIDs(payload, pirm.Disabled)
+	return marshalMessage(pirm.GetMessageName(), ProfileInquiryReplySubID, pirm.Header, payload)
+}
+
+// String returns the human-readable representation of the MIDI-CI message.
+func (pirm *ProfileInquiryReplyMessage) String() string {
+	return pirm.Header.format(pirm.GetMessageName(), pirm.Enabled, pirm.Disabled)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a ProfileInquiryReplyMessage struct pointer. Reply to Profile Inquiry
+// messages are represented by (left to right): the MIDI-CI header with sub-ID #2 0x21, two enabled Profile count bytes,
+// five bytes per enabled Profile, two disabled Profile count bytes and five bytes per disabled Profile.
+//
+// Example: []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x21, 0x02, 0x02, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x01, 0x00, 0x7E, 0x00, 0x00, 0x01, 0x01, 0x00, 0x00, 0xF7}
+//
+// The example forms a Reply to Profile Inquiry message from MUID 0x02 to MUID 0x01 with one enabled Profile and no
+// disabled Profiles.
+func (pirm *ProfileInquiryReplyMessage) UnmarshalMIDI(b []byte) error {
+	header, payload, err := unmarshalMessage(pirm.GetMessageName(), ProfileInquiryReplySubID, b)
+	if err != nil {
+		return err
+	}
+	enabled, payload, err := parseProfileIDs(pirm.GetMessageName(), payload)
+	if err != nil {
+		return err
+	}
+	disabled, _, err := parseProfileIDs(pirm.GetMessageName(), payload)
+	if err != nil {
+		return err
+	}
+	pirm.Header, pirm.Enabled, pirm.Disabled = header, enabled, disabled
+	return nil
+}
+
+// appendProfileIDs appends the number of Profiles as two 7-bit data bytes followed by every Profile ID.
+func appendProfileIDs(b []byte, profiles []ProfileID) []byte {
+	b = appendSevenBitValue(b, uint32(len(profiles)), 2)
+	for _, profile := range profiles {
+		for _, p := range profile {
+			b = append(b, p&0x7F)
+		}
+	}
+	return b
+}
+
+// parseProfileIDs returns the counted list of Profile IDs at the start of the payload, and the rest of the payload.
+func parseProfileIDs(name string, payload []byte) ([]ProfileID, []byte, error) {
+	if err := checkPayloadLength(name, payload, 2); err != nil {
+		return nil, nil, err
+	}
+	count := int(parseSevenBitValue(payload[:2]))
+	payload = payload[2:]
+	if err := checkPayloadLength(name, payload, count*ProfileIDLength); err != nil {
+		return nil, nil, err
+	}
+	profiles := make([]ProfileID, count)
+	for i := range profiles {
+		copy(profiles[i][:], payload[i*ProfileIDLength:])
+	}
+	return profiles, payload[count*ProfileIDLength:], nil
+}
+
+// marshalProfileMessage returns the raw bytes of the message with the supplied name, sub-ID #2 and header that turns a
+// single Profile on or off, or reports that it has been.
+func marshalProfileMessage(name string, subID byte, header Header, profile ProfileID, channels uint16) ([]byte, error) {
+	if channels > MaxIdentityNumber {
+		return nil, fmt.Errorf("%s messages must have a channel count between 0 and %#x, inclusive: %w", name, MaxIdentityNumber, ErrMarshallingMessage)
+	}
+	payload := appendProfileIDs(nil, []ProfileID{profile})[2:]
+	return marshalMessage(name, subID, header, appendSevenBitValue(payload, uint32(channels), 2))
+}
+
+// unmarshalProfileMessage validates the raw bytes of a single Profile message with the supplied name and sub-ID #2 and
+// returns its header, Profile and channel count. MIDI-CI 1.1 messages without a channel count are accepted.
+func unmarshalProfileMessage(name string, subID byte, b []byte) (Header, ProfileID, uint16, error) {
+	header, payload, err := unmarshalMessage(name, subID, b)
+	if err != nil {
+		return Header{}, ProfileID{}, 0, err
+	}
+	if err := checkPayloadLength(name, payload, ProfileIDLength); err != nil {
+		return Header{}, ProfileID{}, 0, err
+	}
+	var profile ProfileID
+	copy(profile[:], payload)
+	var channels uint16
+	if len(payload) >= ProfileIDLength+2 {
+		channels = uint16(parseSevenBitValue(payload[ProfileIDLength : ProfileIDLength+2]))
+	}
+	return header, profile, channels, nil
+}
+
+// SetProfileOnMessage represents a MIDI-CI Set Profile On message, which asks a device to enable a Profile.
+type SetProfileOnMessage struct {
+	Header
+
+	// Profile represents the Profile that is being enabled.
+	Profile ProfileID
+
+	// Channels represents the number of channels that the Profile uses, starting from the channel of the device ID, or 0
+	// for the channel of the device ID alone.
+	Channels uint16
+}
+
+// GetMessageName returns the name of this Set Profile On message.
+func (spm *SetProfileOnMessage) GetMessageName() string {
+	return "Set Profile On"
+}
+
+// MarshalMIDI marshalls a SetProfileOnMessage MIDI-CI message into its raw bytes
+func (spm SetProfileOnMessage) MarshalMIDI() ([]byte, error) {
+	return marshalProfileMessage(spm.GetMessageName(), SetProfileOnSubID, spm.Header, spm.Profile, spm.Channels)
+}
+
+// String returns the human-readable representation of the MIDI-CI message.
+func (spm *SetProfileOnMessage) String() string {
+	return spm.Header.format(spm.GetMessageName(), spm.Profile, spm.Channels)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a SetProfileOnMessage struct pointer. Set Profile On messages are represented
+// by (left to right): the MIDI-CI header with sub-ID #2 0x22, five Profile ID bytes and two channel count bytes.
+//
+// Example: []byte{0xF0, 0x7E, 0x00, 0x0D, 0x22, 0x02, 0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x7E, 0x00, 0x00, 0x01, 0x01, 0x00, 0x00, 0xF7}
+//
+// The example forms a Set Profile On message from MUID 0x01 to MUID 0x02 that enables a standard Profile on channel 0.
+func (spm *SetProfileOnMessage) UnmarshalMIDI(b []byte) error {
+	header, profile, channels, err := unmarshalProfileMessage(spm.GetMessageName(), SetProfileOnSubID, b)
+	if err != nil {
+		return err
+	}
+	spm.Header, spm.Profile, spm.Channels = header, profile, channels
+	return nil
+}
+
+// SetProfileOffMessage represents a MIDI-CI Set Profile Off message, which asks a device to disable a Profile.
+type SetProfileOffMessage struct {
+	Header
+
+	// Profile represents the Profile that is being disabled.
+	Profile ProfileID
+
+	// Channels represents the number of channels that the Profile uses, starting from the channel of the device ID, or 0
+	// for the channel of the device ID alone.
+	Channels uint16
+}
+
+// GetMessageName returns the name of this Set Profile Off message.
+func (spm *SetProfileOffMessage) GetMessageName() string {
+	return "Set Profile Off"
+}
+
+// MarshalMIDI marshalls a SetProfileOffMessage MIDI-CI message into its raw bytes
+func (spm SetProfileOffMessage) MarshalMIDI() ([]byte, error) {
+	return marshalProfileMessage(spm.GetMessageName(), SetProfileOffSubID, spm.Header, spm.Profile, spm.Channels)
+}
+
+// String returns the human-readable representation of the MIDI-CI message.
+func (spm *SetProfileOffMessage) String() string {
+	return spm.Header.format(spm.GetMessageName(), spm.Profile, spm.Channels)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a SetProfileOffMessage struct pointer. Set Profile Off messages are
+// represented by (left to right): the MIDI-CI header with sub-ID #2 0x23, five Profile ID bytes and two channel count
+// bytes, which are always zero.
+//
+// Example: []byte{0xF0, 0x7E, 0x00, 0x0D, 0x23, 0x02, 0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x7E, 0x00, 0x00, 0x01, 0x01, 0x00, 0x00, 0xF7}
+//
+// The example forms a Set Profile Off message from MUID 0x01 to MUID 0x02 that disables a standard Profile on channel 0.
+func (spm *SetProfileOffMessage) UnmarshalMIDI(b []byte) error {
+	header, profile, channels, err := unmarshalProfileMessage(spm.GetMessageName(), SetProfileOffSubID, b)
+	if err != nil {
+		return err
+	}
+	spm.Header, spm.Profile, spm.Channels = header, profile, channels
+	return nil
+}
+
+// ProfileEnabledReportMessage represents a MIDI-CI Profile Enabled Report message, which a device broadcasts after it
+// enables a Profile.
+type ProfileEnabledReportMessage struct {
+	Header
+
+	// Profile represents the Profile that is enabled.
+	Profile ProfileID
+
+	// Channels represents the number of channels that the Profile uses, starting from the channel of the device ID, or 0
+	// for the channel of the device ID alone.
+	Channels uint16
+}
+
+// GetMessageName returns the name of this Profile Enabled Report message.
+func (prm *ProfileEnabledReportMessage) GetMessageName() string {
+	return "Profile Enabled Report"
+}
+
+// MarshalMIDI marshalls a ProfileEnabledReportMessage MIDI-CI message into its raw bytes
+func (prm ProfileEnabledReportMessage) MarshalMIDI() ([]byte, error) {
+	return marshalProfileMessage(prm.GetMessageName(), ProfileEnabledReportSubID, prm.Header, prm.Profile, prm.Channels)
+}
+
+// String returns the human-readable representation of the MIDI-CI message.
+func (prm *ProfileEnabledReportMessage) String() string {
+	return prm.Header.format(prm.GetMessageName(), prm.Profile, prm.Channels)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a ProfileEnabledReportMessage struct pointer. Profile Enabled Report messages
+// are represented by (left to right): the MIDI-CI header with sub-ID #2 0x24, five Profile ID bytes and two channel
+// count bytes.
+//
+// Example: []byte{0xF0, 0x7E, 0x00, 0x0D, 0x24, 0x02, 0x02, 0x00, 0x00, 0x00, 0x7F, 0x7F, 0x7F, 0x7F, 0x7E, 0x00, 0x00, 0x01, 0x01, 0x00, 0x00, 0xF7}
+//
+// The example forms a Profile Enabled Report message broadcast from MUID 0x02 for a standard Profile on channel 0.
+func (prm *ProfileEnabledReportMessage) UnmarshalMIDI(b []byte) error {
+	header, profile, channels, err := unmarshalProfileMessage(prm.GetMessageName(), ProfileEnabledReportSubID, b)
+	if err != nil {
+		return err
+	}
+	prm.Header, prm.Profile, prm.Channels = header, profile, channels
+	return nil
+}
+
+// ProfileDisabledReportMessage represents a MIDI-CI Profile Disabled Report message, which a device broadcasts after it
+// disables a Profile.
+type ProfileDisabledReportMessage struct {
+	Header
+
+	// Profile represents the Profile that is disabled.
+	Profile ProfileID
+
+	// Channels represents the number of channels that the Profile uses, starting from the channel of the device ID, or 0
+	// for the channel of the device ID alone.
+	Channels uint16
+}
+
+// GetMessageName returns the name of this Profile Disabled Report message.
+func (prm *ProfileDisabledReportMessage) GetMessageName() string {
+	return "Profile Disabled Report"
+}
+
+// MarshalMIDI marshalls a ProfileDisabledReportMessage MIDI-CI message into its raw bytes
+func (prm ProfileDisabledReportMessage) MarshalMIDI() ([]byte, error) {
+	return marshalProfileMessage(prm.GetMessageName(), ProfileDisabledReportSubID, prm.Header, prm.Profile, prm.Channels)
+}
+
+// String returns the human-readable representation of the MIDI-CI message.
+func (prm *ProfileDisabledReportMessage) String() string {
+	return prm.Header.format(prm.GetMessageName(), prm.Profile, prm.Channels)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a ProfileDisabledReportMessage struct pointer. Profile Disabled Report
+// messages are represented by (left to right): the MIDI-CI header with sub-ID #2 0x25, five Profile ID bytes and two
+// channel count bytes.
+//
+// Example: []byte{0xF0, 0x7E, 0x00, 0x0D, 0x25, 0x02, 0x02, 0x00, 0x00, 0x00, 0x7F, 0x7F, 0x7F, 0x7F, 0x7E, 0x00, 0x00, 0x01, 0x01, 0x00, 0x00, 0xF7}
+//
+// The example forms a Profile Disabled Report message broadcast from MUID 0x02 for a standard Profile on channel 0.
+func (prm *ProfileDisabledReportMessage) UnmarshalMIDI(b []byte) error {
+	header, profile, channels, err := unmarshalProfileMessage(prm.GetMessageName(), ProfileDisabledReportSubID, b)
+	if err != nil {
+		return err
+	}
+	prm.Header, prm.Profile, prm.Channels = header, profile, channels
+	return nil
+}
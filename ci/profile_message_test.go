@@ -0,0 +1,159 @@
+package ci
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_ProfileInquiryReplyMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	header := Header{DeviceID: FunctionBlockDeviceID, Version: Version, Source: 0x02, Destination: 0x01}
+	tests := map[string]struct {
+		message  ProfileInquiryReplyMessage
+		expected []byte
+		err      error
+	}{
+		"too many Profiles": {
+			message: ProfileInquiryReplyMessage{Enabled: make([]ProfileID, maxProfileCount+1)},
+			err:     ErrMarshallingMessage,
+		},
+		"message marshalls into expected bytes": {
+			message: ProfileInquiryReplyMessage{
+				Header:  header,
+				Enabled: []ProfileID{{0x7E, 0x00, 0x00, 0x01, 0x01}},
+			},
+			expected: []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x21, 0x02, 0x02, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x01, 0x00, 0x7E, 0x00, 0x00, 0x01, 0x01, 0x00, 0x00, 0xF7},
+		},
+		"message with disabled Profiles marshalls into expected bytes": {
+			message: ProfileInquiryReplyMessage{
+				Header:   header,
+				Disabled: []ProfileID{{0x7E, 0x00, 0x00, 0x01, 0x01}, {0x41, 0x00, 0x00, 0x02, 0x00}},
+			},
+			expected: []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x21, 0x02, 0x02, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x00, 0x7E, 0x00, 0x00, 0x01, 0x01, 0x41, 0x00, 0x00, 0x02, 0x00, 0xF7},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_ProfileInquiryReplyMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b   []byte
+		err error
+	}{
+		"enabled Profiles are truncated": {
+			b:   []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x21, 0x02, 0x02, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x7E, 0x00, 0x00, 0x01, 0x01, 0x00, 0x00, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"disabled Profile count is missing": {
+			b:   []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x21, 0x02, 0x02, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got ProfileInquiryReplyMessage
+			if err := got.UnmarshalMIDI(test.b); !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func Test_SetProfileOnMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  SetProfileOnMessage
+		expected []byte
+		err      error
+	}{
+		"channel count is out of range": {
+			message: SetProfileOnMessage{Channels: 0x4000},
+			err:     ErrMarshallingMessage,
+		},
+		"message marshalls into expected bytes": {
+			message: SetProfileOnMessage{
+				Header:  Header{DeviceID: 0, Version: Version, Source: 0x01, Destination: 0x02},
+				Profile: ProfileID{0x7E, 0x00, 0x00, 0x01, 0x01},
+			},
+			expected: []byte{0xF0, 0x7E, 0x00, 0x0D, 0x22, 0x02, 0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x7E, 0x00, 0x00, 0x01, 0x01, 0x00, 0x00, 0xF7},
+		},
+		"message with several channels marshalls into expected bytes": {
+			message: SetProfileOnMessage{
+				Header:   Header{DeviceID: 0, Version: Version, Source: 0x01, Destination: 0x02},
+				Profile:  ProfileID{0x7E, 0x00, 0x00, 0x01, 0x01},
+				Channels: 0x0100,
+			},
+			expected: []byte{0xF0, 0x7E, 0x00, 0x0D, 0x22, 0x02, 0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x7E, 0x00, 0x00, 0x01, 0x01, 0x00, 0x02, 0xF7},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_ProfileEnabledReportMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage ProfileEnabledReportMessage
+		err             error
+	}{
+		"Profile ID is truncated": {
+			b:   []byte{0xF0, 0x7E, 0x00, 0x0D, 0x24, 0x02, 0x02, 0x00, 0x00, 0x00, 0x7F, 0x7F, 0x7F, 0x7F, 0x7E, 0x00, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"MIDI-CI 1.1 message without a channel count": {
+			b: []byte{0xF0, 0x7E, 0x05, 0x0D, 0x24, 0x01, 0x02, 0x00, 0x00, 0x00, 0x7F, 0x7F, 0x7F, 0x7F, 0x7E, 0x00, 0x00, 0x01, 0x01, 0xF7},
+			expectedMessage: ProfileEnabledReportMessage{
+				Header:  Header{DeviceID: 5, Version: 0x01, Source: 0x02, Destination: BroadcastMUID},
+				Profile: ProfileID{0x7E, 0x00, 0x00, 0x01, 0x01},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got ProfileEnabledReportMessage
+			err := got.UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %#v, got %#v", test.expectedMessage, got)
+			}
+		})
+	}
+}
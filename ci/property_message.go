@@ -0,0 +1,382 @@
+package ci
+
+import (
+	"fmt"
+)
+
+const (
+	// PropertyExchangeMajorVersion represents the major version of Property Exchange supported by this package.
+	PropertyExchangeMajorVersion byte = 0x00
+
+	// PropertyExchangeMinorVersion represents the minor version of Property Exchange supported by this package.
+	PropertyExchangeMinorVersion byte = 0x00
+
+	// MaxPropertyRequestID represents the highest request ID of a Property Exchange transaction.
+	MaxPropertyRequestID byte = 0x7F
+
+	// MaxPropertyChunks represents the highest number of chunks in a Property Exchange message body.
+	MaxPropertyChunks uint16 = 0x3FFF
+
+	// propertyDataFieldsLength represents the number of payload bytes of a Property Exchange data message other than its
+	// header and body data: the request ID, the header length, the chunk count, the chunk number and the data length.
+	propertyDataFieldsLength int = 9
+)
+
+// PropertyTransaction represents the sub-ID #2 of a Property Exchange data message, which identifies its part in a
+// transaction.
+type PropertyTransaction byte
+
+const (
+	// GetPropertyTransaction represents an Inquiry: Get Property Data message.
+	GetPropertyTransaction PropertyTransaction = 0x34
+
+	// GetPropertyReplyTransaction represents a Reply to Get Property Data message.
+	GetPropertyReplyTransaction PropertyTransaction = 0x35
+
+	// SetPropertyTransaction represents an Inquiry: Set Property Data message.
+	SetPropertyTransaction PropertyTransaction = 0x36
+
+	// SetPropertyReplyTransaction represents a Reply to Set Property Data message.
+	SetPropertyReplyTransaction PropertyTransaction = 0x37
+
+	// SubscriptionTransaction represents an Inquiry: Subscription message.
+	SubscriptionTransaction PropertyTransaction = 0x38
+
+	// SubscriptionReplyTransaction represents a Reply to Subscription message.
+	SubscriptionReplyTransaction PropertyTransaction = 0x39
+
+	// NotifyTransaction represents a Notify message.
+	NotifyTransaction PropertyTransaction = 0x3F
+)
+
+// propertyTransactionNames maps each Property Exchange transaction to the name of its message.
+var propertyTransactionNames = map[PropertyTransaction]string{
+	GetPropertyTransaction:       "Inquiry: Get Property Data",
+	GetPropertyReplyTransaction:  "Reply to Get Property Data",
+	SetPropertyTransaction:       "Inquiry: Set Property Data",
+	SetPropertyReplyTransaction:  "Reply to Set Property Data",
+	SubscriptionTransaction:      "Inquiry: Subscription",
+	SubscriptionReplyTransaction: "Reply to Subscription",
+	NotifyTransaction:            "Notify",
+}
+
+// IsReply returns whether the transaction is a reply to an inquiry.
+func (pt PropertyTransaction) IsReply() bool {
+	return pt == GetPropertyReplyTransaction || pt == SetPropertyReplyTransaction || pt == SubscriptionReplyTransaction
+}
+
+// valid returns whether the transaction is a supported Property Exchange data message.
+func (pt PropertyTransaction) valid() bool {
+	_, ok := propertyTransactionNames[pt]
+	return ok
+}
+
+// PropertyExchangeCapabilitiesMessage represents a MIDI-CI Inquiry: Property Exchange Capabilities message, which asks
+// a device how many Property Exchange transactions it can handle at once.
+type PropertyExchangeCapabilitiesMessage struct {
+	Header
+
+	// SimultaneousRequests represents the number of transactions that the Initiator can handle at once.
+	SimultaneousRequests byte
+
+	// MajorVersion represents the major version of Property Exchange that the Initiator supports.
+	MajorVersion byte
+
+	// MinorVersion represents the minor version of Property Exchange that the Initiator supports.
+	MinorVersion byte
+}
+
+// GetMessageName returns the name of this Inquiry: Property Exchange Capabilities message.
+func (pcm *PropertyExchangeCapabilitiesMessage) GetMessageName() string {
+	return "Inquiry: Property Exchange Capabilities"
+}
+
+// MarshalMIDI marshalls a PropertyExchangeCapabilitiesMessage MIDI-CI message into its raw bytes
+func (pcm PropertyExchangeCapabilitiesMessage) MarshalMIDI() ([]byte, error) {
+	payload := []byte{pcm.SimultaneousRequests & 0x7F, pcm.MajorVersion & 0x7F, pcm.MinorVersion & 0x7F}
+	return marshalMessage(pcm.GetMessageName(), PropertyExchangeCapabilitiesSubID, pcm.Header, payload)
+}
+
+// String returns the human-readable representation of the MIDI-CI message.
+func (pcm *PropertyExchangeCapabilitiesMessage) String() string {
+	return pcm.Header.format(pcm.GetMessageName(), pcm.SimultaneousRequests, pcm.MajorVersion, pcm.MinorVersion)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a PropertyExchangeCapabilitiesMessage struct pointer. Inquiry: Property
+// Exchange Capabilities messages are represented by (left to right): the MIDI-CI header with sub-ID #2 0x30, the
+// simultaneous requests byte and, from MIDI-CI 1.2, the major and minor version bytes.
+//
+// Example: []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x30, 0x02, 0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0xF7}
+//
+// The example forms an Inquiry: Property Exchange Capabilities message from MUID 0x01 to MUID 0x02 for an Initiator
+// that handles four transactions at once.
+func (pcm *PropertyExchangeCapabilitiesMessage) UnmarshalMIDI(b []byte) error {
+	header, requests, major, minor, err := unmarshalPropertyExchangeCapabilities(pcm.GetMessageName(), PropertyExchangeCapabilitiesSubID, b)
+	if err != nil {
+		return err
+	}
+	pcm.Header, pcm.SimultaneousRequests, pcm.MajorVersion, pcm.MinorVersion = header, requests, major, minor
+	return nil
+}
+
+// PropertyExchangeCapabilitiesReplyMessage represents a MIDI-CI Reply to Property Exchange Capabilities message, which
+// tells the Initiator how many Property Exchange transactions the Responder can handle at once.
+type PropertyExchangeCapabilitiesReplyMessage struct {
+	Header
+
+	// SimultaneousRequests represents the number of transactions that the Responder can handle at once.
+	SimultaneousRequests byte
+
+	// MajorVersion represents the major version of Property Exchange that the Responder supports.
+	MajorVersion byte
+
+	// MinorVersion represents the minor version of Property Exchange that the Responder supports.
+	MinorVersion byte
+}
+
+// GetMessageName returns the name of this Reply to Property Exchange Capabilities message.
+func (pcrm *PropertyExchangeCapabilitiesReplyMessage) GetMessageName() string {
+	return "Reply to Property Exchange Capabilities"
+}
+
+// MarshalMIDI marshalls a PropertyExchangeCapabilitiesReplyMessage MIDI-CI message into its raw bytes
+func (pcrm PropertyExchangeCapabilitiesReplyMessage) MarshalMIDI() ([]byte, error) {
+	payload := []byte{pcrm.SimultaneousRequests & 0x7F, pcrm.MajorVersion & 0x7F, pcrm.MinorVersion & 0x7F}
+	return marshalMessage(pcrm.GetMessageName(), PropertyExchangeCapabilitiesReplySubID, pcrm.Header, payload)
+}
+
+// String returns the human-readable representation of the MIDI-CI message.
+func (pcrm *PropertyExchangeCapabilitiesReplyMessage) String() string {
+	return pcrm.Header.format(pcrm.GetMessageName(), pcrm.SimultaneousRequests, pcrm.MajorVersion, pcrm.MinorVersion)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a PropertyExchangeCapabilitiesReplyMessage struct pointer. Reply to Property
+// Exchange Capabilities messages are represented by (left to right): the MIDI-CI header with sub-ID #2 0x31, the
+// simultaneous requests byte and, from MIDI-CI 1.2, the major and minor version bytes.
+//
+// Example: []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x31, 0x02, 0x02, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0xF7}
+//
+// The example forms a Reply to Property Exchange Capabilities message from MUID 0x02 to MUID 0x01 for a Responder that
+// handles one transaction at a time.
+func (pcrm *PropertyExchangeCapabilitiesReplyMessage) UnmarshalMIDI(b []byte) error {
+	header, requests, major, minor, err := unmarshalPropertyExchangeCapabilities(pcrm.GetMessageName(), PropertyExchangeCapabilitiesReplySubID, b)
+	if err != nil {
+		return err
+	}
+	pcrm.Header, pcrm.SimultaneousRequests, pcrm.MajorVersion, pcrm.MinorVersion = header, requests, major, minor
+	return nil
+}
+
+// unmarshalPropertyExchangeCapabilities validates the raw bytes of a Property Exchange Capabilities message with the
+// supplied name and sub-ID #2 and returns its header, simultaneous requests and versions. MIDI-CI 1.1 messages without
+// versions are accepted.
+func unmarshalPropertyExchangeCapabilities(name string, subID byte, b []byte) (Header, byte, byte, byte, error) {
+	header, payload, err := unmarshalMessage(name, subID, b)
+	if err != nil {
+		return Header{}, 0, 0, 0, err
+	}
+	if err := checkPayloadLength(name, payload, 1); err != nil {
+		return Header{}, 0, 0, 0, err
+	}
+	var major, minor byte
+	if len(payload) >= 3 {
+		major, minor = payload[1], payload[2]
+	}
+	return header, payload[0], major, minor, nil
+}
+
+// PropertyDataMessage represents one chunk of a MIDI-CI Property Exchange data message, which carries a JSON header
+// and part of a body. Bodies that do not fit in a single System Exclusive message are split across chunks with the same
+// request ID, and only the first chunk carries the header.
+type PropertyDataMessage struct {
+	Header
+
+	// Transaction represents the kind of the Property Exchange data message.
+	Transaction PropertyTransaction
+
+	// RequestID represents the request ID that links the chunks of an inquiry and its reply, between 0 and 0x7F
+	// inclusive.
+	RequestID byte
+
+	// HeaderData represents the JSON header of the message.
+	HeaderData []byte
+
+	// ChunkCount represents the number of chunks in the body, or 0 while the sender does not yet know the count.
+	ChunkCount uint16
+
+	// ChunkNumber represents the number of this chunk, starting from 1.
+	ChunkNumber uint16
+
+	// Data represents this chunk of the body.
+	Data []byte
+}
+
+// NewPropertyDataMessages returns the chunks of a Property Exchange data message that carry the header and body, with
+// each chunk small enough for a System Exclusive message of the supplied maximum size. A maximum size of 0 sends the body
+// in a single chunk.
+func NewPropertyDataMessages(header Header, transaction PropertyTransaction, requestID byte, headerData []byte, body []byte, maxSystemExclusiveSize int) ([]PropertyDataMessage, error) {
+	chunkSize := len(body)
+	if maxSystemExclusiveSize > 0 {
+		chunkSize = maxSystemExclusiveSize - framingLength - propertyDataFieldsLength - len(headerData)
+		if chunkSize <= 0 {
+			return nil, fmt.Errorf("property data headers of %d bytes do not fit in System Exclusive messages of %d bytes: %w", len(headerData), maxSystemExclusiveSize, ErrMarshallingMessage)
+		}
+	}
+	count := 1
+	if len(body) > chunkSize {
+		count = (len(body) + chunkSize - 1) / chunkSize
+	}
+	if count > int(MaxPropertyChunks) {
+		return nil, fmt.Errorf("property data bodies must be sent in no more than %d chunks, requiring %d: %w", MaxPropertyChunks, count, ErrMarshallingMessage)
+	}
+
+	messages := make([]PropertyDataMessage, count)
+	for i := range messages {
+		messages[i] = PropertyDataMessage{
+			Header:      header,
+			Transaction: transaction,
+			RequestID:   requestID,
+			ChunkCount:  uint16(count),
+			ChunkNumber: uint16(i + 1),
+		}
+		if i == 0 {
+			messages[i].HeaderData = headerData
+		}
+		start, end := i*chunkSize, (i+1)*chunkSize
+		if end > len(body) {
+			end = len(body)
+		}
+		messages[i].Data = body[start:end]
+	}
+	return messages, nil
+}
+
+// GetMessageName returns the name of this Property Exchange data message.
+func (pdm *PropertyDataMessage) GetMessageName() string {
+	if name, ok := propertyTransactionNames[pdm.Transaction]; ok {
+		return name
+	}
+	return "Property Data"
+}
+
+// MarshalMIDI marshalls a PropertyDataMessage MIDI-CI message into its raw bytes
+func (pdm PropertyDataMessage) MarshalMIDI() ([]byte, error) {
+	if !pdm.Transaction.valid() {
+		return nil, fmt.Errorf("%s messages must have a supported transaction, received %#x: %w", pdm.GetMessageName(), byte(pdm.Transaction), ErrMarshallingMessage)
+	}
+	if pdm.RequestID > MaxPropertyRequestID {
+		return nil, fmt.Errorf("%s messages must have a request ID between 0 and %#x, inclusive: %w", pdm.GetMessageName(), MaxPropertyRequestID, ErrMarshallingMessage)
+	}
+	if pdm.ChunkCount > MaxPropertyChunks || pdm.ChunkNumber > MaxPropertyChunks {
+		return nil, fmt.Errorf("%s messages must have chunk counts and numbers between 0 and %#x, inclusive: %w", pdm.GetMessageName(), MaxPropertyChunks, ErrMarshallingMessage)
+	}
+	if len(pdm.HeaderData) > int(MaxPropertyChunks) || len(pdm.Data) > int(MaxPropertyChunks) {
+		return nil, fmt.Errorf("%s messages must have headers and data of no more than %d bytes: %w", pdm.GetMessageName(), MaxPropertyChunks, ErrMarshallingMessage)
+	}
+
+	payload := make([]byte, 0, propertyDataFieldsLength+len(pdm.HeaderData)+len(pdm.Data))
+	payload = append(payload, pdm.RequestID)
+	payload = appendSevenBitValue(payload, uint32(len(pdm.HeaderData)), 2)
+	payload = append(payload, pdm.HeaderData...)
+	payload = appendSevenBitValue(payload, uint32(pdm.ChunkCount), 2)
+	payload = appendSevenBitValue(payload, uint32(pdm.ChunkNumber), 2)
+	payload = appendSevenBitValue(payload, uint32(len(pdm.Data)), 2)
+	payload = append(payload, pdm.Data...)
+	return marshalMessage(pdm.GetMessageName(), byte(pdm.Transaction), pdm.Header, payload)
+}
+
+// String returns the human-readable representation of the MIDI-CI message.
+func (pdm *PropertyDataMessage) String() string {
+	return pdm.Header.format(pdm.GetMessageName(), pdm.RequestID, string(pdm.HeaderData), pdm.ChunkNumber, pdm.ChunkCount, string(pdm.Data))
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a PropertyDataMessage struct pointer. Property Exchange data messages are
+// represented by (left to right): the MIDI-CI header with a sub-ID #2 between 0x34 and 0x39 or 0x3F, the request ID
+// byte, two header length bytes, the header, two chunk count bytes, two chunk number bytes, two data length bytes and
+// the data.
+//
+// Example: []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x34, 0x02, 0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x01, 0x02, 0x00, 0x7B, 0x7D, 0x01, 0x00, 0x01, 0x00, 0x00, 0x00, 0xF7}
+//
+// The example forms an Inquiry: Get Property Data message from MUID 0x01 to MUID 0x02 with request ID 1, the header {}
+// and no data.
+func (pdm *PropertyDataMessage) UnmarshalMIDI(b []byte) error {
+	if len(b) < 5 || !PropertyTransaction(b[4]).valid() {
+		return fmt.Errorf("property data messages must have a sub-ID #2 between %#x and %#x or %#x: %w", GetPropertyTransaction, SubscriptionReplyTransaction, NotifyTransaction, ErrUnmarshallingMessage)
+	}
+	transaction := PropertyTransaction(b[4])
+	name := propertyTransactionNames[transaction]
+	header, payload, err := unmarshalMessage(name, byte(transaction), b)
+	if err != nil {
+		return err
+	}
+	if err := checkPayloadLength(name, payload, propertyDataFieldsLength); err != nil {
+		return err
+	}
+	headerLength := int(parseSevenBitValue(payload[1:3]))
+	if err := checkPayloadLength(name, payload, propertyDataFieldsLength+headerLength); err != nil {
+		return err
+	}
+	fields := payload[3+headerLength:]
+	dataLength := int(parseSevenBitValue(fields[4:6]))
+	if err := checkPayloadLength(name, payload, propertyDataFieldsLength+headerLength+dataLength); err != nil {
+		return err
+	}
+
+	*pdm = PropertyDataMessage{
+		Header:      header,
+		Transaction: transaction,
+		RequestID:   payload[0],
+		HeaderData:  append([]byte(nil), payload[3:3+headerLength]...),
+		ChunkCount:  uint16(parseSevenBitValue(fields[0:2])),
+		ChunkNumber: uint16(parseSevenBitValue(fields[2:4])),
+		Data:        append([]byte(nil), fields[6:6+dataLength]...),
+	}
+	return nil
+}
+
+// PropertyAssembler collects the chunks of Property Exchange data messages into complete headers and bodies. Chunks
+// are matched by the source MUID, transaction and request ID of their messages, so an inquiry and a reply from the same
+// device never share a body even when their request IDs are the same. It is not safe for concurrent use.
+type PropertyAssembler struct {
+	pending map[propertyKey]*PropertyDataMessage
+}
+
+// propertyKey identifies the chunks of a single Property Exchange data message.
+type propertyKey struct {
+	source      MUID
+	transaction PropertyTransaction
+	requestID   byte
+}
+
+// NewPropertyAssembler returns an empty PropertyAssembler.
+func NewPropertyAssembler() *PropertyAssembler {
+	return &PropertyAssembler{pending: map[propertyKey]*PropertyDataMessage{}}
+}
+
+// Add adds a chunk and returns the complete message, with the header of the first chunk and the data of every chunk,
+// once its last chunk has been added. Chunks must be added in order.
+func (pa *PropertyAssembler) Add(chunk PropertyDataMessage) (PropertyDataMessage, bool, error) {
+	key := propertyKey{source: chunk.Source, transaction: chunk.Transaction, requestID: chunk.RequestID}
+	message, ok := pa.pending[key]
+	if chunk.ChunkNumber <= 1 {
+		message = &PropertyDataMessage{
+			Header:      chunk.Header,
+			Transaction: chunk.Transaction,
+			RequestID:   chunk.RequestID,
+			HeaderData:  chunk.HeaderData,
+		}
+		pa.pending[key] = message
+	} else if !ok || chunk.ChunkNumber != message.ChunkNumber+1 {
+		delete(pa.pending, key)
+		return PropertyDataMessage{}, false, fmt.Errorf("%s chunk %d of request ID %d from %s arrived out of order: %w", chunk.GetMessageName(), chunk.ChunkNumber, chunk.RequestID, chunk.Source, ErrUnmarshallingMessage)
+	}
+
+	message.ChunkNumber = chunk.ChunkNumber
+	message.ChunkCount = chunk.ChunkCount
+	message.Data = append(message.Data, chunk.Data...)
+	if chunk.ChunkCount == 0 || chunk.ChunkNumber < chunk.ChunkCount {
+		return PropertyDataMessage{}, false, nil
+	}
+	delete(pa.pending, key)
+	return *message, true, nil
+}
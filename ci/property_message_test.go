@@ -0,0 +1,237 @@
+package ci
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_PropertyDataMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	header := Header{DeviceID: FunctionBlockDeviceID, Version: Version, Source: 0x01, Destination: 0x02}
+	tests := map[string]struct {
+		message  PropertyDataMessage
+		expected []byte
+		err      error
+	}{
+		"transaction is not supported": {
+			message: PropertyDataMessage{Transaction: 0x3A},
+			err:     ErrMarshallingMessage,
+		},
+		"request ID is out of range": {
+			message: PropertyDataMessage{Transaction: GetPropertyTransaction, RequestID: 0x80},
+			err:     ErrMarshallingMessage,
+		},
+		"data is not 7-bit": {
+			message: PropertyDataMessage{Transaction: GetPropertyReplyTransaction, Data: []byte{0x80}},
+			err:     ErrMarshallingMessage,
+		},
+		"message marshalls into expected bytes": {
+			message: PropertyDataMessage{
+				Header:      header,
+				Transaction: GetPropertyTransaction,
+				RequestID:   1,
+				HeaderData:  []byte("{}"),
+				ChunkCount:  1,
+				ChunkNumber: 1,
+			},
+			expected: []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x34, 0x02, 0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x01, 0x02, 0x00, 0x7B, 0x7D, 0x01, 0x00, 0x01, 0x00, 0x00, 0x00, 0xF7},
+		},
+		"message with data marshalls into expected bytes": {
+			message: PropertyDataMessage{
+				Header:      header,
+				Transaction: SetPropertyTransaction,
+				RequestID:   0x7F,
+				ChunkCount:  2,
+				ChunkNumber: 2,
+				Data:        []byte("[]"),
+			},
+			expected: []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x36, 0x02, 0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x7F, 0x00, 0x00, 0x02, 0x00, 0x02, 0x00, 0x02, 0x00, 0x5B, 0x5D, 0xF7},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_PropertyDataMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b   []byte
+		err error
+	}{
+		"sub-ID #2 is not a Property Exchange data message": {
+			b:   []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x30, 0x02, 0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x01, 0x02, 0x00, 0x7B, 0x7D, 0x01, 0x00, 0x01, 0x00, 0x00, 0x00, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"header is longer than the payload": {
+			b:   []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x34, 0x02, 0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x01, 0x10, 0x00, 0x7B, 0x7D, 0x01, 0x00, 0x01, 0x00, 0x00, 0x00, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"data is longer than the payload": {
+			b:   []byte{0xF0, 0x7E, 0x7F, 0x0D, 0x35, 0x02, 0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x01, 0x02, 0x00, 0x7B, 0x7D, 0x01, 0x00, 0x01, 0x00, 0x02, 0x00, 0x5B, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got PropertyDataMessage
+			if err := got.UnmarshalMIDI(test.b); !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func Test_NewPropertyDataMessages(t *testing.T) {
+	t.Parallel()
+	header := Header{DeviceID: FunctionBlockDeviceID, Version: Version, Source: 0x01, Destination: 0x02}
+	headerData := []byte(`{"status":200}`)
+	tests := map[string]struct {
+		body             []byte
+		maxSize          int
+		expectedMessages []PropertyDataMessage
+		err              error
+	}{
+		"header does not fit": {
+			maxSize: framingLength + propertyDataFieldsLength + len(headerData),
+			err:     ErrMarshallingMessage,
+		},
+		"empty body is sent in one chunk": {
+			maxSize: 128,
+			expectedMessages: []PropertyDataMessage{
+				{Header: header, Transaction: GetPropertyReplyTransaction, RequestID: 3, HeaderData: headerData, ChunkCount: 1, ChunkNumber: 1, Data: []byte{}},
+			},
+		},
+		"unlimited size sends the body in one chunk": {
+			body: []byte("0123456789"),
+			expectedMessages: []PropertyDataMessage{
+				{Header: header, Transaction: GetPropertyReplyTransaction, RequestID: 3, HeaderData: headerData, ChunkCount: 1, ChunkNumber: 1, Data: []byte("0123456789")},
+			},
+		},
+		"body is split into chunks that fit": {
+			body:    []byte("0123456789"),
+			maxSize: framingLength + propertyDataFieldsLength + len(headerData) + 4,
+			expectedMessages: []PropertyDataMessage{
+				{Header: header, Transaction: GetPropertyReplyTransaction, RequestID: 3, HeaderData: headerData, ChunkCount: 3, ChunkNumber: 1, Data: []byte("0123")},
+				{Header: header, Transaction: GetPropertyReplyTransaction, RequestID: 3, ChunkCount: 3, ChunkNumber: 2, Data: []byte("4567")},
+				{Header: header, Transaction: GetPropertyReplyTransaction, RequestID: 3, ChunkCount: 3, ChunkNumber: 3, Data: []byte("89")},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewPropertyDataMessages(header, GetPropertyReplyTransaction, 3, headerData, test.body, test.maxSize)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if len(test.expectedMessages) != len(got) {
+				t.Fatalf("expected %d messages, got %d", len(test.expectedMessages), len(got))
+			}
+			for i := range got {
+				if !bytes.Equal(test.expectedMessages[i].Data, got[i].Data) || !bytes.Equal(test.expectedMessages[i].HeaderData, got[i].HeaderData) {
+					t.Fatalf("expected %#v, got %#v", test.expectedMessages[i], got[i])
+				}
+				test.expectedMessages[i].Data, test.expectedMessages[i].HeaderData = got[i].Data, got[i].HeaderData
+				if !reflect.DeepEqual(test.expectedMessages[i], got[i]) {
+					t.Fatalf("expected %#v, got %#v", test.expectedMessages[i], got[i])
+				}
+				if b, err := got[i].MarshalMIDI(); test.maxSize > 0 && (err != nil || len(b) > test.maxSize) {
+					t.Fatalf("expected a message of no more than %d bytes, got %d bytes and %v error", test.maxSize, len(b), err)
+				}
+			}
+		})
+	}
+}
+
+func Test_PropertyAssembler_Add(t *testing.T) {
+	t.Parallel()
+	header := Header{DeviceID: FunctionBlockDeviceID, Version: Version, Source: 0x01, Destination: 0x02}
+	other := Header{DeviceID: FunctionBlockDeviceID, Version: Version, Source: 0x03, Destination: 0x02}
+	tests := map[string]struct {
+		chunks          []PropertyDataMessage
+		expectedMessage PropertyDataMessage
+		complete        bool
+		err             error
+	}{
+		"single chunk is complete": {
+			chunks: []PropertyDataMessage{
+				{Header: header, Transaction: GetPropertyTransaction, RequestID: 1, HeaderData: []byte("{}"), ChunkCount: 1, ChunkNumber: 1},
+			},
+			expectedMessage: PropertyDataMessage{Header: header, Transaction: GetPropertyTransaction, RequestID: 1, HeaderData: []byte("{}"), ChunkCount: 1, ChunkNumber: 1},
+			complete:        true,
+		},
+		"chunks are joined in order, even when interleaved with another source": {
+			chunks: []PropertyDataMessage{
+				{Header: header, Transaction: SetPropertyTransaction, RequestID: 1, HeaderData: []byte("{}"), ChunkCount: 2, ChunkNumber: 1, Data: []byte("[1,")},
+				{Header: other, Transaction: SetPropertyTransaction, RequestID: 1, HeaderData: []byte("{}"), ChunkCount: 2, ChunkNumber: 1, Data: []byte("{")},
+				{Header: header, Transaction: SetPropertyTransaction, RequestID: 1, ChunkCount: 2, ChunkNumber: 2, Data: []byte("2]")},
+			},
+			expectedMessage: PropertyDataMessage{Header: header, Transaction: SetPropertyTransaction, RequestID: 1, HeaderData: []byte("{}"), ChunkCount: 2, ChunkNumber: 2, Data: []byte("[1,2]")},
+			complete:        true,
+		},
+		"inquiry and reply with the same request ID are kept apart": {
+			chunks: []PropertyDataMessage{
+				{Header: header, Transaction: SetPropertyTransaction, RequestID: 1, HeaderData: []byte("{}"), ChunkCount: 2, ChunkNumber: 1, Data: []byte("[1,")},
+				{Header: header, Transaction: GetPropertyReplyTransaction, RequestID: 1, HeaderData: []byte("{}"), ChunkCount: 2, ChunkNumber: 1, Data: []byte("{")},
+				{Header: header, Transaction: SetPropertyTransaction, RequestID: 1, ChunkCount: 2, ChunkNumber: 2, Data: []byte("2]")},
+			},
+			expectedMessage: PropertyDataMessage{Header: header, Transaction: SetPropertyTransaction, RequestID: 1, HeaderData: []byte("{}"), ChunkCount: 2, ChunkNumber: 2, Data: []byte("[1,2]")},
+			complete:        true,
+		},
+		"unknown chunk count waits for more chunks": {
+			chunks: []PropertyDataMessage{
+				{Header: header, Transaction: GetPropertyReplyTransaction, RequestID: 1, HeaderData: []byte("{}"), ChunkNumber: 1, Data: []byte("[")},
+			},
+		},
+		"missing chunk returns an error": {
+			chunks: []PropertyDataMessage{
+				{Header: header, Transaction: GetPropertyReplyTransaction, RequestID: 1, HeaderData: []byte("{}"), ChunkCount: 3, ChunkNumber: 1, Data: []byte("[")},
+				{Header: header, Transaction: GetPropertyReplyTransaction, RequestID: 1, ChunkCount: 3, ChunkNumber: 3, Data: []byte("]")},
+			},
+			err: ErrUnmarshallingMessage,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assembler := NewPropertyAssembler()
+			var got PropertyDataMessage
+			var complete bool
+			var err error
+			for _, chunk := range test.chunks {
+				got, complete, err = assembler.Add(chunk)
+			}
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if test.complete != complete {
+				t.Fatalf("expected complete %t, got %t", test.complete, complete)
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %#v, got %#v", test.expectedMessage, got)
+			}
+		})
+	}
+}
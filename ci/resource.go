@@ -0,0 +1,173 @@
+package ci
+
+import (
+	"encoding/json"
+	"fmt"
+	"unicode/utf16"
+)
+
+const (
+	// ResourceListResource represents the name of the resource that lists every resource of a device.
+	ResourceListResource string = "ResourceList"
+
+	// DeviceInfoResource represents the name of the resource that describes a device.
+	DeviceInfoResource string = "DeviceInfo"
+)
+
+const (
+	// StatusOK represents a Property Exchange inquiry that succeeded.
+	StatusOK int = 200
+
+	// StatusAccepted represents a Property Exchange inquiry that was accepted but not yet completed.
+	StatusAccepted int = 202
+
+	// StatusBadData represents a Property Exchange inquiry with a header or body that could not be used.
+	StatusBadData int = 400
+
+	// StatusNotFound represents a Property Exchange inquiry for a resource that does not exist.
+	StatusNotFound int = 404
+
+	// StatusNotSupported represents a Property Exchange inquiry that the resource does not support.
+	StatusNotSupported int = 405
+
+	// StatusInternalError represents a Property Exchange inquiry that failed inside the device.
+	StatusInternalError int = 500
+)
+
+const (
+	// CanSetNone represents a resource that cannot be set.
+	CanSetNone string = "none"
+
+	// CanSetFull represents a resource that can be set by replacing its whole body.
+	CanSetFull string = "full"
+)
+
+// RequestHeader represents the JSON header of a Property Exchange inquiry.
+type RequestHeader struct {
+	// Resource represents the name of the resource.
+	Resource string `json:"resource"`
+
+	// ResID represents the ID of a single item of a resource that lists several.
+	ResID string `json:"resId,omitempty"`
+}
+
+// ReplyHeader represents the JSON header of a reply to a Property Exchange inquiry.
+type ReplyHeader struct {
+	// Status represents the outcome of the inquiry, such as StatusOK.
+	Status int `json:"status"`
+
+	// Message represents a human-readable explanation of the status.
+	Message string `json:"message,omitempty"`
+}
+
+// ResourceListEntry represents a resource of a device, as listed by the ResourceList resource.
+type ResourceListEntry struct {
+	// Resource represents the name of the resource.
+	Resource string `json:"resource"`
+
+	// CanGet represents whether the resource can be read. Readers should treat a missing value as true.
+	CanGet *bool `json:"canGet,omitempty"`
+
+	// CanSet represents whether the resource can be set, such as CanSetNone or CanSetFull. Readers should treat a missing
+	// value as CanSetNone.
+	CanSet string `json:"canSet,omitempty"`
+
+	// CanSubscribe represents whether the resource can be subscribed to.
+	CanSubscribe bool `json:"canSubscribe,omitempty"`
+
+	// Schema represents the JSON schema of the body of the resource.
+	Schema json.RawMessage `json:"schema,omitempty"`
+}
+
+// DeviceInfo represents the DeviceInfo resource, which describes a device with the same IDs as its Discovery messages
+// and human-readable names.
+type DeviceInfo struct {
+	// ManufacturerID represents the three manufacturer ID bytes of the device.
+	ManufacturerID [3]int `json:"manufacturerId"`
+
+	// FamilyID represents the two device family bytes, least significant byte first.
+	FamilyID [2]int `json:"familyId"`
+
+	// ModelID represents the two device model bytes, least significant byte first.
+	ModelID [2]int `json:"modelId"`
+
+	// VersionID represents the four software revision level bytes of the device.
+	VersionID [4]int `json:"versionId"`
+
+	// Manufacturer represents the name of the manufacturer of the device.
+	Manufacturer string `json:"manufacturer"`
+
+	// Family represents the name of the device family.
+	Family string `json:"family"`
+
+	// Model represents the name of the device model.
+	Model string `json:"model"`
+
+	// Version represents the software version of the device.
+	Version string `json:"version"`
+
+	// SerialNumber represents the serial number of the device.
+	SerialNumber string `json:"serialNumber,omitempty"`
+}
+
+// NewDeviceInfo returns a DeviceInfo with the IDs of the Discovery identity and no names.
+func NewDeviceInfo(identity Identity) DeviceInfo {
+	var info DeviceInfo
+	info.SetIdentity(identity)
+	return info
+}
+
+// Identity returns the Discovery identity of the device that the DeviceInfo describes.
+func (di DeviceInfo) Identity() Identity {
+	b := make([]byte, 0, 11)
+	for _, ids := range [][]int{di.ManufacturerID[:], di.FamilyID[:], di.ModelID[:], di.VersionID[:]} {
+		for _, id := range ids {
+			b = append(b, byte(id))
+		}
+	}
+	return parseIdentity(b)
+}
+
+// SetIdentity sets the IDs of the DeviceInfo to those of the Discovery identity.
+func (di *DeviceInfo) SetIdentity(identity Identity) {
+	for i, id := range identity.Manufacturer {
+		di.ManufacturerID[i] = int(id)
+	}
+	for i, id := range appendSevenBitValue(nil, uint32(identity.Family), 2) {
+		di.FamilyID[i] = int(id)
+	}
+	for i, id := range appendSevenBitValue(nil, uint32(identity.Model), 2) {
+		di.ModelID[i] = int(id)
+	}
+	for i, id := range identity.Version {
+		di.VersionID[i] = int(id)
+	}
+}
+
+// MarshalPropertyData marshals the value into JSON that can be sent in Property Exchange messages. Every character
+// outside of 7-bit ASCII is escaped as \uXXXX, since System Exclusive data bytes cannot carry 8-bit values.
+func MarshalPropertyData(v interface{}) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("property data could not be marshalled (%v): %w", err, ErrMarshallingMessage)
+	}
+	return escapeJSON(b), nil
+}
+
+// escapeJSON returns the JSON with every character outside of 7-bit ASCII escaped as \uXXXX. Those characters can only
+// appear inside JSON strings, where the escapes are equivalent.
+func escapeJSON(b []byte) []byte {
+	escaped := make([]byte, 0, len(b))
+	for _, r := range string(b) {
+		switch {
+		case r < 0x80:
+			escaped = append(escaped, byte(r))
+		case r > 0xFFFF:
+			r1, r2 := utf16.EncodeRune(r)
+			escaped = append(escaped, fmt.Sprintf(`\u%04x\u%04x`, r1, r2)...)
+		default:
+			escaped = append(escaped, fmt.Sprintf(`\u%04x`, r)...)
+		}
+	}
+	return escaped
+}
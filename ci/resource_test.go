@@ -0,0 +1,89 @@
+package ci
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+func Test_MarshalPropertyData(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		v        interface{}
+		expected string
+		err      error
+	}{
+		"value cannot be marshalled": {
+			v:   make(chan int),
+			err: ErrMarshallingMessage,
+		},
+		"ASCII is unchanged": {
+			v:        RequestHeader{Resource: DeviceInfoResource},
+			expected: `{"resource":"DeviceInfo"}`,
+		},
+		"non-ASCII characters are escaped": {
+			v:        ReplyHeader{Status: StatusOK, Message: "Café 🎹"},
+			expected: `{"status":200,"message":"Caf\u00e9 \ud83c\udfb9"}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := MarshalPropertyData(test.v)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if test.expected != string(got) {
+				t.Fatalf("expected %s, got %s", test.expected, got)
+			}
+			if test.err == nil {
+				if err := json.Unmarshal(got, new(interface{})); err != nil {
+					t.Fatalf("expected valid JSON, got %v", err)
+				}
+			}
+		})
+	}
+}
+
+func Test_DeviceInfo_Identity(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		identity Identity
+		expected string
+	}{
+		"one-byte manufacturer ID": {
+			identity: Identity{Manufacturer: midiv1.RolandManufacturerID, Family: 0x012B, Model: 2, Version: [4]byte{0, 1, 0, 0}},
+			expected: `{"manufacturerId":[65,0,0],"familyId":[43,2],"modelId":[2,0],"versionId":[0,1,0,0],"manufacturer":"","family":"","model":"","version":""}`,
+		},
+		"three-byte manufacturer ID": {
+			identity: Identity{Manufacturer: midiv1.NovationManufacturerID, Family: 0x3FFF, Model: 0, Version: [4]byte{1, 2, 3, 4}},
+			expected: `{"manufacturerId":[0,32,41],"familyId":[127,127],"modelId":[0,0],"versionId":[1,2,3,4],"manufacturer":"","family":"","model":"","version":""}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			info := NewDeviceInfo(test.identity)
+			b, err := MarshalPropertyData(info)
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.expected != string(b) {
+				t.Fatalf("expected %s, got %s", test.expected, b)
+			}
+			var got DeviceInfo
+			if err := json.Unmarshal(b, &got); err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if !reflect.DeepEqual(test.identity, got.Identity()) {
+				t.Fatalf("expected %#v, got %#v", test.identity, got.Identity())
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// Package clock sends and follows MIDI beat clock, the 24 pulses per quarter note Timing Clock messages that keep drum
+// machines and sequencers in time with each other.
+//
+// A Generator sends Timing Clock messages at a configurable tempo along with Start, Stop and Continue messages, while a
+// Follower reads them from another device. Both measure time through a Source, so tests can supply their own instead
+// of waiting on the system clock.
+package clock
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrInvalidBPM represents a tempo outside of the supported range.
+	ErrInvalidBPM error = errors.New("invalid clock BPM")
+
+	// ErrInvalidSwing represents a swing amount outside of the supported range.
+	ErrInvalidSwing error = errors.New("invalid clock swing")
+)
+
+const (
+	// PulsesPerQuarterNote represents the number of Timing Clock messages in a quarter note.
+	PulsesPerQuarterNote int = 24
+
+	// MinBPM represents the slowest supported tempo in beats (quarter notes) per minute.
+	MinBPM float64 = 1
+
+	// MaxBPM represents the fastest supported tempo in beats (quarter notes) per minute.
+	MaxBPM float64 = 999
+
+	// DefaultBPM represents the tempo used when none is supplied.
+	DefaultBPM float64 = 120
+)
+
+// Source provides the passage of time. SystemSource uses the system clock, while tests can supply their own source to
+// run without real time.
+type Source interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// After returns a channel that receives the current time once the duration has passed.
+	After(d time.Duration) <-chan time.Time
+}
+
+// SystemSource is a Source that uses the system clock.
+type SystemSource struct{}
+
+// Now returns the current time of the system clock.
+func (SystemSource) Now() time.Time {
+	return time.Now()
+}
+
+// After returns a channel that receives the current time of the system clock once the duration has passed.
+func (SystemSource) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// pulseDuration returns the time between two Timing Clock messages at the supplied tempo.
+func pulseDuration(bpm float64) float64 {
+	return float64(time.Minute) / (bpm * float64(PulsesPerQuarterNote))
+}
+
+// validateBPM returns an error if the tempo is outside of the supported range.
+func validateBPM(bpm float64) error {
+	if !(bpm >= MinBPM && bpm <= MaxBPM) {
+		return fmt.Errorf("tempo must be between %v and %v BPM, inclusive, received %v: %w", MinBPM, MaxBPM, bpm, ErrInvalidBPM)
+	}
+	return nil
+}
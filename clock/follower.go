@@ -0,0 +1,312 @@
+package clock
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+const (
+	// DefaultFollowerWindow represents the number of pulse intervals averaged by default to estimate the tempo, which
+	// is a single quarter note.
+	DefaultFollowerWindow int = 24
+
+	// DefaultTempoChangeThreshold represents the smallest change in estimated BPM that raises a TempoChanged event by default.
+	DefaultTempoChangeThreshold float64 = 0.5
+
+	// PositionStringFormat represents the printf-compatible format for the string representation of a position.
+	PositionStringFormat string = "%d:%02d"
+)
+
+// TransportState represents whether the sender of the clock is playing.
+type TransportState int
+
+const (
+	// Stopped represents a transport that is not playing, which is the state before any Start message arrives.
+	Stopped TransportState = iota
+
+	// Playing represents a transport that is playing after a Start or Continue message.
+	Playing
+)
+
+// String returns the human-readable representation of the transport state.
+func (ts TransportState) String() string {
+	if ts == Playing {
+		return "Playing"
+	}
+	return "Stopped"
+}
+
+// Position represents a song position as a number of beats (quarter notes) and the pulse within the beat.
+type Position struct {
+	// Beat represents the number of whole beats since the start of the song, starting at 0.
+	Beat uint64
+
+	// Tick represents the pulse within the beat, between 0 and 23 inclusive.
+	Tick int
+}
+
+// NewPositionFromPulses returns the Position of the supplied number of pulses since the start of the song.
+func NewPositionFromPulses(pulses uint64) Position {
+	return Position{
+		Beat: pulses / uint64(PulsesPerQuarterNote),
+		Tick: int(pulses % uint64(PulsesPerQuarterNote)),
+	}
+}
+
+// Pulses returns the number of pulses between the start of the song and the position.
+func (p Position) Pulses() uint64 {
+	return p.Beat*uint64(PulsesPerQuarterNote) + uint64(p.Tick)
+}
+
+// String returns the human-readable representation of the position.
+func (p Position) String() string {
+	return fmt.Sprintf(PositionStringFormat, p.Beat, p.Tick)
+}
+
+// EventType represents the kind of change reported by a Follower.
+type EventType int
+
+const (
+	// TempoChangedEvent reports that the estimated tempo moved by at least the tempo change threshold.
+	TempoChangedEvent EventType = iota
+
+	// StartedEvent reports a Start message, which plays from the start of the song.
+	StartedEvent
+
+	// ContinuedEvent reports a Continue message, which plays from the current song position.
+	ContinuedEvent
+
+	// StoppedEvent reports a Stop message.
+	StoppedEvent
+
+	// PositionChangedEvent reports a Song Position Pointer message.
+	PositionChangedEvent
+)
+
+// String returns the human-readable representation of the event type.
+func (et EventType) String() string {
+	switch et {
+	case TempoChangedEvent:
+		return "Tempo Changed"
+	case StartedEvent:
+		return "Started"
+	case ContinuedEvent:
+		return "Continued"
+	case StoppedEvent:
+		return "Stopped"
+	case PositionChangedEvent:
+		return "Position Changed"
+	}
+	return fmt.Sprintf("EventType(%d)", int(et))
+}
+
+// Event represents a change reported by a Follower.
+type Event struct {
+	// Type represents the kind of change.
+	Type EventType
+
+	// Time represents the time at which the message that caused the change arrived.
+	Time time.Time
+
+	// BPM represents the estimated tempo after the change, or 0 if there is no estimate yet.
+	BPM float64
+
+	// State represents the transport state after the change.
+	State TransportState
+
+	// Position represents the song position after the change.
+	Position Position
+}
+
+// Follower follows the clock of another device from its Timing Clock, Start, Stop, Continue and Song Position Pointer
+// messages, keeping track of its tempo, transport state and song position.
+//
+// The tempo is estimated from the time taken by the last window of pulses rather than from single pulse intervals, so
+// jitter in the arrival of individual Timing Clock messages cancels out. A gap much longer than the current pulse
+// interval, such as a clock that was paused and restarted, starts a new estimate. The methods of a Follower are safe for
+// concurrent use.
+type Follower struct {
+	// mutex guards the state of the follower
+	mutex sync.Mutex
+
+	// now returns the current time and exists so tests can control the arrival time of messages
+	now func() time.Time
+
+	// handler receives every event raised by the follower, or is nil to raise no events
+	handler func(Event)
+
+	// window is the number of pulse intervals averaged to estimate the tempo
+	window int
+
+	// threshold is the smallest change in estimated BPM that raises a TempoChanged event
+	threshold float64
+
+	// arrivals holds the arrival times of the most recent pulses, oldest first, up to window + 1 of them
+	arrivals []time.Time
+
+	// bpm is the current tempo estimate, or 0 when there is no estimate yet
+	bpm float64
+
+	// reportedBPM is the tempo of the last TempoChanged event
+	reportedBPM float64
+
+	// state is the current transport state
+	state TransportState
+
+	// pulses is the current song position in pulses
+	pulses uint64
+}
+
+// FollowerOption configures a Follower.
+type FollowerOption func(*Follower)
+
+// WithEventHandler sets the function that receives the events raised by the follower. The handler is called on the
+// goroutine that handed over the message, after the follower has been updated.
+func WithEventHandler(handler func(Event)) FollowerOption {
+	return func(f *Follower) {
+		f.handler = handler
+	}
+}
+
+// WithFollowerWindow sets the number of pulse intervals averaged to estimate the tempo. Larger windows smooth out more
+// jitter but follow tempo changes more slowly. Windows of less than 1 use DefaultFollowerWindow.
+func WithFollowerWindow(window int) FollowerOption {
+	return func(f *Follower) {
+		f.window = window
+	}
+}
+
+// WithTempoChangeThreshold sets the smallest change in estimated BPM that raises a TempoChanged event.
+func WithTempoChangeThreshold(threshold float64) FollowerOption {
+	return func(f *Follower) {
+		f.threshold = threshold
+	}
+}
+
+// NewFollower returns a new Follower configured by any supplied options. The transport starts out stopped at the start
+// of the song.
+func NewFollower(options ...FollowerOption) *Follower {
+	f := &Follower{
+		now:       time.Now,
+		window:    DefaultFollowerWindow,
+		threshold: DefaultTempoChangeThreshold,
+	}
+	for _, option := range options {
+		option(f)
+	}
+	if f.window < 1 {
+		f.window = DefaultFollowerWindow
+	}
+	return f
+}
+
+// Handle updates the follower from a message that has just arrived. Messages that do not affect the clock are ignored.
+func (f *Follower) Handle(message midiv1.Message) {
+	f.HandleAt(message, f.now())
+}
+
+// HandleAt updates the follower from a message that arrived at the supplied time, such as a timestamp from a MIDI
+// driver. Messages that do not affect the clock are ignored.
+func (f *Follower) HandleAt(message midiv1.Message, t time.Time) {
+	f.mutex.Lock()
+	var events []Event
+	switch m := message.(type) {
+	case *midiv1.TimingClockMessage:
+		if f.state == Playing {
+			f.pulses++
+		}
+		if f.pulse(t) {
+			events = append(events, f.event(TempoChangedEvent, t))
+		}
+	case *midiv1.StartMessage:
+		f.state = Playing
+		f.pulses = 0
+		events = append(events, f.event(StartedEvent, t))
+	case *midiv1.ContinueMessage:
+		f.state = Playing
+		events = append(events, f.event(ContinuedEvent, t))
+	case *midiv1.StopMessage:
+		f.state = Stopped
+		events = append(events, f.event(StoppedEvent, t))
+	case *midiv1.SongPositionPointerMessage:
+		f.pulses = uint64(m.Clocks())
+		events = append(events, f.event(PositionChangedEvent, t))
+	}
+	handler := f.handler
+	f.mutex.Unlock()
+
+	if handler == nil {
+		return
+	}
+	for _, event := range events {
+		handler(event)
+	}
+}
+
+// BPM returns the estimated tempo, or 0 if not enough Timing Clock messages have arrived yet.
+func (f *Follower) BPM() float64 {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.bpm
+}
+
+// State returns the current transport state.
+func (f *Follower) State() TransportState {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.state
+}
+
+// Position returns the current song position.
+func (f *Follower) Position() Position {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return NewPositionFromPulses(f.pulses)
+}
+
+// pulse records the arrival of a Timing Clock message and returns whether the tempo estimate changed enough to report.
+func (f *Follower) pulse(t time.Time) bool {
+	if n := len(f.arrivals); n > 0 {
+		interval := t.Sub(f.arrivals[n-1])
+		if interval <= 0 || (f.bpm > 0 && float64(interval) > 4*pulseDuration(f.bpm)) || float64(interval) > pulseDuration(MinBPM) {
+			// the clock paused or the messages arrived out of order, so the old arrivals no longer describe the tempo
+			f.arrivals = f.arrivals[:0]
+		}
+	}
+	if len(f.arrivals) == f.window+1 {
+		f.arrivals = append(f.arrivals[:0], f.arrivals[1:]...)
+	}
+	f.arrivals = append(f.arrivals, t)
+
+	intervals := len(f.arrivals) - 1
+	if intervals == 0 {
+		return false
+	}
+	span := f.arrivals[intervals].Sub(f.arrivals[0])
+	f.bpm = float64(time.Minute) * float64(intervals) / (float64(span) * float64(PulsesPerQuarterNote))
+
+	// wait for a full window before reporting the first estimate, so it is not thrown off by a single late pulse
+	if f.reportedBPM == 0 && intervals < f.window {
+		return false
+	}
+	if math.Abs(f.bpm-f.reportedBPM) < f.threshold {
+		return false
+	}
+	f.reportedBPM = f.bpm
+	return true
+}
+
+// event returns an Event of the supplied type that describes the current state of the follower.
+func (f *Follower) event(eventType EventType, t time.Time) Event {
+	return Event{
+		Type:     eventType,
+		Time:     t,
+		BPM:      f.bpm,
+		State:    f.state,
+		Position: NewPositionFromPulses(f.pulses),
+	}
+}
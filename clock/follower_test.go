@@ -0,0 +1,141 @@
+package clock
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+// sendPulses hands count Timing Clock messages to the follower at the supplied tempo, starting at start, with every
+// other pulse arriving late by jitter. It returns the time of the next pulse.
+func sendPulses(f *Follower, start time.Time, bpm float64, count int, jitter time.Duration) time.Time {
+	interval := time.Duration(pulseDuration(bpm))
+	t := start
+	for i := 0; i < count; i++ {
+		arrival := t
+		if i%2 == 1 {
+			arrival = arrival.Add(jitter)
+		}
+		f.HandleAt(&midiv1.TimingClockMessage{}, arrival)
+		t = t.Add(interval)
+	}
+	return t
+}
+
+func Test_NewPositionFromPulses(t *testing.T) {
+	t.Parallel()
+	got := NewPositionFromPulses(53)
+	expected := Position{Beat: 2, Tick: 5}
+	if got != expected {
+		t.Fatalf("expected %+v, got %+v", expected, got)
+	}
+	if got.Pulses() != 53 {
+		t.Fatalf("expected 53 pulses, got %d", got.Pulses())
+	}
+	if got.String() != "2:05" {
+		t.Fatalf("expected 2:05, got %s", got.String())
+	}
+}
+
+func Test_Follower_BPM(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		bpm    float64
+		jitter time.Duration
+	}{
+		"steady clock": {
+			bpm: 120,
+		},
+		"jittery clock": {
+			bpm:    98,
+			jitter: 3 * time.Millisecond,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := NewFollower()
+			sendPulses(f, time.Time{}, test.bpm, 97, test.jitter)
+			if math.Abs(f.BPM()-test.bpm) > 0.1 {
+				t.Fatalf("expected about %v BPM, got %v", test.bpm, f.BPM())
+			}
+		})
+	}
+}
+
+func Test_Follower_TempoChangedEvent(t *testing.T) {
+	t.Parallel()
+	var events []Event
+	f := NewFollower(WithEventHandler(func(event Event) {
+		events = append(events, event)
+	}))
+
+	next := sendPulses(f, time.Time{}, 120, 48, 2*time.Millisecond)
+	if len(events) != 1 || events[0].Type != TempoChangedEvent || math.Abs(events[0].BPM-120) > 0.5 {
+		t.Fatalf("expected a single tempo change to about 120 BPM, got %+v", events)
+	}
+
+	sendPulses(f, next, 140, 48, 0)
+	last := events[len(events)-1]
+	if last.Type != TempoChangedEvent || math.Abs(last.BPM-140) > 0.5 {
+		t.Fatalf("expected a tempo change to about 140 BPM, got %+v", last)
+	}
+}
+
+func Test_Follower_GapStartsNewEstimate(t *testing.T) {
+	t.Parallel()
+	f := NewFollower()
+	next := sendPulses(f, time.Time{}, 120, 25, 0)
+	sendPulses(f, next.Add(10*time.Second), 60, 25, 0)
+	if math.Abs(f.BPM()-60) > 0.01 {
+		t.Fatalf("expected 60 BPM after the gap, got %v", f.BPM())
+	}
+}
+
+func Test_Follower_Transport(t *testing.T) {
+	t.Parallel()
+	var events []EventType
+	f := NewFollower(WithEventHandler(func(event Event) {
+		if event.Type != TempoChangedEvent {
+			events = append(events, event.Type)
+		}
+	}))
+
+	// clocks while stopped track the tempo without moving the song position
+	next := sendPulses(f, time.Time{}, 120, 10, 0)
+	if f.Position() != (Position{}) {
+		t.Fatalf("expected the start of the song, got %s", f.Position())
+	}
+
+	f.HandleAt(&midiv1.StartMessage{}, next)
+	if f.State() != Playing {
+		t.Fatalf("expected %s, got %s", Playing, f.State())
+	}
+	next = sendPulses(f, next, 120, 30, 0)
+	if expected := (Position{Beat: 1, Tick: 6}); f.Position() != expected {
+		t.Fatalf("expected %s, got %s", expected, f.Position())
+	}
+
+	f.HandleAt(&midiv1.StopMessage{}, next)
+	f.HandleAt(&midiv1.SongPositionPointerMessage{Beats: 8}, next)
+	if expected := (Position{Beat: 2}); f.Position() != expected {
+		t.Fatalf("expected %s, got %s", expected, f.Position())
+	}
+	next = sendPulses(f, next, 120, 5, 0)
+	f.HandleAt(&midiv1.ContinueMessage{}, next)
+	sendPulses(f, next, 120, 3, 0)
+	if expected := (Position{Beat: 2, Tick: 3}); f.Position() != expected {
+		t.Fatalf("expected %s, got %s", expected, f.Position())
+	}
+
+	// other messages are ignored
+	f.HandleAt(&midiv1.NoteOnMessage{}, next)
+
+	expected := []EventType{StartedEvent, StoppedEvent, PositionChangedEvent, ContinuedEvent}
+	if !reflect.DeepEqual(expected, events) {
+		t.Fatalf("expected %v, got %v", expected, events)
+	}
+}
@@ -0,0 +1,420 @@
+package clock
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+const (
+	// StraightSwing represents a swing amount that leaves every pulse evenly spaced.
+	StraightSwing float64 = 0.5
+
+	// MaxSwing represents the largest supported swing amount, where the first sixteenth note of each pair takes three
+	// quarters of the time of the pair.
+	MaxSwing float64 = 0.75
+
+	// SwingStepPulses represents the number of pulses in each swung step (a sixteenth note).
+	SwingStepPulses int = 6
+)
+
+// Output receives each message sent by a Generator.
+type Output func(message midiv1.Message) error
+
+// WriterOutput returns an Output that marshals each message and writes it to w.
+func WriterOutput(w io.Writer) Output {
+	return func(message midiv1.Message) error {
+		marshaler, ok := message.(midiv1.MessageMarshaler)
+		if !ok {
+			return fmt.Errorf("%s messages cannot be marshalled: %w", message.GetMessageName(), midiv1.ErrMarshallingMessage)
+		}
+		b, err := marshaler.MarshalMIDI()
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(b)
+		return err
+	}
+}
+
+// ChannelOutput returns an Output that sends each message on ch. The Generator waits for each send, so a slow receiver
+// delays the clock.
+func ChannelOutput(ch chan<- midiv1.Message) Output {
+	return func(message midiv1.Message) error {
+		ch <- message
+		return nil
+	}
+}
+
+// Generator sends Timing Clock messages at 24 pulses per quarter note, along with Start, Stop and Continue messages.
+//
+// Every pulse is scheduled at an absolute deadline measured from the last tempo change rather than by sleeping between
+// pulses, so late wake-ups never add up to drift. The tempo can be changed while running, either at once or with a
+// linear ramp, and sixteenth notes can be swung. The methods of a Generator are safe for concurrent use.
+type Generator struct {
+	// mutex guards the state of the generator
+	mutex sync.Mutex
+
+	// outputMutex keeps messages in order when they are sent from several goroutines
+	outputMutex sync.Mutex
+
+	// output receives every message sent by the generator
+	output Output
+
+	// source provides the passage of time
+	source Source
+
+	// clockWhileStopped represents whether Timing Clock messages are sent while the transport is stopped
+	clockWhileStopped bool
+
+	// swing is the fraction of each pair of sixteenth notes taken by the first one
+	swing float64
+
+	// running represents whether the transport is playing
+	running bool
+
+	// position is the number of pulses sent since the song position was last reset
+	position uint64
+
+	// pulse is the index of the next pulse, which sets its place within the swing pattern
+	pulse uint64
+
+	// anchorTime is the deadline of the anchor pulse
+	anchorTime time.Time
+
+	// anchorPulse is the index of the pulse that all later deadlines are measured from
+	anchorPulse uint64
+
+	// anchorBPM is the tempo from the anchor pulse onwards, unless a ramp is in progress
+	anchorBPM float64
+
+	// lastTime is the deadline of the last pulse sent, if the pulse index has moved past the anchor pulse
+	lastTime time.Time
+
+	// rampFrom is the tempo at the start of the current ramp
+	rampFrom float64
+
+	// rampTo is the tempo at the end of the current ramp
+	rampTo float64
+
+	// rampStart is the time at which the current ramp started
+	rampStart time.Time
+
+	// rampDuration is the length of the current ramp, or 0 when no ramp is in progress
+	rampDuration time.Duration
+
+	// wake interrupts the wait for the next deadline when the schedule changes
+	wake chan struct{}
+}
+
+// GeneratorOption configures a Generator.
+type GeneratorOption func(*Generator)
+
+// WithBPM sets the initial tempo of the generator. The default is DefaultBPM.
+func WithBPM(bpm float64) GeneratorOption {
+	return func(g *Generator) {
+		g.anchorBPM = bpm
+	}
+}
+
+// WithSwing sets the initial swing amount of the generator. The default is StraightSwing.
+func WithSwing(swing float64) GeneratorOption {
+	return func(g *Generator) {
+		g.swing = swing
+	}
+}
+
+// WithSource sets the source of time for the generator. The default is SystemSource.
+func WithSource(source Source) GeneratorOption {
+	return func(g *Generator) {
+		g.source = source
+	}
+}
+
+// WithClockWhileStopped sets whether Timing Clock messages are sent while the transport is stopped, so receivers can
+// follow the tempo before playback starts. The MIDI 1.0 specification recommends this, so it is enabled by default.
+func WithClockWhileStopped(enabled bool) GeneratorOption {
+	return func(g *Generator) {
+		g.clockWhileStopped = enabled
+	}
+}
+
+// NewGenerator returns a new Generator that sends its messages to output, configured by any supplied options. The
+// transport starts out stopped.
+func NewGenerator(output Output, options ...GeneratorOption) (*Generator, error) {
+	g := &Generator{
+		output:            output,
+		source:            SystemSource{},
+		clockWhileStopped: true,
+		swing:             StraightSwing,
+		anchorBPM:         DefaultBPM,
+		wake:              make(chan struct{}, 1),
+	}
+	for _, option := range options {
+		option(g)
+	}
+	if err := validateBPM(g.anchorBPM); err != nil {
+		return nil, err
+	}
+	if err := validateSwing(g.swing); err != nil {
+		return nil, err
+	}
+	g.anchorTime = g.source.Now()
+	return g, nil
+}
+
+// Run sends Timing Clock messages until the context is done or the output returns an error. The first pulse is sent as
+// soon as Run is called. When Run falls behind by more than a pulse, such as after the process was suspended, the missed
+// pulses are dropped and the schedule carries on from the late pulse rather than sending them in a burst.
+func (g *Generator) Run(ctx context.Context) error {
+	g.mutex.Lock()
+	g.anchor(g.source.Now(), g.pulse, g.bpmAt(g.source.Now()))
+	g.mutex.Unlock()
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		// any change made before this point is already part of the next deadline
+		select {
+		case <-g.wake:
+		default:
+		}
+
+		g.mutex.Lock()
+		deadline := g.deadline(g.pulse)
+		g.mutex.Unlock()
+
+		if wait := deadline.Sub(g.source.Now()); wait > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-g.wake:
+				continue
+			case <-g.source.After(wait):
+			}
+		}
+
+		if err := g.tick(); err != nil {
+			return err
+		}
+	}
+}
+
+// Start sends a Start message and resets the song position, so the next pulse is the first pulse of the song.
+func (g *Generator) Start() error {
+	return g.transport(&midiv1.StartMessage{}, true)
+}
+
+// Stop sends a Stop message and keeps the song position where it is.
+func (g *Generator) Stop() error {
+	return g.transport(&midiv1.StopMessage{}, false)
+}
+
+// Continue sends a Continue message, so playback resumes from the current song position with the next pulse.
+func (g *Generator) Continue() error {
+	return g.transport(&midiv1.ContinueMessage{}, true)
+}
+
+// SetBPM changes the tempo of the generator. A ramp of 0 or less changes the tempo at once, while a longer ramp moves
+// the tempo linearly from its current value to the new one over the ramp.
+func (g *Generator) SetBPM(bpm float64, ramp time.Duration) error {
+	if err := validateBPM(bpm); err != nil {
+		return err
+	}
+
+	g.mutex.Lock()
+	now := g.source.Now()
+	current := g.bpmAt(now)
+	g.reanchor(current)
+	if ramp > 0 {
+		g.rampFrom = current
+		g.rampTo = bpm
+		g.rampStart = now
+		g.rampDuration = ramp
+	} else {
+		g.rampDuration = 0
+		g.anchorBPM = bpm
+	}
+	g.mutex.Unlock()
+
+	g.signal()
+	return nil
+}
+
+// SetSwing changes the swing amount of the generator, from StraightSwing up to MaxSwing. The swing amount is the
+// fraction of each pair of sixteenth notes taken by the first one, so 2/3 gives a triplet feel.
+func (g *Generator) SetSwing(swing float64) error {
+	if err := validateSwing(swing); err != nil {
+		return err
+	}
+
+	g.mutex.Lock()
+	g.reanchor(g.bpmAt(g.source.Now()))
+	g.swing = swing
+	g.mutex.Unlock()
+
+	g.signal()
+	return nil
+}
+
+// BPM returns the current tempo of the generator, including the progress of any ramp.
+func (g *Generator) BPM() float64 {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	return g.bpmAt(g.source.Now())
+}
+
+// Swing returns the current swing amount of the generator.
+func (g *Generator) Swing() float64 {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	return g.swing
+}
+
+// Position returns the number of pulses sent while playing since the last Start message.
+func (g *Generator) Position() uint64 {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	return g.position
+}
+
+// IsRunning returns whether the transport is playing.
+func (g *Generator) IsRunning() bool {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	return g.running
+}
+
+// transport sends a Start, Stop or Continue message and lines up the next pulse with it.
+func (g *Generator) transport(message midiv1.Message, running bool) error {
+	g.outputMutex.Lock()
+	defer g.outputMutex.Unlock()
+
+	g.mutex.Lock()
+	now := g.source.Now()
+	if _, ok := message.(*midiv1.StartMessage); ok {
+		g.position = 0
+	}
+	if running {
+		// the pulse after a Start or Continue message lands at the current song position within the swing pattern
+		g.pulse = g.position
+		g.anchor(now, g.pulse, g.bpmAt(now))
+	}
+	g.running = running
+	g.mutex.Unlock()
+
+	g.signal()
+	return g.output(message)
+}
+
+// tick sends the next pulse if its deadline has passed and moves on to the one after it. The deadline is worked out
+// again under the lock, so a change made while Run was waiting applies to this pulse too.
+func (g *Generator) tick() error {
+	g.outputMutex.Lock()
+	defer g.outputMutex.Unlock()
+
+	g.mutex.Lock()
+	now := g.source.Now()
+	deadline := g.deadline(g.pulse)
+	if deadline.After(now) {
+		// the schedule moved the pulse later while Run was waiting
+		g.mutex.Unlock()
+		return nil
+	}
+	if !g.deadline(g.pulse + 1).After(now) {
+		// more than one pulse was missed, so the schedule starts again from this one instead of catching up
+		g.anchor(now, g.pulse, g.bpmAt(now))
+		deadline = now
+	}
+	send := g.running || g.clockWhileStopped
+	if g.running {
+		g.position++
+	}
+	g.pulse++
+	g.lastTime = deadline
+	if g.rampDuration > 0 {
+		// tempo ramps are followed one pulse at a time
+		g.anchor(deadline, g.pulse-1, g.bpmAt(deadline))
+		if deadline.Sub(g.rampStart) >= g.rampDuration {
+			g.rampDuration = 0
+		}
+	}
+	g.mutex.Unlock()
+
+	if !send {
+		return nil
+	}
+	return g.output(&midiv1.TimingClockMessage{})
+}
+
+// anchor measures every later deadline from the supplied pulse and time at the supplied tempo.
+func (g *Generator) anchor(t time.Time, pulse uint64, bpm float64) {
+	g.anchorTime = t
+	g.anchorPulse = pulse
+	g.anchorBPM = bpm
+}
+
+// reanchor moves the anchor to the last pulse sent, so that a change only affects the pulses that follow it.
+func (g *Generator) reanchor(bpm float64) {
+	if g.pulse > g.anchorPulse {
+		g.anchor(g.lastTime, g.pulse-1, bpm)
+		return
+	}
+	g.anchorBPM = bpm
+}
+
+// deadline returns the time at which the supplied pulse should be sent.
+func (g *Generator) deadline(pulse uint64) time.Time {
+	pulses := g.swungPulses(pulse) - g.swungPulses(g.anchorPulse)
+	return g.anchorTime.Add(time.Duration(math.Round(pulses * pulseDuration(g.anchorBPM))))
+}
+
+// swungPulses returns the position of the supplied pulse in straight pulses once the swing is applied.
+func (g *Generator) swungPulses(pulse uint64) float64 {
+	pair := uint64(2 * SwingStepPulses)
+	offset := float64(pulse % pair)
+	step := float64(SwingStepPulses)
+	swung := offset * 2 * g.swing
+	if offset > step {
+		swung = step*2*g.swing + (offset-step)*2*(1-g.swing)
+	}
+	return float64(pulse-pulse%pair) + swung
+}
+
+// bpmAt returns the tempo at the supplied time.
+func (g *Generator) bpmAt(t time.Time) float64 {
+	if g.rampDuration <= 0 {
+		return g.anchorBPM
+	}
+	elapsed := t.Sub(g.rampStart)
+	if elapsed >= g.rampDuration {
+		return g.rampTo
+	}
+	if elapsed <= 0 {
+		return g.rampFrom
+	}
+	return g.rampFrom + (g.rampTo-g.rampFrom)*float64(elapsed)/float64(g.rampDuration)
+}
+
+// signal interrupts the wait for the next deadline so it can be worked out again.
+func (g *Generator) signal() {
+	select {
+	case g.wake <- struct{}{}:
+	default:
+	}
+}
+
+// validateSwing returns an error if the swing amount is outside of the supported range.
+func validateSwing(swing float64) error {
+	if !(swing >= StraightSwing && swing <= MaxSwing) {
+		return fmt.Errorf("swing must be between %v and %v, inclusive, received %v: %w", StraightSwing, MaxSwing, swing, ErrInvalidSwing)
+	}
+	return nil
+}
@@ -0,0 +1,337 @@
+package clock
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+// instantSource is a Source that jumps straight to the end of every wait instead of sleeping.
+type instantSource struct {
+	mutex sync.Mutex
+	now   time.Time
+
+	// waited is called, if set, at the end of every wait before the wait is over
+	waited func(now time.Time)
+}
+
+func (s *instantSource) Now() time.Time {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.now
+}
+
+func (s *instantSource) After(d time.Duration) <-chan time.Time {
+	s.mutex.Lock()
+	s.now = s.now.Add(d)
+	now, waited := s.now, s.waited
+	s.mutex.Unlock()
+	if waited != nil {
+		waited(now)
+	}
+	ch := make(chan time.Time, 1)
+	ch <- now
+	return ch
+}
+
+// sentMessage records a message sent by a Generator along with the time it was sent.
+type sentMessage struct {
+	offset  time.Duration
+	message midiv1.Message
+}
+
+// runGenerator runs the generator until count messages have been recorded, calling hook after each one.
+func runGenerator(t *testing.T, g *Generator, source *instantSource, recorded *[]sentMessage, count int, hook func(n int)) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	g.output = func(message midiv1.Message) error {
+		*recorded = append(*recorded, sentMessage{offset: source.Now().Sub(time.Time{}), message: message})
+		if hook != nil {
+			hook(len(*recorded))
+		}
+		if len(*recorded) >= count {
+			cancel()
+		}
+		return nil
+	}
+	if err := g.Run(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected %v error, got %v", context.Canceled, err)
+	}
+}
+
+func Test_NewGenerator(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		options []GeneratorOption
+		err     error
+	}{
+		"tempo is too slow": {
+			options: []GeneratorOption{WithBPM(0)},
+			err:     ErrInvalidBPM,
+		},
+		"tempo is too fast": {
+			options: []GeneratorOption{WithBPM(1000)},
+			err:     ErrInvalidBPM,
+		},
+		"swing is below straight": {
+			options: []GeneratorOption{WithSwing(0.4)},
+			err:     ErrInvalidSwing,
+		},
+		"options are valid": {
+			options: []GeneratorOption{WithBPM(90), WithSwing(0.6)},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewGenerator(ChannelOutput(make(chan midiv1.Message)), test.options...)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func Test_Generator_Run(t *testing.T) {
+	t.Parallel()
+	source := &instantSource{}
+	g, err := NewGenerator(nil, WithBPM(125), WithSource(source))
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	var recorded []sentMessage
+	runGenerator(t, g, source, &recorded, 49, nil)
+
+	// at 125 BPM each pulse lasts exactly 20ms, so the deadlines never drift
+	for i, sent := range recorded {
+		expected := time.Duration(i) * 20 * time.Millisecond
+		if sent.offset != expected {
+			t.Fatalf("expected pulse %d at %v, got %v", i, expected, sent.offset)
+		}
+		if _, ok := sent.message.(*midiv1.TimingClockMessage); !ok {
+			t.Fatalf("expected timing clock message, got %+v", sent.message)
+		}
+	}
+}
+
+func Test_Generator_Transport(t *testing.T) {
+	t.Parallel()
+	source := &instantSource{}
+	g, err := NewGenerator(nil, WithBPM(125), WithSource(source), WithClockWhileStopped(false))
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	var recorded []sentMessage
+	g.output = func(message midiv1.Message) error {
+		recorded = append(recorded, sentMessage{message: message})
+		return nil
+	}
+	if err := g.Start(); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	runGenerator(t, g, source, &recorded, 4, nil)
+	if err := g.Stop(); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if g.IsRunning() {
+		t.Fatalf("expected the transport to be stopped")
+	}
+	if g.Position() != 3 {
+		t.Fatalf("expected position 3, got %d", g.Position())
+	}
+	if err := g.Continue(); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	runGenerator(t, g, source, &recorded, 7, nil)
+
+	var got []midiv1.Message
+	for _, sent := range recorded {
+		got = append(got, sent.message)
+	}
+	expected := []midiv1.Message{
+		&midiv1.StartMessage{},
+		&midiv1.TimingClockMessage{},
+		&midiv1.TimingClockMessage{},
+		&midiv1.TimingClockMessage{},
+		&midiv1.StopMessage{},
+		&midiv1.ContinueMessage{},
+		&midiv1.TimingClockMessage{},
+	}
+	if !reflect.DeepEqual(expected, got) {
+		t.Fatalf("expected %+v, got %+v", expected, got)
+	}
+	if g.Position() != 4 {
+		t.Fatalf("expected position 4, got %d", g.Position())
+	}
+}
+
+func Test_Generator_Swing(t *testing.T) {
+	t.Parallel()
+	source := &instantSource{}
+	g, err := NewGenerator(nil, WithBPM(125), WithSwing(0.75), WithSource(source))
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	var recorded []sentMessage
+	runGenerator(t, g, source, &recorded, 25, nil)
+
+	// the first sixteenth note of each pair takes 180ms instead of 120ms and the second one takes 60ms
+	expected := map[int]time.Duration{
+		1:  30 * time.Millisecond,
+		6:  180 * time.Millisecond,
+		7:  190 * time.Millisecond,
+		12: 240 * time.Millisecond,
+		18: 420 * time.Millisecond,
+		24: 480 * time.Millisecond,
+	}
+	for i, offset := range expected {
+		if recorded[i].offset != offset {
+			t.Fatalf("expected pulse %d at %v, got %v", i, offset, recorded[i].offset)
+		}
+	}
+}
+
+func Test_Generator_SetBPM(t *testing.T) {
+	t.Parallel()
+	source := &instantSource{}
+	g, err := NewGenerator(nil, WithBPM(125), WithSource(source))
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if err := g.SetBPM(1000, 0); !errors.Is(err, ErrInvalidBPM) {
+		t.Fatalf("expected %v error, got %v", ErrInvalidBPM, err)
+	}
+
+	var recorded []sentMessage
+	runGenerator(t, g, source, &recorded, 4, func(n int) {
+		if n == 2 {
+			if err := g.SetBPM(250, 0); err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+		}
+	})
+
+	// the tempo doubles after the second pulse, halving the time to the pulses that follow
+	expected := []time.Duration{0, 20 * time.Millisecond, 30 * time.Millisecond, 40 * time.Millisecond}
+	for i, offset := range expected {
+		if recorded[i].offset != offset {
+			t.Fatalf("expected pulse %d at %v, got %v", i, offset, recorded[i].offset)
+		}
+	}
+	if g.BPM() != 250 {
+		t.Fatalf("expected 250 BPM, got %v", g.BPM())
+	}
+}
+
+func Test_Generator_SetBPM_WhileWaiting(t *testing.T) {
+	t.Parallel()
+	source := &instantSource{}
+	g, err := NewGenerator(nil, WithBPM(125), WithSource(source))
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	var once sync.Once
+	source.waited = func(now time.Time) {
+		if now.Sub(time.Time{}) != 40*time.Millisecond {
+			return
+		}
+		once.Do(func() {
+			if err := g.SetBPM(62.5, 0); err != nil {
+				t.Errorf("expected nil error, got %v", err)
+			}
+		})
+	}
+
+	var recorded []sentMessage
+	runGenerator(t, g, source, &recorded, 4, nil)
+
+	// the tempo halves just as the wait for the third pulse ends, so the third pulse is already sent at the new tempo
+	expected := []time.Duration{0, 20 * time.Millisecond, 60 * time.Millisecond, 100 * time.Millisecond}
+	for i, offset := range expected {
+		if recorded[i].offset != offset {
+			t.Fatalf("expected pulse %d at %v, got %v", i, offset, recorded[i].offset)
+		}
+	}
+}
+
+func Test_Generator_Run_Stall(t *testing.T) {
+	t.Parallel()
+	source := &instantSource{}
+	g, err := NewGenerator(nil, WithBPM(125), WithSource(source))
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+
+	var recorded []sentMessage
+	runGenerator(t, g, source, &recorded, 5, func(n int) {
+		if n == 2 {
+			// the generator stalls for several pulses after the second one
+			source.After(95 * time.Millisecond)
+		}
+	})
+
+	// the missed pulses are dropped and the schedule carries on from the late pulse
+	expected := []time.Duration{0, 20 * time.Millisecond, 115 * time.Millisecond, 135 * time.Millisecond, 155 * time.Millisecond}
+	for i, offset := range expected {
+		if recorded[i].offset != offset {
+			t.Fatalf("expected pulse %d at %v, got %v", i, offset, recorded[i].offset)
+		}
+	}
+}
+
+func Test_Generator_SetBPM_Ramp(t *testing.T) {
+	t.Parallel()
+	source := &instantSource{}
+	g, err := NewGenerator(nil, WithBPM(100), WithSource(source))
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if err := g.SetBPM(200, time.Second); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+
+	var recorded []sentMessage
+	runGenerator(t, g, source, &recorded, 100, nil)
+
+	// every pulse during the ramp is closer to the last one than the one before it
+	for i := 2; i < len(recorded); i++ {
+		previous := recorded[i-1].offset - recorded[i-2].offset
+		current := recorded[i].offset - recorded[i-1].offset
+		if current > previous {
+			t.Fatalf("expected pulse %d to be no further apart than %v, got %v", i, previous, current)
+		}
+	}
+	if g.BPM() != 200 {
+		t.Fatalf("expected 200 BPM after the ramp, got %v", g.BPM())
+	}
+	last := recorded[len(recorded)-1].offset - recorded[len(recorded)-2].offset
+	if last != 12500*time.Microsecond {
+		t.Fatalf("expected pulses 12.5ms apart after the ramp, got %v", last)
+	}
+}
+
+func Test_WriterOutput(t *testing.T) {
+	t.Parallel()
+	var buffer bytes.Buffer
+	output := WriterOutput(&buffer)
+	if err := output(&midiv1.StartMessage{}); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if err := output(&midiv1.TimingClockMessage{}); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	expected := []byte{0xFA, 0xF8}
+	if !bytes.Equal(expected, buffer.Bytes()) {
+		t.Fatalf("expected %#v, got %#v", expected, buffer.Bytes())
+	}
+}
@@ -0,0 +1,41 @@
+package midiv1
+
+import "fmt"
+
+const (
+	// ActiveSensingMessageStatus represents the status byte of an Active Sensing message.
+	ActiveSensingMessageStatus byte = 0xFE
+
+	// ActiveSensingMessageLength represents the number of bytes in a full Active Sensing message.
+	ActiveSensingMessageLength int = 1
+)
+
+// ActiveSensingMessage represents an Active Sensing System Real-Time message, which is sent at least every 300 milliseconds to tell the receiver that the connection is still alive.
+type ActiveSensingMessage struct{}
+
+// GetMessageName returns the name of this Active Sensing message.
+func (asm *ActiveSensingMessage) GetMessageName() string {
+	return "Active Sensing"
+}
+
+// MarshalMIDI marshalls an ActiveSensingMessage MIDI message into its raw bytes
+func (asm ActiveSensingMessage) MarshalMIDI() ([]byte, error) {
+	return []byte{
+		ActiveSensingMessageStatus,
+	}, nil
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (asm *ActiveSensingMessage) String() string {
+	return fmt.Sprintf(SystemMessageStringFormat, MessageVersion, asm.GetMessageName())
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into an ActiveSensingMessage struct pointer. Active Sensing messages are represented
+// by a single byte: 0xFE.
+//
+// Example: []byte{0xFE}
+//
+// The example forms an Active Sensing message.
+func (asm *ActiveSensingMessage) UnmarshalMIDI(b []byte) error {
+	return unmarshalStatusOnlyMessage("active sensing", ActiveSensingMessageStatus, b)
+}
@@ -0,0 +1,72 @@
+package midiv1
+
+import "fmt"
+
+// AllNotesOffMessage represents a All Notes Off Channel Mode message, which turns off all notes on the channel that were turned on by Note-On messages.
+type AllNotesOffMessage struct {
+	// Channel represents the channel number where this message will be sent.
+	Channel Channel
+}
+
+// GetMessageName returns the name of this All Notes Off message.
+func (anom *AllNotesOffMessage) GetMessageName() string {
+	return "All Notes Off"
+}
+
+// MarshalMIDI marshalls a AllNotesOffMessage MIDI message into its raw bytes
+func (anom AllNotesOffMessage) MarshalMIDI() ([]byte, error) {
+	return marshalChannelModeMessage(anom.Channel, AllNotesOffController, 0), nil
+}
+
+// MarshalRunningStatusMIDI marshalls a running status MIDI message into its raw bytes.
+func (anom AllNotesOffMessage) MarshalRunningStatusMIDI() ([]byte, error) {
+	return []byte{
+		byte(AllNotesOffController),
+		0,
+	}, nil
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (anom *AllNotesOffMessage) String() string {
+	return fmt.Sprintf(ChannelModeMessageStringFormat, MessageVersion, anom.GetMessageName(), anom.Channel)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a AllNotesOffMessage struct pointer. All Notes Off messages are
+// represented by three bytes (left to right): Control Change status/channel, controller number 123, value 0.
+//
+// Example: []byte{0b10110001, 0b01111011, 0b00000000}
+//
+// The example forms a All Notes Off message for channel 2 (index 1).
+func (anom *AllNotesOffMessage) UnmarshalMIDI(b []byte) error {
+	channel, value, err := unmarshalChannelModeMessage("all notes off", AllNotesOffController, b)
+	if err != nil {
+		return err
+	}
+	if err := requireZeroChannelModeValue("all notes off", value); err != nil {
+		return err
+	}
+
+	*anom = AllNotesOffMessage{
+		Channel: channel,
+	}
+	return nil
+}
+
+// UnmarshalRunningStatusMIDI unmarshalls raw bytes into a AllNotesOffMessage struct pointer. All Notes Off running status messages are
+// represented by two bytes (left to right): controller number 123, value 0.
+//
+// Example: []byte{0b01111011, 0b00000000}
+//
+// The example forms a All Notes Off running status message.
+func (anom *AllNotesOffMessage) UnmarshalRunningStatusMIDI(b []byte) error {
+	value, err := unmarshalRunningStatusChannelModeMessage("all notes off", AllNotesOffController, b)
+	if err != nil {
+		return err
+	}
+	if err := requireZeroChannelModeValue("all notes off", value); err != nil {
+		return err
+	}
+
+	*anom = AllNotesOffMessage{}
+	return nil
+}
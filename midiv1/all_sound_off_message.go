@@ -0,0 +1,72 @@
+package midiv1
+
+import "fmt"
+
+// AllSoundOffMessage represents a All Sound Off Channel Mode message, which immediately silences all sounding notes on the channel, including release tails.
+type AllSoundOffMessage struct {
+	// Channel represents the channel number where this message will be sent.
+	Channel Channel
+}
+
+// GetMessageName returns the name of this All Sound Off message.
+func (asom *AllSoundOffMessage) GetMessageName() string {
+	return "All Sound Off"
+}
+
+// MarshalMIDI marshalls a AllSoundOffMessage MIDI message into its raw bytes
+func (asom AllSoundOffMessage) MarshalMIDI() ([]byte, error) {
+	return marshalChannelModeMessage(asom.Channel, AllSoundOffController, 0), nil
+}
+
+// MarshalRunningStatusMIDI marshalls a running status MIDI message into its raw bytes.
+func (asom AllSoundOffMessage) MarshalRunningStatusMIDI() ([]byte, error) {
+	return []byte{
+		byte(AllSoundOffController),
+		0,
+	}, nil
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (asom *AllSoundOffMessage) String() string {
+	return fmt.Sprintf(ChannelModeMessageStringFormat, MessageVersion, asom.GetMessageName(), asom.Channel)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a AllSoundOffMessage struct pointer. All Sound Off messages are
+// represented by three bytes (left to right): Control Change status/channel, controller number 120, value 0.
+//
+// Example: []byte{0b10110001, 0b01111000, 0b00000000}
+//
+// The example forms a All Sound Off message for channel 2 (index 1).
+func (asom *AllSoundOffMessage) UnmarshalMIDI(b []byte) error {
+	channel, value, err := unmarshalChannelModeMessage("all sound off", AllSoundOffController, b)
+	if err != nil {
+		return err
+	}
+	if err := requireZeroChannelModeValue("all sound off", value); err != nil {
+		return err
+	}
+
+	*asom = AllSoundOffMessage{
+		Channel: channel,
+	}
+	return nil
+}
+
+// UnmarshalRunningStatusMIDI unmarshalls raw bytes into a AllSoundOffMessage struct pointer. All Sound Off running status messages are
+// represented by two bytes (left to right): controller number 120, value 0.
+//
+// Example: []byte{0b01111000, 0b00000000}
+//
+// The example forms a All Sound Off running status message.
+func (asom *AllSoundOffMessage) UnmarshalRunningStatusMIDI(b []byte) error {
+	value, err := unmarshalRunningStatusChannelModeMessage("all sound off", AllSoundOffController, b)
+	if err != nil {
+		return err
+	}
+	if err := requireZeroChannelModeValue("all sound off", value); err != nil {
+		return err
+	}
+
+	*asom = AllSoundOffMessage{}
+	return nil
+}
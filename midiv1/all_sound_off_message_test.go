@@ -0,0 +1,177 @@
+package midiv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func Test_AllSoundOffMessage_GetMessageName(t *testing.T) {
+	t.Parallel()
+	message := AllSoundOffMessage{}
+	expected := "All Sound Off"
+	if message.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.GetMessageName())
+	}
+}
+
+func Test_AllSoundOffMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  AllSoundOffMessage
+		expected []byte
+	}{
+		"message marshalls into expected bytes": {
+			message: AllSoundOffMessage{
+				Channel: 1,
+			},
+			expected: []byte{0b10110001, 0b01111000, 0b00000000},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_AllSoundOffMessage_MarshalRunningStatusMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  AllSoundOffMessage
+		expected []byte
+	}{
+		"running status message marshalls into expected bytes": {
+			message:  AllSoundOffMessage{},
+			expected: []byte{0b01111000, 0b00000000},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalRunningStatusMIDI()
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_AllSoundOffMessage_String(t *testing.T) {
+	t.Parallel()
+	message := AllSoundOffMessage{
+		Channel: 1,
+	}
+	expected := fmt.Sprintf("%s:%s:%d", MessageVersion, "All Sound Off", 1)
+	if message.String() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.String())
+	}
+}
+
+func Test_AllSoundOffMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage AllSoundOffMessage
+		err             error
+	}{
+		"byte slice is not proper length": {
+			b:   []byte{0b10110001, 0b01111000},
+			err: ErrUnmarshallingMessage,
+		},
+		"first byte does not have a status MSB": {
+			b:   []byte{0b00110001, 0b01111000, 0b00000000},
+			err: ErrUnmarshallingMessage,
+		},
+		"second byte is the wrong controller number": {
+			b:   []byte{0b10110001, 0b00000111, 0b00000000},
+			err: ErrUnmarshallingMessage,
+		},
+		"third byte is not zero": {
+			b:   []byte{0b10110001, 0b01111000, 0b00000001},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b: []byte{0b10110001, 0b01111000, 0b00000000},
+			expectedMessage: AllSoundOffMessage{
+				Channel: 1,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got AllSoundOffMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil {
+				if err == nil {
+					t.Fatalf("expected non-nil %v error, got nil error", test.err)
+				}
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
+
+func Test_AllSoundOffMessage_UnmarshalRunningStatusMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage AllSoundOffMessage
+		err             error
+	}{
+		"byte slice is not proper length": {
+			b:   []byte{0b10110001, 0b01111000, 0b00000000},
+			err: ErrUnmarshallingMessage,
+		},
+		"first byte is the wrong controller number": {
+			b:   []byte{0b00000111, 0b00000000},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b:               []byte{0b01111000, 0b00000000},
+			expectedMessage: AllSoundOffMessage{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got AllSoundOffMessage
+			err := (&got).UnmarshalRunningStatusMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil {
+				if err == nil {
+					t.Fatalf("expected non-nil %v error, got nil error", test.err)
+				}
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
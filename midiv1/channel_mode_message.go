@@ -0,0 +1,86 @@
+package midiv1
+
+import "fmt"
+
+const (
+	// ChannelModeMessageLength represents the number of bytes in a full Channel Mode message.
+	//
+	// Channel Mode messages share the Control Change status nibble and are identified by controller numbers 120 through 127.
+	ChannelModeMessageLength int = ControlChangeMessageLength
+
+	// ChannelModeMessageStringFormat represents the printf-compatible format specifically for a Channel Mode message string.
+	ChannelModeMessageStringFormat string = "%s:%s:%d"
+)
+
+// PanicMessages returns the All Notes Off and All Sound Off messages for every MIDI channel, in channel order.
+func PanicMessages() []MessageMarshaler {
+	messages := make([]MessageMarshaler, 0, len(AllChannels())*2)
+	for _, channel := range AllChannels() {
+		messages = append(messages, AllNotesOffMessage{Channel: channel}, AllSoundOffMessage{Channel: channel})
+	}
+	return messages
+}
+
+// marshalChannelModeMessage marshalls the Control Change bytes of a Channel Mode message.
+func marshalChannelModeMessage(channel Channel, controller Controller, value byte) []byte {
+	return []byte{
+		MakeStatusByte(ControlChangeMessageStatusNibble, channel),
+		byte(controller),
+		value,
+	}
+}
+
+// unmarshalChannelModeMessage validates the Control Change bytes of a Channel Mode message and returns its channel and
+// raw data value. The name is used to describe the message in any returned errors.
+func unmarshalChannelModeMessage(name string, controller Controller, b []byte) (Channel, byte, error) {
+	// check the number of bytes in the message
+	if len(b) != ChannelModeMessageLength {
+		return MinChannel, 0, fmt.Errorf("%s messages are made up of %d bytes, received %d byte(s): %w", name, ChannelModeMessageLength, len(b), ErrUnmarshallingMessage)
+	}
+
+	// make sure this is a status byte with the proper MSB
+	if !ByteHasStatusMSB(b[0]) {
+		return MinChannel, 0, fmt.Errorf("%s messages must have a status MSB: %w", name, ErrUnmarshallingMessage)
+	}
+
+	// retrieve the channel nibble of the status byte to form the Channel value
+	channel, err := ParseChannelFromStatusByte(b[0])
+	if err != nil {
+		return MinChannel, 0, err
+	}
+
+	value, err := unmarshalRunningStatusChannelModeMessage(name, controller, b[1:])
+	if err != nil {
+		return MinChannel, 0, err
+	}
+	return channel, value, nil
+}
+
+// unmarshalRunningStatusChannelModeMessage validates the running status bytes of a Channel Mode message and returns its
+// raw data value. The name is used to describe the message in any returned errors.
+func unmarshalRunningStatusChannelModeMessage(name string, controller Controller, b []byte) (byte, error) {
+	// check the number of bytes in the running status message
+	if len(b) != ChannelModeMessageLength-1 {
+		return 0, fmt.Errorf("%s running status messages are made up of %d bytes, received %d byte(s): %w", name, ChannelModeMessageLength-1, len(b), ErrUnmarshallingMessage)
+	}
+
+	// make sure the controller number identifies this Channel Mode message
+	if Controller(b[0]) != controller {
+		return 0, fmt.Errorf("%s messages must use controller number %d, received %d: %w", name, controller, b[0], ErrUnmarshallingMessage)
+	}
+
+	// the value must be a data byte
+	if !ByteHasDataMSB(b[1]) {
+		return 0, fmt.Errorf("%s messages must have a data MSB in the value byte: %w", name, ErrUnmarshallingMessage)
+	}
+	return b[1], nil
+}
+
+// requireZeroChannelModeValue returns an error if the value of a Channel Mode message is not zero, as required by the
+// specification for every Channel Mode message other than Local Control and Mono Mode On.
+func requireZeroChannelModeValue(name string, value byte) error {
+	if value != 0 {
+		return fmt.Errorf("%s messages must have a value of 0, received %d: %w", name, value, ErrUnmarshallingMessage)
+	}
+	return nil
+}
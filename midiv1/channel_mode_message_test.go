@@ -0,0 +1,31 @@
+package midiv1
+
+import (
+	"bytes"
+	"testing"
+)
+
+func Test_PanicMessages(t *testing.T) {
+	t.Parallel()
+	got := PanicMessages()
+	if len(got) != 32 {
+		t.Fatalf("expected 32 messages, got %d", len(got))
+	}
+
+	// every channel should receive All Notes Off followed by All Sound Off
+	for i, channel := range AllChannels() {
+		expected := [][]byte{
+			{MakeStatusByte(ControlChangeMessageStatusNibble, channel), byte(AllNotesOffController), 0},
+			{MakeStatusByte(ControlChangeMessageStatusNibble, channel), byte(AllSoundOffController), 0},
+		}
+		for j, expectedBytes := range expected {
+			b, err := got[i*2+j].MarshalMIDI()
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if !bytes.Equal(expectedBytes, b) {
+				t.Fatalf("expected %#v, got %#v", expectedBytes, b)
+			}
+		}
+	}
+}
@@ -7,10 +7,17 @@ const (
 	ChannelPressureMessageCode Nibble = 0b01010000
 
 	// ChannelPressureMessageLength represents the number of bytes in a full Channel Pressure message.
-	ChannelPressureMessageLength int = 3
+	ChannelPressureMessageLength int = 2
+
+	// LegacyChannelPressureMessageLength represents the number of bytes in a full Channel Pressure message as marshalled
+	// by earlier versions of this package, which sent an unused note number before the pressure.
+	LegacyChannelPressureMessageLength int = 3
 
 	// ChannelPressureMessageStatusNibble represents the status nibble within the status byte
 	ChannelPressureMessageStatusNibble Status = Status(StatusMessageMSB) | Status(ChannelPressureMessageCode)
+
+	// ChannelPressureMessageStringFormat represents the printf-compatible format specifically for a Channel Pressure message string.
+	ChannelPressureMessageStringFormat string = "%s:%s:%d:%d"
 )
 
 // ChannelPressureMessage represents a Channel Pressure Channel Voice message.
@@ -18,10 +25,7 @@ type ChannelPressureMessage struct {
 	// Channel represents the channel number where this message will be sent.
 	Channel Channel
 
-	// Note represents the note number that will be sent with this message.
-	Note Note
-
-	// Pressure represents the relative applied pressure of the note in this message.
+	// Pressure represents the relative applied pressure of every note on the channel.
 	Pressure Pressure
 }
 
@@ -34,7 +38,6 @@ func (cpm *ChannelPressureMessage) GetMessageName() string {
 func (cpm ChannelPressureMessage) MarshalMIDI() ([]byte, error) {
 	return []byte{
 		MakeStatusByte(ChannelPressureMessageStatusNibble, cpm.Channel),
-		byte(cpm.Note),
 		byte(cpm.Pressure),
 	}, nil
 }
@@ -42,80 +45,103 @@ func (cpm ChannelPressureMessage) MarshalMIDI() ([]byte, error) {
 // MarshalRunningStatusMIDI marshalls a running status MIDI message into its raw bytes.
 func (cpm ChannelPressureMessage) MarshalRunningStatusMIDI() ([]byte, error) {
 	return []byte{
-		byte(cpm.Note),
 		byte(cpm.Pressure),
 	}, nil
 }
 
 // String returns the human-readable representation of the MIDI message.
 func (cpm *ChannelPressureMessage) String() string {
-	return fmt.Sprintf(MessageStringFormat, MessageVersion, cpm.GetMessageName(), cpm.Channel, cpm.Note, cpm.Pressure)
+	return fmt.Sprintf(ChannelPressureMessageStringFormat, MessageVersion, cpm.GetMessageName(), cpm.Channel, cpm.Pressure)
 }
 
 // UnmarshalMIDI unmarshalls raw bytes into a ChannelPressureMessage struct pointer. Channel Pressure messages are
-// represented by three bytes (left to right): status/channel, note number, note pressure.
+// represented by two bytes (left to right): status/channel, pressure.
 //
-// Example: []byte{0b11010001, 0b01000000, 0b00100000}
+// Example: []byte{0b11010001, 0b00100000}
 //
-// The example forms a Channel Pressure message for channel 2 (index 1), note number 64, pressure value 32.
+// The example forms a Channel Pressure message for channel 2 (index 1), pressure value 32.
 func (cpm *ChannelPressureMessage) UnmarshalMIDI(b []byte) error {
 	// check the number of bytes in the message
 	if len(b) != ChannelPressureMessageLength {
 		return fmt.Errorf("channel pressure messages are made up of %d bytes, received %d byte(s): %w", ChannelPressureMessageLength, len(b), ErrUnmarshallingMessage)
 	}
+	return cpm.unmarshalMIDI(b[0], b[1])
+}
 
-	// make sure this is a status byte with the proper MSB
-	if !ByteHasStatusMSB(b[0]) {
-		return fmt.Errorf("channel pressure messages must have a status MSB: %w", ErrUnmarshallingMessage)
+// UnmarshalLegacyMIDI unmarshalls the raw bytes of a Channel Pressure message marshalled by earlier versions of this
+// package into a ChannelPressureMessage struct pointer. Legacy Channel Pressure messages are represented by three bytes
+// (left to right): status/channel, unused note number, pressure.
+//
+// Example: []byte{0b11010001, 0b01000000, 0b00100000}
+//
+// The example forms a Channel Pressure message for channel 2 (index 1), pressure value 32.
+func (cpm *ChannelPressureMessage) UnmarshalLegacyMIDI(b []byte) error {
+	// check the number of bytes in the message
+	if len(b) != LegacyChannelPressureMessageLength {
+		return fmt.Errorf("legacy channel pressure messages are made up of %d bytes, received %d byte(s): %w", LegacyChannelPressureMessageLength, len(b), ErrUnmarshallingMessage)
 	}
 
-	// retrieve the channel nibble of the status byte to form the Channel value
-	channel, err := ParseChannelFromStatusByte(b[0])
-	if err != nil {
-		return err
+	// the note number was never sent by other devices, so it is checked and dropped
+	if _, err := NewNoteFromByte(b[1]); err != nil {
+		return fmt.Errorf("invalid note number (%v) from legacy note byte: %w", err, ErrUnmarshallingMessage)
 	}
+	return cpm.unmarshalMIDI(b[0], b[2])
+}
 
-	// form the note number
-	note, err := NewNoteFromByte(b[1])
-	if err != nil {
-		return fmt.Errorf("invalid note number (%v) from note byte: %w", err, ErrUnmarshallingMessage)
+// UnmarshalCompatibleMIDI unmarshalls the raw bytes of a Channel Pressure message in either the two-byte layout or the
+// legacy three-byte layout into a ChannelPressureMessage struct pointer, telling the layouts apart by their length. It
+// is meant for messages that were stored one by one, such as messages saved by earlier versions of this package.
+func (cpm *ChannelPressureMessage) UnmarshalCompatibleMIDI(b []byte) error {
+	if len(b) == LegacyChannelPressureMessageLength {
+		return cpm.UnmarshalLegacyMIDI(b)
 	}
-
-	// form the pressure
-	pressure := NewPressureFromByte(b[2])
-
-	*cpm = ChannelPressureMessage{
-		Channel:  channel,
-		Note:     note,
-		Pressure: pressure,
-	}
-	return nil
+	return cpm.UnmarshalMIDI(b)
 }
 
 // UnmarshalRunningStatusMIDI unmarshalls raw bytes into a ChannelPressureMessage struct pointer. Channel Pressure running status messages are
-// represented by two bytes (left to right): note number, note pressure.
+// represented by one byte: pressure.
 //
-// Example: []byte{0b01000000, 0b00100000}
+// Example: []byte{0b00100000}
 //
-// The example forms a Channel Pressure running status message for note number 64, pressure value 32.
+// The example forms a Channel Pressure running status message for pressure value 32.
 func (cpm *ChannelPressureMessage) UnmarshalRunningStatusMIDI(b []byte) error {
 	// check the number of bytes in the running status message
 	if len(b) != ChannelPressureMessageLength-1 {
 		return fmt.Errorf("channel pressure running status messages are made up of %d bytes, received %d byte(s): %w", ChannelPressureMessageLength-1, len(b), ErrUnmarshallingMessage)
 	}
 
-	// form the note number
-	note, err := NewNoteFromByte(b[0])
+	// make sure the pressure is a data byte
+	if ByteHasStatusMSB(b[0]) {
+		return fmt.Errorf("invalid pressure %#v from running status pressure byte: %w", b[0], ErrUnmarshallingMessage)
+	}
+
+	*cpm = ChannelPressureMessage{
+		Pressure: NewPressureFromByte(b[0]),
+	}
+	return nil
+}
+
+// unmarshalMIDI forms the ChannelPressureMessage from its status byte and pressure byte.
+func (cpm *ChannelPressureMessage) unmarshalMIDI(status byte, pressure byte) error {
+	// make sure this is a status byte with the proper MSB
+	if !ByteHasStatusMSB(status) {
+		return fmt.Errorf("channel pressure messages must have a status MSB: %w", ErrUnmarshallingMessage)
+	}
+
+	// retrieve the channel nibble of the status byte to form the Channel value
+	channel, err := ParseChannelFromStatusByte(status)
 	if err != nil {
-		return fmt.Errorf("invalid note number %#v (%v) from running status note byte: %w", b[0], err, ErrUnmarshallingMessage)
+		return err
 	}
 
-	// form the pressure
-	pressure := NewPressureFromByte(b[1])
+	// make sure the pressure is a data byte
+	if ByteHasStatusMSB(pressure) {
+		return fmt.Errorf("invalid pressure %#v from pressure byte: %w", pressure, ErrUnmarshallingMessage)
+	}
 
 	*cpm = ChannelPressureMessage{
-		Note:     note,
-		Pressure: pressure,
+		Channel:  channel,
+		Pressure: NewPressureFromByte(pressure),
 	}
 	return nil
 }
@@ -26,10 +26,9 @@ func Test_ChannelPressureMessage_MarshalMIDI(t *testing.T) {
 		"message marshalls into expected bytes": {
 			message: ChannelPressureMessage{
 				Channel:  1,
-				Note:     64,
 				Pressure: 32,
 			},
-			expected: []byte{0b11010001, 0b01000000, 0b00100000},
+			expected: []byte{0b11010001, 0b00100000},
 		},
 	}
 
@@ -54,10 +53,9 @@ func Test_ChannelPressureMessage_MarshalRunningStatusMIDI(t *testing.T) {
 	}{
 		"running status message marshalls into expected bytes": {
 			message: ChannelPressureMessage{
-				Note:     64,
 				Pressure: 32,
 			},
-			expected: []byte{0b01000000, 0b00100000},
+			expected: []byte{0b00100000},
 		},
 	}
 
@@ -78,10 +76,9 @@ func Test_ChannelPressureMessage_String(t *testing.T) {
 	t.Parallel()
 	message := ChannelPressureMessage{
 		Channel:  1,
-		Note:     64,
 		Pressure: 32,
 	}
-	expected := fmt.Sprintf("%s:%s:%d:%d:%d", MessageVersion, "Channel Pressure", 1, 64, 32)
+	expected := fmt.Sprintf("%s:%s:%d:%d", MessageVersion, "Channel Pressure", 1, 32)
 	if message.String() != expected {
 		t.Fatalf("expected %s, got %s", expected, message.String())
 	}
@@ -95,7 +92,57 @@ func Test_ChannelPressureMessage_UnmarshalMIDI(t *testing.T) {
 		err             error
 	}{
 		"byte slice is not proper length": {
-			b:   []byte{0b11010001, 0b01000000},
+			b:   []byte{0b11010001, 0b01000000, 0b00100000},
+			err: ErrUnmarshallingMessage,
+		},
+		"first byte does not have a status MSB": {
+			b:   []byte{0b01010001, 0b00100000},
+			err: ErrUnmarshallingMessage,
+		},
+		"second byte is not a data byte": {
+			b:   []byte{0b11010001, 0b11000000},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b: []byte{0b11010001, 0b00100000},
+			expectedMessage: ChannelPressureMessage{
+				Channel:  1,
+				Pressure: 32,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got ChannelPressureMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil {
+				if err == nil {
+					t.Fatalf("expected non-nil %v error, got nil error", test.err)
+				}
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
+
+func Test_ChannelPressureMessage_UnmarshalLegacyMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage ChannelPressureMessage
+		err             error
+	}{
+		"byte slice is not proper length": {
+			b:   []byte{0b11010001, 0b00100000},
 			err: ErrUnmarshallingMessage,
 		},
 		"first byte does not have a status MSB": {
@@ -110,7 +157,6 @@ func Test_ChannelPressureMessage_UnmarshalMIDI(t *testing.T) {
 			b: []byte{0b11010001, 0b01000000, 0b00100000},
 			expectedMessage: ChannelPressureMessage{
 				Channel:  1,
-				Note:     64,
 				Pressure: 32,
 			},
 		},
@@ -119,7 +165,56 @@ func Test_ChannelPressureMessage_UnmarshalMIDI(t *testing.T) {
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			var got ChannelPressureMessage
-			err := (&got).UnmarshalMIDI(test.b)
+			err := (&got).UnmarshalLegacyMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil {
+				if err == nil {
+					t.Fatalf("expected non-nil %v error, got nil error", test.err)
+				}
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
+
+func Test_ChannelPressureMessage_UnmarshalCompatibleMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage ChannelPressureMessage
+		err             error
+	}{
+		"byte slice is not proper length": {
+			b:   []byte{0b11010001},
+			err: ErrUnmarshallingMessage,
+		},
+		"two bytes unmarshal into expected message": {
+			b: []byte{0b11010001, 0b00100000},
+			expectedMessage: ChannelPressureMessage{
+				Channel:  1,
+				Pressure: 32,
+			},
+		},
+		"legacy three bytes unmarshal into expected message": {
+			b: []byte{0b11010001, 0b01000000, 0b00100000},
+			expectedMessage: ChannelPressureMessage{
+				Channel:  1,
+				Pressure: 32,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got ChannelPressureMessage
+			err := (&got).UnmarshalCompatibleMIDI(test.b)
 			if test.err == nil && err != nil {
 				t.Fatalf("expected nil error, got %v", err)
 			}
@@ -146,17 +241,16 @@ func Test_ChannelPressureMessage_UnmarshalRunningStatusMIDI(t *testing.T) {
 		err             error
 	}{
 		"byte slice is not proper length": {
-			b:   []byte{0b11010001, 0b10010001, 0b01000000},
+			b:   []byte{0b01000000, 0b00100000},
 			err: ErrUnmarshallingMessage,
 		},
-		"second byte is an invalid note number": {
-			b:   []byte{0b11000000, 0b00100000},
+		"byte is not a data byte": {
+			b:   []byte{0b11000000},
 			err: ErrUnmarshallingMessage,
 		},
 		"bytes unmarshal into expected message": {
-			b: []byte{0b01000000, 0b00100000},
+			b: []byte{0b00100000},
 			expectedMessage: ChannelPressureMessage{
-				Note:     64,
 				Pressure: 32,
 			},
 		},
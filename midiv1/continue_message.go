@@ -0,0 +1,41 @@
+package midiv1
+
+import "fmt"
+
+const (
+	// ContinueMessageStatus represents the status byte of a Continue message.
+	ContinueMessageStatus byte = 0xFB
+
+	// ContinueMessageLength represents the number of bytes in a full Continue message.
+	ContinueMessageLength int = 1
+)
+
+// ContinueMessage represents a Continue System Real-Time message, which resumes playback from the current song position.
+type ContinueMessage struct{}
+
+// GetMessageName returns the name of this Continue message.
+func (cm *ContinueMessage) GetMessageName() string {
+	return "Continue"
+}
+
+// MarshalMIDI marshalls a ContinueMessage MIDI message into its raw bytes
+func (cm ContinueMessage) MarshalMIDI() ([]byte, error) {
+	return []byte{
+		ContinueMessageStatus,
+	}, nil
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (cm *ContinueMessage) String() string {
+	return fmt.Sprintf(SystemMessageStringFormat, MessageVersion, cm.GetMessageName())
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a ContinueMessage struct pointer. Continue messages are represented
+// by a single byte: 0xFB.
+//
+// Example: []byte{0xFB}
+//
+// The example forms a Continue message.
+func (cm *ContinueMessage) UnmarshalMIDI(b []byte) error {
+	return unmarshalStatusOnlyMessage("continue", ContinueMessageStatus, b)
+}
@@ -0,0 +1,67 @@
+package midiv1
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_ContinueMessage_GetMessageName(t *testing.T) {
+	t.Parallel()
+	message := ContinueMessage{}
+	expected := "Continue"
+	if message.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.GetMessageName())
+	}
+}
+
+func Test_ContinueMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	got, err := ContinueMessage{}.MarshalMIDI()
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	expected := []byte{0xFB}
+	if !bytes.Equal(expected, got) {
+		t.Fatalf("expected %#v, got %#v", expected, got)
+	}
+}
+
+func Test_ContinueMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage ContinueMessage
+		err             error
+	}{
+		"byte slice is not proper length": {
+			b:   []byte{0xFB, 0x00},
+			err: ErrUnmarshallingMessage,
+		},
+		"status byte is not continue": {
+			b:   []byte{0xF6},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b:               []byte{0xFB},
+			expectedMessage: ContinueMessage{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got ContinueMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package midiv1
+
+import "fmt"
+
+const (
+	// ControlChangeMessageStatusCode represents the message code within the status nibble
+	ControlChangeMessageCode Nibble = 0b00110000
+
+	// ControlChangeMessageLength represents the number of bytes in a full Control Change message.
+	ControlChangeMessageLength int = 3
+
+	// ControlChangeMessageStatusNibble represents the status nibble within the status byte
+	ControlChangeMessageStatusNibble Status = Status(StatusMessageMSB) | Status(ControlChangeMessageCode)
+)
+
+// ControlChangeMessage represents a Control Change Channel Voice message.
+type ControlChangeMessage struct {
+	// Channel represents the channel number where this message will be sent.
+	Channel Channel
+
+	// Controller represents the controller number that will be sent with this message.
+	Controller Controller
+
+	// Value represents the value of the controller in this message.
+	Value ControllerValue
+}
+
+// GetMessageName returns the name of this Control Change message.
+func (ccm *ControlChangeMessage) GetMessageName() string {
+	return "Control Change"
+}
+
+// MarshalMIDI marshalls a ControlChangeMessage MIDI message into its raw bytes
+func (ccm ControlChangeMessage) MarshalMIDI() ([]byte, error) {
+	return []byte{
+		MakeStatusByte(ControlChangeMessageStatusNibble, ccm.Channel),
+		byte(ccm.Controller),
+		byte(ccm.Value),
+	}, nil
+}
+
+// MarshalRunningStatusMIDI marshalls a running status MIDI message into its raw bytes.
+func (ccm ControlChangeMessage) MarshalRunningStatusMIDI() ([]byte, error) {
+	return []byte{
+		byte(ccm.Controller),
+		byte(ccm.Value),
+	}, nil
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (ccm *ControlChangeMessage) String() string {
+	return fmt.Sprintf(MessageStringFormat, MessageVersion, ccm.GetMessageName(), ccm.Channel, ccm.Controller, ccm.Value)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a ControlChangeMessage struct pointer. Control Change messages are
+// represented by three bytes (left to right): status/channel, controller number, controller value.
+//
+// Example: []byte{0b10110001, 0b00000111, 0b01100100}
+//
+// The example forms a Control Change message for channel 2 (index 1), controller number 7 (Channel Volume), value 100.
+func (ccm *ControlChangeMessage) UnmarshalMIDI(b []byte) error {
+	// check the number of bytes in the message
+	if len(b) != ControlChangeMessageLength {
+		return fmt.Errorf("control change messages are made up of %d bytes, received %d byte(s): %w", ControlChangeMessageLength, len(b), ErrUnmarshallingMessage)
+	}
+
+	// make sure this is a status byte with the proper MSB
+	if !ByteHasStatusMSB(b[0]) {
+		return fmt.Errorf("control change messages must have a status MSB: %w", ErrUnmarshallingMessage)
+	}
+
+	// retrieve the channel nibble of the status byte to form the Channel value
+	channel, err := ParseChannelFromStatusByte(b[0])
+	if err != nil {
+		return err
+	}
+
+	// form the controller number
+	controller, err := NewControllerFromByte(b[1])
+	if err != nil {
+		return fmt.Errorf("invalid controller number (%v) from controller byte: %w", err, ErrUnmarshallingMessage)
+	}
+
+	// form the controller value
+	value := NewControllerValueFromByte(b[2])
+
+	*ccm = ControlChangeMessage{
+		Channel:    channel,
+		Controller: controller,
+		Value:      value,
+	}
+	return nil
+}
+
+// UnmarshalRunningStatusMIDI unmarshalls raw bytes into a ControlChangeMessage struct pointer. Control Change running status messages are
+// represented by two bytes (left to right): controller number, controller value.
+//
+// Example: []byte{0b00000111, 0b01100100}
+//
+// The example forms a Control Change running status message for controller number 7 (Channel Volume), value 100.
+func (ccm *ControlChangeMessage) UnmarshalRunningStatusMIDI(b []byte) error {
+	// check the number of bytes in the running status message
+	if len(b) != ControlChangeMessageLength-1 {
+		return fmt.Errorf("control change running status messages are made up of %d bytes, received %d byte(s): %w", ControlChangeMessageLength-1, len(b), ErrUnmarshallingMessage)
+	}
+
+	// form the controller number
+	controller, err := NewControllerFromByte(b[0])
+	if err != nil {
+		return fmt.Errorf("invalid controller number %#v (%v) from running status controller byte: %w", b[0], err, ErrUnmarshallingMessage)
+	}
+
+	// form the controller value
+	value := NewControllerValueFromByte(b[1])
+
+	*ccm = ControlChangeMessage{
+		Controller: controller,
+		Value:      value,
+	}
+	return nil
+}
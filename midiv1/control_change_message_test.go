@@ -0,0 +1,185 @@
+package midiv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func Test_ControlChangeMessage_GetMessageName(t *testing.T) {
+	t.Parallel()
+	message := ControlChangeMessage{}
+	expected := "Control Change"
+	if message.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.GetMessageName())
+	}
+}
+
+func Test_ControlChangeMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  ControlChangeMessage
+		expected []byte
+	}{
+		"message marshalls into expected bytes": {
+			message: ControlChangeMessage{
+				Channel:    1,
+				Controller: 7,
+				Value:      100,
+			},
+			expected: []byte{0b10110001, 0b00000111, 0b01100100},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_ControlChangeMessage_MarshalRunningStatusMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  ControlChangeMessage
+		expected []byte
+	}{
+		"running status message marshalls into expected bytes": {
+			message: ControlChangeMessage{
+				Controller: 7,
+				Value:      100,
+			},
+			expected: []byte{0b00000111, 0b01100100},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalRunningStatusMIDI()
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_ControlChangeMessage_String(t *testing.T) {
+	t.Parallel()
+	message := ControlChangeMessage{
+		Channel:    1,
+		Controller: 7,
+		Value:      100,
+	}
+	expected := fmt.Sprintf("%s:%s:%d:%d:%d", MessageVersion, "Control Change", 1, 7, 100)
+	if message.String() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.String())
+	}
+}
+
+func Test_ControlChangeMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage ControlChangeMessage
+		err             error
+	}{
+		"byte slice is not proper length": {
+			b:   []byte{0b10110001, 0b01000000},
+			err: ErrUnmarshallingMessage,
+		},
+		"first byte does not have a status MSB": {
+			b:   []byte{0b00110001, 0b00000111, 0b01100100},
+			err: ErrUnmarshallingMessage,
+		},
+		"second byte is an invalid controller number": {
+			b:   []byte{0b10110001, 0b11000000, 0b01100100},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b: []byte{0b10110001, 0b00000111, 0b01100100},
+			expectedMessage: ControlChangeMessage{
+				Channel:    1,
+				Controller: 7,
+				Value:      100,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got ControlChangeMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil {
+				if err == nil {
+					t.Fatalf("expected non-nil %v error, got nil error", test.err)
+				}
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
+
+func Test_ControlChangeMessage_UnmarshalRunningStatusMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage ControlChangeMessage
+		err             error
+	}{
+		"byte slice is not proper length": {
+			b:   []byte{0b10110001, 0b10110001, 0b01000000},
+			err: ErrUnmarshallingMessage,
+		},
+		"second byte is an invalid controller number": {
+			b:   []byte{0b11000000, 0b01100100},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b: []byte{0b00000111, 0b01100100},
+			expectedMessage: ControlChangeMessage{
+				Controller: 7,
+				Value:      100,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got ControlChangeMessage
+			err := (&got).UnmarshalRunningStatusMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil {
+				if err == nil {
+					t.Fatalf("expected non-nil %v error, got nil error", test.err)
+				}
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,462 @@
+package midiv1
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Controller represents the controller number of an individual MIDI message. Valid values are between 0 and 127 inclusive
+// when converted to an integer.
+//
+// Controller is only used in conjunction with Control Change Channel Voice messages. Controller numbers 120 through 127
+// are reserved for Channel Mode messages.
+type Controller int
+
+var (
+	// ErrInvalidController represents an invalid MIDI controller number.
+	ErrInvalidController error = errors.New("invalid MIDI controller number")
+)
+
+const (
+	// MinController is the lowest MIDI controller number available.
+	MinController Controller = 0
+
+	// MaxController is the highest MIDI controller number available.
+	MaxController Controller = 127
+
+	// MaxHighResolutionController is the highest controller number that carries the MSB of a 14-bit controller pair.
+	MaxHighResolutionController Controller = 31
+
+	// HighResolutionLSBOffset is the distance between the controller numbers that carry the MSB and the LSB of a 14-bit
+	// controller pair.
+	HighResolutionLSBOffset Controller = 32
+)
+
+const (
+	// BankSelectMSBController represents the most-significant byte of the Bank Select controller.
+	BankSelectMSBController Controller = 0
+
+	// ModulationWheelMSBController represents the most-significant byte of the Modulation Wheel controller.
+	ModulationWheelMSBController Controller = 1
+
+	// BreathControllerMSBController represents the most-significant byte of the Breath controller.
+	BreathControllerMSBController Controller = 2
+
+	// Undefined3Controller represents undefined controller number 3.
+	Undefined3Controller Controller = 3
+
+	// FootControllerMSBController represents the most-significant byte of the Foot controller.
+	FootControllerMSBController Controller = 4
+
+	// PortamentoTimeMSBController represents the most-significant byte of the Portamento Time controller.
+	PortamentoTimeMSBController Controller = 5
+
+	// DataEntryMSBController represents the most-significant byte of the Data Entry controller.
+	DataEntryMSBController Controller = 6
+
+	// ChannelVolumeMSBController represents the most-significant byte of the Channel Volume controller.
+	ChannelVolumeMSBController Controller = 7
+
+	// BalanceMSBController represents the most-significant byte of the Balance controller.
+	BalanceMSBController Controller = 8
+
+	// Undefined9Controller represents undefined controller number 9.
+	Undefined9Controller Controller = 9
+
+	// PanMSBController represents the most-significant byte of the Pan controller.
+	PanMSBController Controller = 10
+
+	// ExpressionControllerMSBController represents the most-significant byte of the Expression controller.
+	ExpressionControllerMSBController Controller = 11
+
+	// EffectControl1MSBController represents the most-significant byte of the Effect Control 1 controller.
+	EffectControl1MSBController Controller = 12
+
+	// EffectControl2MSBController represents the most-significant byte of the Effect Control 2 controller.
+	EffectControl2MSBController Controller = 13
+
+	// Undefined14Controller represents undefined controller number 14.
+	Undefined14Controller Controller = 14
+
+	// Undefined15Controller represents undefined controller number 15.
+	Undefined15Controller Controller = 15
+
+	// GeneralPurposeController1MSBController represents the most-significant byte of General Purpose Controller 1.
+	GeneralPurposeController1MSBController Controller = 16
+
+	// GeneralPurposeController2MSBController represents the most-significant byte of General Purpose Controller 2.
+	GeneralPurposeController2MSBController Controller = 17
+
+	// GeneralPurposeController3MSBController represents the most-significant byte of General Purpose Controller 3.
+	GeneralPurposeController3MSBController Controller = 18
+
+	// GeneralPurposeController4MSBController represents the most-significant byte of General Purpose Controller 4.
+	GeneralPurposeController4MSBController Controller = 19
+
+	// Undefined20Controller represents undefined controller number 20.
+	Undefined20Controller Controller = 20
+
+	// Undefined21Controller represents undefined controller number 21.
+	Undefined21Controller Controller = 21
+
+	// Undefined22Controller represents undefined controller number 22.
+	Undefined22Controller Controller = 22
+
+	// Undefined23Controller represents undefined controller number 23.
+	Undefined23Controller Controller = 23
+
+	// Undefined24Controller represents undefined controller number 24.
+	Undefined24Controller Controller = 24
+
+	// Undefined25Controller represents undefined controller number 25.
+	Undefined25Controller Controller = 25
+
+	// Undefined26Controller represents undefined controller number 26.
+	Undefined26Controller Controller = 26
+
+	// Undefined27Controller represents undefined controller number 27.
+	Undefined27Controller Controller = 27
+
+	// Undefined28Controller represents undefined controller number 28.
+	Undefined28Controller Controller = 28
+
+	// Undefined29Controller represents undefined controller number 29.
+	Undefined29Controller Controller = 29
+
+	// Undefined30Controller represents undefined controller number 30.
+	Undefined30Controller Controller = 30
+
+	// Undefined31Controller represents undefined controller number 31.
+	Undefined31Controller Controller = 31
+
+	// BankSelectLSBController represents the least-significant byte of the Bank Select controller.
+	BankSelectLSBController Controller = 32
+
+	// ModulationWheelLSBController represents the least-significant byte of the Modulation Wheel controller.
+	ModulationWheelLSBController Controller = 33
+
+	// BreathControllerLSBController represents the least-significant byte of the Breath controller.
+	BreathControllerLSBController Controller = 34
+
+	// Undefined3LSBController represents the least-significant byte of undefined controller number 3.
+	Undefined3LSBController Controller = 35
+
+	// FootControllerLSBController represents the least-significant byte of the Foot controller.
+	FootControllerLSBController Controller = 36
+
+	// PortamentoTimeLSBController represents the least-significant byte of the Portamento Time controller.
+	PortamentoTimeLSBController Controller = 37
+
+	// DataEntryLSBController represents the least-significant byte of the Data Entry controller.
+	DataEntryLSBController Controller = 38
+
+	// ChannelVolumeLSBController represents the least-significant byte of the Channel Volume controller.
+	ChannelVolumeLSBController Controller = 39
+
+	// BalanceLSBController represents the least-significant byte of the Balance controller.
+	BalanceLSBController Controller = 40
+
+	// Undefined9LSBController represents the least-significant byte of undefined controller number 9.
+	Undefined9LSBController Controller = 41
+
+	// PanLSBController represents the least-significant byte of the Pan controller.
+	PanLSBController Controller = 42
+
+	// ExpressionControllerLSBController represents the least-significant byte of the Expression controller.
+	ExpressionControllerLSBController Controller = 43
+
+	// EffectControl1LSBController represents the least-significant byte of the Effect Control 1 controller.
+	EffectControl1LSBController Controller = 44
+
+	// EffectControl2LSBController represents the least-significant byte of the Effect Control 2 controller.
+	EffectControl2LSBController Controller = 45
+
+	// Undefined14LSBController represents the least-significant byte of undefined controller number 14.
+	Undefined14LSBController Controller = 46
+
+	// Undefined15LSBController represents the least-significant byte of undefined controller number 15.
+	Undefined15LSBController Controller = 47
+
+	// GeneralPurposeController1LSBController represents the least-significant byte of General Purpose Controller 1.
+	GeneralPurposeController1LSBController Controller = 48
+
+	// GeneralPurposeController2LSBController represents the least-significant byte of General Purpose Controller 2.
+	GeneralPurposeController2LSBController Controller = 49
+
+	// GeneralPurposeController3LSBController represents the least-significant byte of General Purpose Controller 3.
+	GeneralPurposeController3LSBController Controller = 50
+
+	// GeneralPurposeController4LSBController represents the least-significant byte of General Purpose Controller 4.
+	GeneralPurposeController4LSBController Controller = 51
+
+	// Undefined20LSBController represents the least-significant byte of undefined controller number 20.
+	Undefined20LSBController Controller = 52
+
+	// Undefined21LSBController represents the least-significant byte of undefined controller number 21.
+	Undefined21LSBController Controller = 53
+
+	// Undefined22LSBController represents the least-significant byte of undefined controller number 22.
+	Undefined22LSBController Controller = 54
+
+	// Undefined23LSBController represents the least-significant byte of undefined controller number 23.
+	Undefined23LSBController Controller = 55
+
+	// Undefined24LSBController represents the least-significant byte of undefined controller number 24.
+	Undefined24LSBController Controller = 56
+
+	// Undefined25LSBController represents the least-significant byte of undefined controller number 25.
+	Undefined25LSBController Controller = 57
+
+	// Undefined26LSBController represents the least-significant byte of undefined controller number 26.
+	Undefined26LSBController Controller = 58
+
+	// Undefined27LSBController represents the least-significant byte of undefined controller number 27.
+	Undefined27LSBController Controller = 59
+
+	// Undefined28LSBController represents the least-significant byte of undefined controller number 28.
+	Undefined28LSBController Controller = 60
+
+	// Undefined29LSBController represents the least-significant byte of undefined controller number 29.
+	Undefined29LSBController Controller = 61
+
+	// Undefined30LSBController represents the least-significant byte of undefined controller number 30.
+	Undefined30LSBController Controller = 62
+
+	// Undefined31LSBController represents the least-significant byte of undefined controller number 31.
+	Undefined31LSBController Controller = 63
+
+	// DamperPedalController represents the Damper Pedal (Sustain) on/off switch.
+	DamperPedalController Controller = 64
+
+	// SustainController is an alias of DamperPedalController.
+	SustainController Controller = DamperPedalController
+
+	// PortamentoController represents the Portamento on/off switch.
+	PortamentoController Controller = 65
+
+	// SostenutoController represents the Sostenuto on/off switch.
+	SostenutoController Controller = 66
+
+	// SoftPedalController represents the Soft Pedal on/off switch.
+	SoftPedalController Controller = 67
+
+	// LegatoFootswitchController represents the Legato Footswitch.
+	LegatoFootswitchController Controller = 68
+
+	// Hold2Controller represents the Hold 2 switch.
+	Hold2Controller Controller = 69
+
+	// SoundController1Controller represents Sound Controller 1 (default: Sound Variation).
+	SoundController1Controller Controller = 70
+
+	// SoundController2Controller represents Sound Controller 2 (default: Timbre/Harmonic Intensity).
+	SoundController2Controller Controller = 71
+
+	// SoundController3Controller represents Sound Controller 3 (default: Release Time).
+	SoundController3Controller Controller = 72
+
+	// SoundController4Controller represents Sound Controller 4 (default: Attack Time).
+	SoundController4Controller Controller = 73
+
+	// SoundController5Controller represents Sound Controller 5 (default: Brightness).
+	SoundController5Controller Controller = 74
+
+	// SoundController6Controller represents Sound Controller 6 (default: Decay Time).
+	SoundController6Controller Controller = 75
+
+	// SoundController7Controller represents Sound Controller 7 (default: Vibrato Rate).
+	SoundController7Controller Controller = 76
+
+	// SoundController8Controller represents Sound Controller 8 (default: Vibrato Depth).
+	SoundController8Controller Controller = 77
+
+	// SoundController9Controller represents Sound Controller 9 (default: Vibrato Delay).
+	SoundController9Controller Controller = 78
+
+	// SoundController10Controller represents Sound Controller 10 (default undefined).
+	SoundController10Controller Controller = 79
+
+	// GeneralPurposeController5Controller represents General Purpose Controller 5.
+	GeneralPurposeController5Controller Controller = 80
+
+	// GeneralPurposeController6Controller represents General Purpose Controller 6.
+	GeneralPurposeController6Controller Controller = 81
+
+	// GeneralPurposeController7Controller represents General Purpose Controller 7.
+	GeneralPurposeController7Controller Controller = 82
+
+	// GeneralPurposeController8Controller represents General Purpose Controller 8.
+	GeneralPurposeController8Controller Controller = 83
+
+	// PortamentoControlController represents the Portamento Control (source note) controller.
+	PortamentoControlController Controller = 84
+
+	// Undefined85Controller represents undefined controller number 85.
+	Undefined85Controller Controller = 85
+
+	// Undefined86Controller represents undefined controller number 86.
+	Undefined86Controller Controller = 86
+
+	// Undefined87Controller represents undefined controller number 87.
+	Undefined87Controller Controller = 87
+
+	// HighResolutionVelocityPrefixController represents the High Resolution Velocity Prefix controller.
+	HighResolutionVelocityPrefixController Controller = 88
+
+	// Undefined89Controller represents undefined controller number 89.
+	Undefined89Controller Controller = 89
+
+	// Undefined90Controller represents undefined controller number 90.
+	Undefined90Controller Controller = 90
+
+	// Effects1DepthController represents Effects 1 Depth (default: Reverb Send Level).
+	Effects1DepthController Controller = 91
+
+	// Effects2DepthController represents Effects 2 Depth (default: Tremolo Level).
+	Effects2DepthController Controller = 92
+
+	// Effects3DepthController represents Effects 3 Depth (default: Chorus Send Level).
+	Effects3DepthController Controller = 93
+
+	// Effects4DepthController represents Effects 4 Depth (default: Celeste/Detune Depth).
+	Effects4DepthController Controller = 94
+
+	// Effects5DepthController represents Effects 5 Depth (default: Phaser Depth).
+	Effects5DepthController Controller = 95
+
+	// DataIncrementController represents the Data Increment controller.
+	DataIncrementController Controller = 96
+
+	// DataDecrementController represents the Data Decrement controller.
+	DataDecrementController Controller = 97
+
+	// NonRegisteredParameterNumberLSBController represents the least-significant byte of the Non-Registered Parameter Number.
+	NonRegisteredParameterNumberLSBController Controller = 98
+
+	// NonRegisteredParameterNumberMSBController represents the most-significant byte of the Non-Registered Parameter Number.
+	NonRegisteredParameterNumberMSBController Controller = 99
+
+	// RegisteredParameterNumberLSBController represents the least-significant byte of the Registered Parameter Number.
+	RegisteredParameterNumberLSBController Controller = 100
+
+	// RegisteredParameterNumberMSBController represents the most-significant byte of the Registered Parameter Number.
+	RegisteredParameterNumberMSBController Controller = 101
+
+	// Undefined102Controller represents undefined controller number 102.
+	Undefined102Controller Controller = 102
+
+	// Undefined103Controller represents undefined controller number 103.
+	Undefined103Controller Controller = 103
+
+	// Undefined104Controller represents undefined controller number 104.
+	Undefined104Controller Controller = 104
+
+	// Undefined105Controller represents undefined controller number 105.
+	Undefined105Controller Controller = 105
+
+	// Undefined106Controller represents undefined controller number 106.
+	Undefined106Controller Controller = 106
+
+	// Undefined107Controller represents undefined controller number 107.
+	Undefined107Controller Controller = 107
+
+	// Undefined108Controller represents undefined controller number 108.
+	Undefined108Controller Controller = 108
+
+	// Undefined109Controller represents undefined controller number 109.
+	Undefined109Controller Controller = 109
+
+	// Undefined110Controller represents undefined controller number 110.
+	Undefined110Controller Controller = 110
+
+	// Undefined111Controller represents undefined controller number 111.
+	Undefined111Controller Controller = 111
+
+	// Undefined112Controller represents undefined controller number 112.
+	Undefined112Controller Controller = 112
+
+	// Undefined113Controller represents undefined controller number 113.
+	Undefined113Controller Controller = 113
+
+	// Undefined114Controller represents undefined controller number 114.
+	Undefined114Controller Controller = 114
+
+	// Undefined115Controller represents undefined controller number 115.
+	Undefined115Controller Controller = 115
+
+	// Undefined116Controller represents undefined controller number 116.
+	Undefined116Controller Controller = 116
+
+	// Undefined117Controller represents undefined controller number 117.
+	Undefined117Controller Controller = 117
+
+	// Undefined118Controller represents undefined controller number 118.
+	Undefined118Controller Controller = 118
+
+	// Undefined119Controller represents undefined controller number 119.
+	Undefined119Controller Controller = 119
+
+	// AllSoundOffController represents the All Sound Off Channel Mode message.
+	AllSoundOffController Controller = 120
+
+	// ResetAllControllersController represents the Reset All Controllers Channel Mode message.
+	ResetAllControllersController Controller = 121
+
+	// LocalControlController represents the Local Control on/off Channel Mode message.
+	LocalControlController Controller = 122
+
+	// AllNotesOffController represents the All Notes Off Channel Mode message.
+	AllNotesOffController Controller = 123
+
+	// OmniModeOffController represents the Omni Mode Off Channel Mode message (also causes All Notes Off).
+	OmniModeOffController Controller = 124
+
+	// OmniModeOnController represents the Omni Mode On Channel Mode message (also causes All Notes Off).
+	OmniModeOnController Controller = 125
+
+	// MonoModeOnController represents the Mono Mode On (Poly Off) Channel Mode message (also causes All Notes Off).
+	MonoModeOnController Controller = 126
+
+	// PolyModeOnController represents the Poly Mode On (Mono Off) Channel Mode message (also causes All Notes Off).
+	PolyModeOnController Controller = 127
+)
+
+// NewController returns a Controller based on the integer argument.
+func NewController(controller int) (Controller, error) {
+	if controller < int(MinController) || controller > int(MaxController) {
+		return MinController, fmt.Errorf("valid controller numbers are between %d and %d, inclusive: %w", MinController, MaxController, ErrInvalidController)
+	}
+	return Controller(controller), nil
+}
+
+// NewControllerFromByte returns a Controller based on the byte argument.
+func NewControllerFromByte(controller byte) (Controller, error) {
+	if controller < byte(MinController) || controller > byte(MaxController) {
+		return MinController, fmt.Errorf("valid controller numbers are between %d and %d, inclusive: %w", MinController, MaxController, ErrInvalidController)
+	}
+	return Controller(controller), nil
+}
+
+// IsChannelMode returns whether the controller number is reserved for Channel Mode messages (120 through 127).
+func (c Controller) IsChannelMode() bool {
+	return c >= AllSoundOffController && c <= PolyModeOnController
+}
+
+// IsHighResolutionMSB returns whether the controller number carries the MSB of a 14-bit controller pair (0 through 31).
+func (c Controller) IsHighResolutionMSB() bool {
+	return c >= MinController && c <= MaxHighResolutionController
+}
+
+// IsHighResolutionLSB returns whether the controller number carries the LSB of a 14-bit controller pair (32 through 63).
+func (c Controller) IsHighResolutionLSB() bool {
+	return c > MaxHighResolutionController && c <= MaxHighResolutionController+HighResolutionLSBOffset
+}
+
+// LSBController returns the controller number that carries the LSB of the 14-bit controller pair, or an error if the
+// controller number does not carry the MSB of a pair.
+func (c Controller) LSBController() (Controller, error) {
+	if !c.IsHighResolutionMSB() {
+		return MinController, fmt.Errorf("only controller numbers between %d and %d, inclusive, have an LSB controller, received %d: %w", MinController, MaxHighResolutionController, c, ErrInvalidController)
+	}
+	return c + HighResolutionLSBOffset, nil
+}
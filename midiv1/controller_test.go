@@ -0,0 +1,173 @@
+package midiv1
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_NewController(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		controllerInt      int
+		expectedController Controller
+		err                error
+	}{
+		"controller number too low": {
+			controllerInt:      -1,
+			expectedController: MinController,
+			err:                ErrInvalidController,
+		},
+		"controller number too high": {
+			controllerInt:      128,
+			expectedController: MinController,
+			err:                ErrInvalidController,
+		},
+		"controller is intended value": {
+			controllerInt:      7,
+			expectedController: ChannelVolumeMSBController,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewController(test.controllerInt)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil {
+				if err == nil {
+					t.Fatalf("expected non-nil %v error, got nil error", test.err)
+				}
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+			}
+			if got != test.expectedController {
+				t.Fatalf("expected %v, got %v", test.expectedController, got)
+			}
+		})
+	}
+}
+
+func Test_NewControllerFromByte(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		controllerByte     byte
+		expectedController Controller
+		err                error
+	}{
+		"controller number too high": {
+			controllerByte:     128,
+			expectedController: MinController,
+			err:                ErrInvalidController,
+		},
+		"controller is intended value": {
+			controllerByte:     64,
+			expectedController: SustainController,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewControllerFromByte(test.controllerByte)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil {
+				if err == nil {
+					t.Fatalf("expected non-nil %v error, got nil error", test.err)
+				}
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+			}
+			if got != test.expectedController {
+				t.Fatalf("expected %v, got %v", test.expectedController, got)
+			}
+		})
+	}
+}
+
+func Test_Controller_IsChannelMode(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		controller Controller
+		expected   bool
+	}{
+		"undefined controller 119 is not a channel mode controller": {
+			controller: Undefined119Controller,
+			expected:   false,
+		},
+		"all sound off is a channel mode controller": {
+			controller: AllSoundOffController,
+			expected:   true,
+		},
+		"poly mode on is a channel mode controller": {
+			controller: PolyModeOnController,
+			expected:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := test.controller.IsChannelMode()
+			if got != test.expected {
+				t.Fatalf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_Controller_IsHighResolution(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		controller  Controller
+		expectedMSB bool
+		expectedLSB bool
+	}{
+		"bank select is an MSB controller": {
+			controller:  BankSelectMSBController,
+			expectedMSB: true,
+		},
+		"controller 31 is an MSB controller": {
+			controller:  31,
+			expectedMSB: true,
+		},
+		"channel volume LSB is an LSB controller": {
+			controller:  ChannelVolumeLSBController,
+			expectedLSB: true,
+		},
+		"controller 63 is an LSB controller": {
+			controller:  63,
+			expectedLSB: true,
+		},
+		"sustain is neither": {
+			controller: 64,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := test.controller.IsHighResolutionMSB(); got != test.expectedMSB {
+				t.Fatalf("expected MSB %v, got %v", test.expectedMSB, got)
+			}
+			if got := test.controller.IsHighResolutionLSB(); got != test.expectedLSB {
+				t.Fatalf("expected LSB %v, got %v", test.expectedLSB, got)
+			}
+		})
+	}
+}
+
+func Test_Controller_LSBController(t *testing.T) {
+	t.Parallel()
+	got, err := ChannelVolumeMSBController.LSBController()
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if got != ChannelVolumeLSBController {
+		t.Fatalf("expected %d, got %d", ChannelVolumeLSBController, got)
+	}
+	if _, err := ChannelVolumeLSBController.LSBController(); !errors.Is(err, ErrInvalidController) {
+		t.Fatalf("expected error %v, got %v", ErrInvalidController, err)
+	}
+}
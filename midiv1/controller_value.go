@@ -0,0 +1,50 @@
+package midiv1
+
+// ControllerValue represents the value of an individual MIDI controller. Valid values are between 0 and 127 inclusive.
+//
+// ControllerValue is only used in conjunction with Control Change Channel Voice messages.
+type ControllerValue int
+
+const (
+	// MinControllerValue represents the lowest possible value for a MIDI controller.
+	MinControllerValue ControllerValue = 0
+
+	// CenterControllerValue represents the center value for a MIDI controller (e.g. a centered Pan or Balance).
+	CenterControllerValue ControllerValue = 64
+
+	// MaxControllerValue represents the highest possible value for a MIDI controller.
+	MaxControllerValue ControllerValue = 127
+
+	// SwitchOffControllerValue represents the canonical "off" value for a switch controller (values 0-63 are off).
+	SwitchOffControllerValue ControllerValue = 0
+
+	// SwitchOnControllerValue represents the canonical "on" value for a switch controller (values 64-127 are on).
+	SwitchOnControllerValue ControllerValue = 127
+)
+
+// NewControllerValue returns a ControllerValue based on the integer argument, clamped within the overall minimum and maximum values.
+func NewControllerValue(value int) ControllerValue {
+	if value < int(MinControllerValue) {
+		return MinControllerValue
+	}
+	if value > int(MaxControllerValue) {
+		return MaxControllerValue
+	}
+	return ControllerValue(value)
+}
+
+// NewControllerValueFromByte returns a ControllerValue based on the byte argument, clamped within the overall minimum and maximum values.
+func NewControllerValueFromByte(value byte) ControllerValue {
+	if value < byte(MinControllerValue) {
+		return MinControllerValue
+	}
+	if value > byte(MaxControllerValue) {
+		return MaxControllerValue
+	}
+	return ControllerValue(value)
+}
+
+// IsOn returns whether the controller value represents "on" when used with a switch controller such as Sustain.
+func (cv ControllerValue) IsOn() bool {
+	return cv >= CenterControllerValue
+}
@@ -0,0 +1,89 @@
+package midiv1
+
+import "testing"
+
+func Test_NewControllerValue(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		valueInt      int
+		expectedValue ControllerValue
+	}{
+		"controller value clamps to minimum value": {
+			valueInt:      -1,
+			expectedValue: MinControllerValue,
+		},
+		"controller value clamps to maximum value": {
+			valueInt:      128,
+			expectedValue: MaxControllerValue,
+		},
+		"controller value is intended integer": {
+			valueInt:      53,
+			expectedValue: 53,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := NewControllerValue(test.valueInt)
+			if got != test.expectedValue {
+				t.Fatalf("expected %v, got %v", test.expectedValue, got)
+			}
+		})
+	}
+}
+
+func Test_NewControllerValueFromByte(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		valueByte     byte
+		expectedValue ControllerValue
+	}{
+		"controller value clamps to maximum value": {
+			valueByte:     128,
+			expectedValue: MaxControllerValue,
+		},
+		"controller value is intended byte": {
+			valueByte:     53,
+			expectedValue: 53,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := NewControllerValueFromByte(test.valueByte)
+			if got != test.expectedValue {
+				t.Fatalf("expected %v, got %v", test.expectedValue, got)
+			}
+		})
+	}
+}
+
+func Test_ControllerValue_IsOn(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		value    ControllerValue
+		expected bool
+	}{
+		"value 63 is off": {
+			value:    63,
+			expected: false,
+		},
+		"value 64 is on": {
+			value:    64,
+			expected: true,
+		},
+		"switch on value is on": {
+			value:    SwitchOnControllerValue,
+			expected: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := test.value.IsOn()
+			if got != test.expected {
+				t.Fatalf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
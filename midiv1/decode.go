@@ -1,10 +1,16 @@
 package midiv1
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	// ErrUnmarshallingMessage represents an error unmarshalling a MIDI message.
 	ErrUnmarshallingMessage error = errors.New("error unmarshalling MIDI message")
+
+	// ErrUnsupportedMessage represents a status byte that does not map to a supported MIDI message type.
+	ErrUnsupportedMessage error = fmt.Errorf("unsupported MIDI message: %w", ErrUnmarshallingMessage)
 )
 
 // MessageUnmarshaler represents MIDI message data that can be unmarshalled.
@@ -18,3 +24,242 @@ type RunningStatusMessageUnmarshaler interface {
 	// UnmarshalRunningStatusMIDI unmarshalls raw bytes into a running status MIDI message.
 	UnmarshalRunningStatusMIDI(b []byte) error
 }
+
+// unmarshalerMessage represents a MIDI message that can unmarshal itself.
+type unmarshalerMessage interface {
+	Message
+	MessageUnmarshaler
+}
+
+// MessageLength returns the number of bytes in a full MIDI message that begins with the supplied status byte.
+func MessageLength(status byte) (int, error) {
+	if !ByteHasStatusMSB(status) {
+		return 0, fmt.Errorf("message length requires a status MSB, received %#x: %w", status, ErrUnmarshallingMessage)
+	}
+
+	switch ParseStatusFromStatusByte(status) {
+	case NoteOffMessageStatusNibble:
+		return NoteOffMessageLength, nil
+	case NoteOnMessageStatusNibble:
+		return NoteOnMessageLength, nil
+	case PolyphonicKeyPressureMessageStatusNibble:
+		return PolyphonicKeyPressureMessageLength, nil
+	case ControlChangeMessageStatusNibble:
+		return ControlChangeMessageLength, nil
+	case ProgramChangeMessageStatusNibble:
+		return ProgramChangeMessageLength, nil
+	case ChannelPressureMessageStatusNibble:
+		return ChannelPressureMessageLength, nil
+	case PitchBendChangeMessageStatusNibble:
+		return PitchBendChangeMessageLength, nil
+	}
+
+	// System messages are identified by the full status byte
+	switch status {
+	case MTCQuarterFrameMessageStatus:
+		return MTCQuarterFrameMessageLength, nil
+	case SongPositionPointerMessageStatus:
+		return SongPositionPointerMessageLength, nil
+	case SongSelectMessageStatus:
+		return SongSelectMessageLength, nil
+	case TuneRequestMessageStatus:
+		return TuneRequestMessageLength, nil
+	case 0xF4, 0xF5:
+		// undefined System Common messages are a single status byte
+		return 1, nil
+	}
+	if IsRealTimeStatus(status) {
+		return 1, nil
+	}
+	return 0, fmt.Errorf("no fixed message length for status byte %#x: %w", status, ErrUnsupportedMessage)
+}
+
+// Unmarshal unmarshalls raw bytes into the MIDI message type identified by the high nibble of the status byte.
+// Control Change messages using controller numbers 120 through 127 are unmarshalled into their Channel Mode message types,
+// and System messages are identified by their full status byte. System Exclusive messages (0xF0 through 0xF7) are
+// unmarshalled into a *SystemExclusiveMessage unless they are a supported Universal System Exclusive message, such as an
+// *IdentityRequestMessage, in which case any error decoding that message (such as ErrInvalidChecksum) is returned.
+//
+// Example: []byte{0b10010001, 0b01000000, 0b00100000}
+//
+// The example returns a *NoteOnMessage for channel 2 (index 1), note number 64, velocity value 32.
+func Unmarshal(b []byte) (Message, error) {
+	// make sure there is a status byte with the proper MSB
+	if len(b) == 0 {
+		return nil, fmt.Errorf("messages must contain at least a status byte: %w", ErrUnmarshallingMessage)
+	}
+	if !ByteHasStatusMSB(b[0]) {
+		return nil, fmt.Errorf("messages must have a status MSB: %w", ErrUnmarshallingMessage)
+	}
+
+	// System Exclusive messages have no fixed length and run until their EOX byte
+	if b[0] == SystemExclusiveMessageStatus {
+		return unmarshalSystemExclusiveMessage(b)
+	}
+
+	// check the number of bytes in the message before choosing a type
+	length, err := MessageLength(b[0])
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != length {
+		return nil, fmt.Errorf("messages with status byte %#x are made up of %d bytes, received %d byte(s): %w", b[0], length, len(b), ErrUnmarshallingMessage)
+	}
+
+	var message unmarshalerMessage
+	if ParseStatusFromStatusByte(b[0]) == SystemMessageStatusNibble {
+		message, err = newSystemMessage(b[0])
+	} else {
+		message, err = newChannelMessage(b[0], b[1])
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := message.UnmarshalMIDI(b); err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+// UnmarshalRunningStatus unmarshalls running status bytes into the MIDI message type identified by the high nibble of
+// the supplied status byte, using the UnmarshalRunningStatusMIDI method of that type. The channel nibble of the status
+// byte is filled in on the returned message.
+//
+// Example: UnmarshalRunningStatus(0b10010001, []byte{0b01000000, 0b00100000})
+//
+// The example returns a *NoteOnMessage for channel 2 (index 1), note number 64, velocity value 32.
+func UnmarshalRunningStatus(status byte, b []byte) (Message, error) {
+	// make sure the remembered status byte is a Channel Voice or Channel Mode status byte
+	if !ByteHasStatusMSB(status) || ParseStatusFromStatusByte(status) == SystemMessageStatusNibble {
+		return nil, fmt.Errorf("running status requires a channel status byte, received %#x: %w", status, ErrUnmarshallingMessage)
+	}
+	if len(b) == 0 {
+		return nil, fmt.Errorf("running status messages must contain at least one data byte: %w", ErrUnmarshallingMessage)
+	}
+
+	channel, err := ParseChannelFromStatusByte(status)
+	if err != nil {
+		return nil, err
+	}
+	message, err := newChannelMessage(status, b[0])
+	if err != nil {
+		return nil, err
+	}
+	runningStatusMessage, ok := message.(RunningStatusMessageUnmarshaler)
+	if !ok {
+		return nil, fmt.Errorf("%s messages do not support running status: %w", message.GetMessageName(), ErrUnsupportedMessage)
+	}
+	if err := runningStatusMessage.UnmarshalRunningStatusMIDI(b); err != nil {
+		return nil, err
+	}
+	setMessageChannel(message, channel)
+	return message, nil
+}
+
+// newChannelMessage returns an empty Channel Voice or Channel Mode message for the status byte. The first data byte is
+// used to tell Channel Mode messages apart from other Control Change messages.
+func newChannelMessage(status byte, data byte) (unmarshalerMessage, error) {
+	switch ParseStatusFromStatusByte(status) {
+	case NoteOffMessageStatusNibble:
+		return &NoteOffMessage{}, nil
+	case NoteOnMessageStatusNibble:
+		return &NoteOnMessage{}, nil
+	case PolyphonicKeyPressureMessageStatusNibble:
+		return &PolyphonicKeyPressureMessage{}, nil
+	case ControlChangeMessageStatusNibble:
+		return newControlChangeMessage(Controller(data)), nil
+	case ProgramChangeMessageStatusNibble:
+		return &ProgramChangeMessage{}, nil
+	case ChannelPressureMessageStatusNibble:
+		return &ChannelPressureMessage{}, nil
+	case PitchBendChangeMessageStatusNibble:
+		return &PitchBendChangeMessage{}, nil
+	}
+	return nil, fmt.Errorf("no channel message for status byte %#x: %w", status, ErrUnsupportedMessage)
+}
+
+// newSystemMessage returns an empty System Common or System Real-Time message for the status byte.
+func newSystemMessage(status byte) (unmarshalerMessage, error) {
+	switch status {
+	case MTCQuarterFrameMessageStatus:
+		return &MTCQuarterFrameMessage{}, nil
+	case SongPositionPointerMessageStatus:
+		return &SongPositionPointerMessage{}, nil
+	case SongSelectMessageStatus:
+		return &SongSelectMessage{}, nil
+	case TuneRequestMessageStatus:
+		return &TuneRequestMessage{}, nil
+	case TimingClockMessageStatus:
+		return &TimingClockMessage{}, nil
+	case StartMessageStatus:
+		return &StartMessage{}, nil
+	case ContinueMessageStatus:
+		return &ContinueMessage{}, nil
+	case StopMessageStatus:
+		return &StopMessage{}, nil
+	case ActiveSensingMessageStatus:
+		return &ActiveSensingMessage{}, nil
+	case SystemResetMessageStatus:
+		return &SystemResetMessage{}, nil
+	}
+	return nil, fmt.Errorf("no system message for status byte %#x: %w", status, ErrUnsupportedMessage)
+}
+
+// newControlChangeMessage returns an empty Control Change or Channel Mode message for the controller number.
+func newControlChangeMessage(controller Controller) unmarshalerMessage {
+	switch controller {
+	case AllSoundOffController:
+		return &AllSoundOffMessage{}
+	case ResetAllControllersController:
+		return &ResetAllControllersMessage{}
+	case LocalControlController:
+		return &LocalControlMessage{}
+	case AllNotesOffController:
+		return &AllNotesOffMessage{}
+	case OmniModeOffController:
+		return &OmniModeOffMessage{}
+	case OmniModeOnController:
+		return &OmniModeOnMessage{}
+	case MonoModeOnController:
+		return &MonoModeOnMessage{}
+	case PolyModeOnController:
+		return &PolyModeOnMessage{}
+	}
+	return &ControlChangeMessage{}
+}
+
+// setMessageChannel fills in the channel of a message that was unmarshalled from running status bytes.
+func setMessageChannel(message Message, channel Channel) {
+	switch m := message.(type) {
+	case *NoteOffMessage:
+		m.Channel = channel
+	case *NoteOnMessage:
+		m.Channel = channel
+	case *PolyphonicKeyPressureMessage:
+		m.Channel = channel
+	case *ControlChangeMessage:
+		m.Channel = channel
+	case *ProgramChangeMessage:
+		m.Channel = channel
+	case *ChannelPressureMessage:
+		m.Channel = channel
+	case *PitchBendChangeMessage:
+		m.Channel = channel
+	case *AllSoundOffMessage:
+		m.Channel = channel
+	case *ResetAllControllersMessage:
+		m.Channel = channel
+	case *LocalControlMessage:
+		m.Channel = channel
+	case *AllNotesOffMessage:
+		m.Channel = channel
+	case *OmniModeOffMessage:
+		m.Channel = channel
+	case *OmniModeOnMessage:
+		m.Channel = channel
+	case *MonoModeOnMessage:
+		m.Channel = channel
+	case *PolyModeOnMessage:
+		m.Channel = channel
+	}
+}
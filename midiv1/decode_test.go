@@ -0,0 +1,277 @@
+package midiv1
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_MessageLength(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		status         byte
+		expectedLength int
+		err            error
+	}{
+		"byte does not have a status MSB": {
+			status: 0b01000000,
+			err:    ErrUnmarshallingMessage,
+		},
+		"note-on status byte": {
+			status:         0b10010001,
+			expectedLength: NoteOnMessageLength,
+		},
+		"program change status byte": {
+			status:         0b11000001,
+			expectedLength: ProgramChangeMessageLength,
+		},
+		"system real-time status byte": {
+			status:         0b11111000,
+			expectedLength: 1,
+		},
+		"system exclusive status byte has no fixed length": {
+			status: 0b11110000,
+			err:    ErrUnsupportedMessage,
+		},
+		"control change status byte": {
+			status:         0b10110001,
+			expectedLength: ControlChangeMessageLength,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := MessageLength(test.status)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil {
+				if err == nil {
+					t.Fatalf("expected non-nil %v error, got nil error", test.err)
+				}
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+			}
+			if got != test.expectedLength {
+				t.Fatalf("expected %v, got %v", test.expectedLength, got)
+			}
+		})
+	}
+}
+
+func Test_Unmarshal(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage Message
+		err             error
+	}{
+		"byte slice is empty": {
+			b:   []byte{},
+			err: ErrUnmarshallingMessage,
+		},
+		"first byte does not have a status MSB": {
+			b:   []byte{0b00010001, 0b01000000, 0b00100000},
+			err: ErrUnmarshallingMessage,
+		},
+		"byte slice is not proper length": {
+			b:   []byte{0b10010001, 0b01000000},
+			err: ErrUnmarshallingMessage,
+		},
+		"invalid data byte is reported by the message type": {
+			b:   []byte{0b10010001, 0b11000000, 0b00100000},
+			err: ErrUnmarshallingMessage,
+		},
+		"status byte is not a supported message": {
+			b:   []byte{0b11110100},
+			err: ErrUnsupportedMessage,
+		},
+		"bytes unmarshal into note-off message": {
+			b: []byte{0b10000001, 0b01000000, 0b00100000},
+			expectedMessage: &NoteOffMessage{
+				Channel:  1,
+				Note:     64,
+				Velocity: 32,
+			},
+		},
+		"bytes unmarshal into note-on message": {
+			b: []byte{0b10010001, 0b01000000, 0b00100000},
+			expectedMessage: &NoteOnMessage{
+				Channel:  1,
+				Note:     64,
+				Velocity: 32,
+			},
+		},
+		"bytes unmarshal into polyphonic key pressure message": {
+			b: []byte{0b10100001, 0b01000000, 0b00100000},
+			expectedMessage: &PolyphonicKeyPressureMessage{
+				Channel:  1,
+				Note:     64,
+				Pressure: 32,
+			},
+		},
+		"bytes unmarshal into control change message": {
+			b: []byte{0b10110001, 0b00000111, 0b01100100},
+			expectedMessage: &ControlChangeMessage{
+				Channel:    1,
+				Controller: ChannelVolumeMSBController,
+				Value:      100,
+			},
+		},
+		"bytes unmarshal into channel mode message": {
+			b: []byte{0b10110001, 0b01111011, 0b00000000},
+			expectedMessage: &AllNotesOffMessage{
+				Channel: 1,
+			},
+		},
+		"bytes unmarshal into program change message": {
+			b: []byte{0b11000001, 0b01000000},
+			expectedMessage: &ProgramChangeMessage{
+				Channel: 1,
+				Program: 64,
+			},
+		},
+		"bytes unmarshal into channel pressure message": {
+			b: []byte{0b11010001, 0b00100000},
+			expectedMessage: &ChannelPressureMessage{
+				Channel:  1,
+				Pressure: 32,
+			},
+		},
+		"bytes unmarshal into system exclusive message": {
+			b: []byte{0xF0, 0x41, 0x10, 0x42, 0x12, 0xF7},
+			expectedMessage: &SystemExclusiveMessage{
+				Manufacturer: RolandManufacturerID,
+				Data:         []byte{0x10, 0x42, 0x12},
+			},
+		},
+		"system exclusive message without EOX": {
+			b:   []byte{0xF0, 0x41, 0x10, 0x42, 0x12},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into MTC quarter frame message": {
+			b:               []byte{0xF1, 0x32},
+			expectedMessage: &MTCQuarterFrameMessage{Piece: SecondsMSBPiece, Value: 2},
+		},
+		"bytes unmarshal into song position pointer message": {
+			b:               []byte{0xF2, 0x00, 0x01},
+			expectedMessage: &SongPositionPointerMessage{Beats: 128},
+		},
+		"bytes unmarshal into song select message": {
+			b:               []byte{0xF3, 0x05},
+			expectedMessage: &SongSelectMessage{Song: 5},
+		},
+		"bytes unmarshal into tune request message": {
+			b:               []byte{0xF6},
+			expectedMessage: &TuneRequestMessage{},
+		},
+		"bytes unmarshal into timing clock message": {
+			b:               []byte{0xF8},
+			expectedMessage: &TimingClockMessage{},
+		},
+		"bytes unmarshal into system reset message": {
+			b:               []byte{0xFF},
+			expectedMessage: &SystemResetMessage{},
+		},
+		"undefined real-time status byte is not a supported message": {
+			b:   []byte{0xF9},
+			err: ErrUnsupportedMessage,
+		},
+		"bytes unmarshal into pitch bend change message": {
+			b: []byte{0b11100001, 0b00110101, 0b01000000},
+			expectedMessage: &PitchBendChangeMessage{
+				Channel:   1,
+				PitchBend: 53,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := Unmarshal(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil {
+				if err == nil {
+					t.Fatalf("expected non-nil %v error, got nil error", test.err)
+				}
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
+
+func Test_UnmarshalRunningStatus(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		status          byte
+		b               []byte
+		expectedMessage Message
+		err             error
+	}{
+		"status byte does not have a status MSB": {
+			status: 0b00010001,
+			b:      []byte{0b01000000, 0b00100000},
+			err:    ErrUnmarshallingMessage,
+		},
+		"status byte is a system status byte": {
+			status: 0b11110010,
+			b:      []byte{0b01000000, 0b00100000},
+			err:    ErrUnmarshallingMessage,
+		},
+		"byte slice is empty": {
+			status: 0b10010001,
+			b:      []byte{},
+			err:    ErrUnmarshallingMessage,
+		},
+		"byte slice is not proper length": {
+			status: 0b10010001,
+			b:      []byte{0b01000000},
+			err:    ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into note-on message with channel": {
+			status: 0b10010101,
+			b:      []byte{0b01000000, 0b00100000},
+			expectedMessage: &NoteOnMessage{
+				Channel:  5,
+				Note:     64,
+				Velocity: 32,
+			},
+		},
+		"bytes unmarshal into channel mode message with channel": {
+			status: 0b10110101,
+			b:      []byte{0b01111010, 0b01111111},
+			expectedMessage: &LocalControlMessage{
+				Channel: 5,
+				On:      true,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := UnmarshalRunningStatus(test.status, test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil {
+				if err == nil {
+					t.Fatalf("expected non-nil %v error, got nil error", test.err)
+				}
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
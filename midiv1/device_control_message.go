@@ -0,0 +1,235 @@
+package midiv1
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// DeviceControlMessageStringFormat represents the printf-compatible format specifically for a Device Control message string.
+	DeviceControlMessageStringFormat string = "%s:%s:%d:%d"
+
+	// MinMasterCoarseTuningSemitones represents the lowest Master Coarse Tuning offset in semitones.
+	MinMasterCoarseTuningSemitones int = -64
+
+	// MaxMasterCoarseTuningSemitones represents the highest Master Coarse Tuning offset in semitones.
+	MaxMasterCoarseTuningSemitones int = 63
+
+	// masterFineTuningCentsRange represents the number of cents between the center and the lowest Master Fine Tuning value.
+	masterFineTuningCentsRange float64 = 100
+)
+
+// MasterVolumeMessage represents a Master Volume Universal Real-Time System Exclusive message, which sets the overall
+// volume of a device.
+type MasterVolumeMessage struct {
+	// DeviceID represents the device that should change, or AllCallDeviceID for every device.
+	DeviceID DeviceID
+
+	// Volume represents the master volume, where 0 is silent and MaxFourteenBitValue is the loudest.
+	Volume FourteenBitValue
+}
+
+// GetMessageName returns the name of this Master Volume message.
+func (mvm *MasterVolumeMessage) GetMessageName() string {
+	return "Master Volume"
+}
+
+// MarshalMIDI marshalls a MasterVolumeMessage MIDI message into its raw bytes
+func (mvm MasterVolumeMessage) MarshalMIDI() ([]byte, error) {
+	return marshalDeviceControlMessage(MasterVolumeSubID, mvm.DeviceID, mvm.Volume)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (mvm *MasterVolumeMessage) String() string {
+	return fmt.Sprintf(DeviceControlMessageStringFormat, MessageVersion, mvm.GetMessageName(), mvm.DeviceID, mvm.Volume)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a MasterVolumeMessage struct pointer. Master Volume messages are represented
+// by eight bytes (left to right): 0xF0, 0x7F, device ID, 0x04, 0x01, volume LSB, volume MSB, 0xF7.
+//
+// Example: []byte{0xF0, 0x7F, 0x7F, 0x04, 0x01, 0x00, 0x40, 0xF7}
+//
+// The example forms a Master Volume message for every device at volume 8192 (LSB: 00, MSB: 40).
+func (mvm *MasterVolumeMessage) UnmarshalMIDI(b []byte) error {
+	deviceID, value, err := unmarshalDeviceControlMessage("master volume", MasterVolumeSubID, b)
+	if err != nil {
+		return err
+	}
+
+	*mvm = MasterVolumeMessage{
+		DeviceID: deviceID,
+		Volume:   value,
+	}
+	return nil
+}
+
+// MasterBalanceMessage represents a Master Balance Universal Real-Time System Exclusive message, which sets the overall
+// left/right balance of a device.
+type MasterBalanceMessage struct {
+	// DeviceID represents the device that should change, or AllCallDeviceID for every device.
+	DeviceID DeviceID
+
+	// Balance represents the master balance, where 0 is hard left, CenterFourteenBitValue is the center and
+	// MaxFourteenBitValue is hard right.
+	Balance FourteenBitValue
+}
+
+// GetMessageName returns the name of this Master Balance message.
+func (mbm *MasterBalanceMessage) GetMessageName() string {
+	return "Master Balance"
+}
+
+// MarshalMIDI marshalls a MasterBalanceMessage MIDI message into its raw bytes
+func (mbm MasterBalanceMessage) MarshalMIDI() ([]byte, error) {
+	return marshalDeviceControlMessage(MasterBalanceSubID, mbm.DeviceID, mbm.Balance)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (mbm *MasterBalanceMessage) String() string {
+	return fmt.Sprintf(DeviceControlMessageStringFormat, MessageVersion, mbm.GetMessageName(), mbm.DeviceID, mbm.Balance)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a MasterBalanceMessage struct pointer. Master Balance messages are
+// represented by eight bytes (left to right): 0xF0, 0x7F, device ID, 0x04, 0x02, balance LSB, balance MSB, 0xF7.
+//
+// Example: []byte{0xF0, 0x7F, 0x7F, 0x04, 0x02, 0x00, 0x40, 0xF7}
+//
+// The example forms a Master Balance message for every device, centered.
+func (mbm *MasterBalanceMessage) UnmarshalMIDI(b []byte) error {
+	deviceID, value, err := unmarshalDeviceControlMessage("master balance", MasterBalanceSubID, b)
+	if err != nil {
+		return err
+	}
+
+	*mbm = MasterBalanceMessage{
+		DeviceID: deviceID,
+		Balance:  value,
+	}
+	return nil
+}
+
+// MasterFineTuningMessage represents a Master Fine Tuning Universal Real-Time System Exclusive message, which detunes a
+// device by up to a semitone in either direction.
+type MasterFineTuningMessage struct {
+	// DeviceID represents the device that should change, or AllCallDeviceID for every device.
+	DeviceID DeviceID
+
+	// Tuning represents the fine tuning, where 0 is 100 cents flat, CenterFourteenBitValue is A440 and
+	// MaxFourteenBitValue is just under 100 cents sharp.
+	Tuning FourteenBitValue
+}
+
+// NewMasterFineTuningMessageFromCents returns a MasterFineTuningMessage for the supplied device, detuned by the supplied
+// number of cents. Offsets beyond the range of the message are clamped.
+func NewMasterFineTuningMessageFromCents(deviceID DeviceID, cents float64) MasterFineTuningMessage {
+	offset := math.Round(cents * float64(CenterFourteenBitValue) / masterFineTuningCentsRange)
+	return MasterFineTuningMessage{
+		DeviceID: deviceID,
+		Tuning:   NewFourteenBitValue(int(CenterFourteenBitValue) + int(math.Max(math.Min(offset, float64(CenterFourteenBitValue)), -float64(CenterFourteenBitValue)))),
+	}
+}
+
+// Cents returns the fine tuning offset in cents, between -100 and just under 100.
+func (mftm MasterFineTuningMessage) Cents() float64 {
+	return float64(int(mftm.Tuning)-int(CenterFourteenBitValue)) * masterFineTuningCentsRange / float64(CenterFourteenBitValue)
+}
+
+// GetMessageName returns the name of this Master Fine Tuning message.
+func (mftm *MasterFineTuningMessage) GetMessageName() string {
+	return "Master Fine Tuning"
+}
+
+// MarshalMIDI marshalls a MasterFineTuningMessage MIDI message into its raw bytes
+func (mftm MasterFineTuningMessage) MarshalMIDI() ([]byte, error) {
+	return marshalDeviceControlMessage(MasterFineTuningSubID, mftm.DeviceID, mftm.Tuning)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (mftm *MasterFineTuningMessage) String() string {
+	return fmt.Sprintf(DeviceControlMessageStringFormat, MessageVersion, mftm.GetMessageName(), mftm.DeviceID, mftm.Tuning)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a MasterFineTuningMessage struct pointer. Master Fine Tuning messages are
+// represented by eight bytes (left to right): 0xF0, 0x7F, device ID, 0x04, 0x03, tuning LSB, tuning MSB, 0xF7.
+//
+// Example: []byte{0xF0, 0x7F, 0x7F, 0x04, 0x03, 0x00, 0x50, 0xF7}
+//
+// The example forms a Master Fine Tuning message for every device, 25 cents sharp.
+func (mftm *MasterFineTuningMessage) UnmarshalMIDI(b []byte) error {
+	deviceID, value, err := unmarshalDeviceControlMessage("master fine tuning", MasterFineTuningSubID, b)
+	if err != nil {
+		return err
+	}
+
+	*mftm = MasterFineTuningMessage{
+		DeviceID: deviceID,
+		Tuning:   value,
+	}
+	return nil
+}
+
+// MasterCoarseTuningMessage represents a Master Coarse Tuning Universal Real-Time System Exclusive message, which
+// transposes a device by whole semitones.
+type MasterCoarseTuningMessage struct {
+	// DeviceID represents the device that should change, or AllCallDeviceID for every device.
+	DeviceID DeviceID
+
+	// Semitones represents the transposition in semitones, between -64 and 63 inclusive.
+	Semitones int
+}
+
+// GetMessageName returns the name of this Master Coarse Tuning message.
+func (mctm *MasterCoarseTuningMessage) GetMessageName() string {
+	return "Master Coarse Tuning"
+}
+
+// MarshalMIDI marshalls a MasterCoarseTuningMessage MIDI message into its raw bytes. The LSB is always sent as 0.
+func (mctm MasterCoarseTuningMessage) MarshalMIDI() ([]byte, error) {
+	if mctm.Semitones < MinMasterCoarseTuningSemitones || mctm.Semitones > MaxMasterCoarseTuningSemitones {
+		return nil, fmt.Errorf("coarse tuning must be between %d and %d semitones, inclusive, received %d: %w", MinMasterCoarseTuningSemitones, MaxMasterCoarseTuningSemitones, mctm.Semitones, ErrMarshallingMessage)
+	}
+	msb := byte(mctm.Semitones - MinMasterCoarseTuningSemitones)
+	return marshalDeviceControlMessage(MasterCoarseTuningSubID, mctm.DeviceID, NewFourteenBitValueFromBytes(msb, 0))
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (mctm *MasterCoarseTuningMessage) String() string {
+	return fmt.Sprintf(DeviceControlMessageStringFormat, MessageVersion, mctm.GetMessageName(), mctm.DeviceID, mctm.Semitones)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a MasterCoarseTuningMessage struct pointer. Master Coarse Tuning messages are
+// represented by eight bytes (left to right): 0xF0, 0x7F, device ID, 0x04, 0x04, 0x00, semitones + 64, 0xF7. The LSB
+// is ignored.
+//
+// Example: []byte{0xF0, 0x7F, 0x7F, 0x04, 0x04, 0x00, 0x3E, 0xF7}
+//
+// The example forms a Master Coarse Tuning message for every device, two semitones down.
+func (mctm *MasterCoarseTuningMessage) UnmarshalMIDI(b []byte) error {
+	deviceID, value, err := unmarshalDeviceControlMessage("master coarse tuning", MasterCoarseTuningSubID, b)
+	if err != nil {
+		return err
+	}
+
+	*mctm = MasterCoarseTuningMessage{
+		DeviceID:  deviceID,
+		Semitones: int(value.GetMSB()) + MinMasterCoarseTuningSemitones,
+	}
+	return nil
+}
+
+// marshalDeviceControlMessage marshalls a Device Control message with the supplied sub-ID, device ID and value into its raw bytes.
+func marshalDeviceControlMessage(subID byte, deviceID DeviceID, value FourteenBitValue) ([]byte, error) {
+	if value > MaxFourteenBitValue {
+		return nil, fmt.Errorf("device control values must be between %d and %d, inclusive, received %d: %w", MinFourteenBitValue, MaxFourteenBitValue, value, ErrMarshallingMessage)
+	}
+	return marshalUniversalMessage(UniversalRealTimeManufacturerID, deviceID, []byte{DeviceControlSubID, subID}, []byte{value.GetLSB(), value.GetMSB()})
+}
+
+// unmarshalDeviceControlMessage validates the raw bytes of a Device Control message and returns its device ID and value.
+func unmarshalDeviceControlMessage(name string, subID byte, b []byte) (DeviceID, FourteenBitValue, error) {
+	deviceID, data, err := unmarshalUniversalMessage(name, UniversalRealTimeManufacturerID, []byte{DeviceControlSubID, subID}, 2, b)
+	if err != nil {
+		return 0, 0, err
+	}
+	return deviceID, NewFourteenBitValueFromBytes(data[1], data[0]), nil
+}
@@ -0,0 +1,238 @@
+package midiv1
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_MasterVolumeMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  MasterVolumeMessage
+		expected []byte
+		err      error
+	}{
+		"volume is out of range": {
+			message: MasterVolumeMessage{Volume: 0x4000},
+			err:     ErrMarshallingMessage,
+		},
+		"device ID is out of range": {
+			message: MasterVolumeMessage{DeviceID: 0x80},
+			err:     ErrMarshallingMessage,
+		},
+		"message marshalls into expected bytes": {
+			message:  MasterVolumeMessage{DeviceID: AllCallDeviceID, Volume: 0x3FFF},
+			expected: []byte{0xF0, 0x7F, 0x7F, 0x04, 0x01, 0x7F, 0x7F, 0xF7},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_MasterVolumeMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage MasterVolumeMessage
+		err             error
+	}{
+		"volume MSB is missing": {
+			b:   []byte{0xF0, 0x7F, 0x7F, 0x04, 0x01, 0x00, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"sub-ID #2 belongs to another device control message": {
+			b:   []byte{0xF0, 0x7F, 0x7F, 0x04, 0x02, 0x00, 0x40, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b:               []byte{0xF0, 0x7F, 0x01, 0x04, 0x01, 0x01, 0x40, 0xF7},
+			expectedMessage: MasterVolumeMessage{DeviceID: 0x01, Volume: 0x2001},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got MasterVolumeMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
+
+func Test_MasterBalanceMessage_RoundTrip(t *testing.T) {
+	t.Parallel()
+	message := MasterBalanceMessage{DeviceID: 0x10, Balance: 0x1234}
+	b, err := message.MarshalMIDI()
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	expected := []byte{0xF0, 0x7F, 0x10, 0x04, 0x02, 0x34, 0x24, 0xF7}
+	if !bytes.Equal(expected, b) {
+		t.Fatalf("expected %#v, got %#v", expected, b)
+	}
+	var got MasterBalanceMessage
+	if err := (&got).UnmarshalMIDI(b); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if got != message {
+		t.Fatalf("expected %+v, got %+v", message, got)
+	}
+}
+
+func Test_NewMasterFineTuningMessageFromCents(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		cents    float64
+		expected FourteenBitValue
+	}{
+		"A440": {
+			cents:    0,
+			expected: CenterFourteenBitValue,
+		},
+		"25 cents sharp": {
+			cents:    25,
+			expected: 0x2800,
+		},
+		"100 cents flat": {
+			cents:    -100,
+			expected: MinFourteenBitValue,
+		},
+		"sharper than the range is clamped": {
+			cents:    150,
+			expected: MaxFourteenBitValue,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := NewMasterFineTuningMessageFromCents(AllCallDeviceID, test.cents)
+			if got.Tuning != test.expected {
+				t.Fatalf("expected %#x, got %#x", test.expected, got.Tuning)
+			}
+		})
+	}
+}
+
+func Test_MasterFineTuningMessage_Cents(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		tuning   FourteenBitValue
+		expected float64
+	}{
+		"A440": {
+			tuning:   CenterFourteenBitValue,
+			expected: 0,
+		},
+		"50 cents flat": {
+			tuning:   0x1000,
+			expected: -50,
+		},
+		"100 cents flat": {
+			tuning:   MinFourteenBitValue,
+			expected: -100,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := MasterFineTuningMessage{Tuning: test.tuning}.Cents()
+			if got != test.expected {
+				t.Fatalf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_MasterCoarseTuningMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  MasterCoarseTuningMessage
+		expected []byte
+		err      error
+	}{
+		"semitones are below range": {
+			message: MasterCoarseTuningMessage{Semitones: -65},
+			err:     ErrMarshallingMessage,
+		},
+		"semitones are above range": {
+			message: MasterCoarseTuningMessage{Semitones: 64},
+			err:     ErrMarshallingMessage,
+		},
+		"message marshalls into expected bytes": {
+			message:  MasterCoarseTuningMessage{DeviceID: AllCallDeviceID, Semitones: -2},
+			expected: []byte{0xF0, 0x7F, 0x7F, 0x04, 0x04, 0x00, 0x3E, 0xF7},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_MasterCoarseTuningMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage MasterCoarseTuningMessage
+		err             error
+	}{
+		"sub-ID #2 belongs to another device control message": {
+			b:   []byte{0xF0, 0x7F, 0x7F, 0x04, 0x03, 0x00, 0x40, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"LSB is ignored": {
+			b:               []byte{0xF0, 0x7F, 0x7F, 0x04, 0x04, 0x12, 0x4C, 0xF7},
+			expectedMessage: MasterCoarseTuningMessage{DeviceID: AllCallDeviceID, Semitones: 12},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got MasterCoarseTuningMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
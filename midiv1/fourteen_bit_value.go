@@ -0,0 +1,80 @@
+package midiv1
+
+import "math"
+
+const (
+	// MinFourteenBitValue represents the lowest value that can be sent as two 7-bit data bytes.
+	MinFourteenBitValue FourteenBitValue = 0
+
+	// CenterFourteenBitValue represents the center of the 14-bit range, used as the neutral value of bipolar parameters
+	// such as balance and fine tuning.
+	CenterFourteenBitValue FourteenBitValue = 0x2000
+
+	// MaxFourteenBitValue represents the highest value that can be sent as two 7-bit data bytes.
+	MaxFourteenBitValue FourteenBitValue = 0x3FFF
+)
+
+// FourteenBitValue represents a value that is sent as two 7-bit data bytes: an MSB holding the upper seven bits and an
+// LSB holding the lower seven bits. Valid values are between 0 and 16383 inclusive.
+type FourteenBitValue uint16
+
+// NewFourteenBitValue returns a FourteenBitValue from an integer value, clamped to the 14-bit range.
+func NewFourteenBitValue(value int) FourteenBitValue {
+	if value < int(MinFourteenBitValue) {
+		return MinFourteenBitValue
+	}
+	if value > int(MaxFourteenBitValue) {
+		return MaxFourteenBitValue
+	}
+	return FourteenBitValue(value)
+}
+
+// NewFourteenBitValueFromBytes returns a FourteenBitValue from a most-significant and a least-significant 7-bit data
+// byte. The MSB of each byte is ignored.
+func NewFourteenBitValueFromBytes(msb byte, lsb byte) FourteenBitValue {
+	return FourteenBitValue(uint16(msb&0x7F)<<7 | uint16(lsb&0x7F))
+}
+
+// NewFourteenBitValueFromFloat returns a FourteenBitValue from a unipolar float between 0 and 1 inclusive, where 0 is the
+// lowest value and 1 is the highest. Values outside of the range are clamped.
+func NewFourteenBitValueFromFloat(f float64) FourteenBitValue {
+	if math.IsNaN(f) {
+		return MinFourteenBitValue
+	}
+	return NewFourteenBitValue(int(math.Round(f * float64(MaxFourteenBitValue))))
+}
+
+// NewFourteenBitValueFromSignedFloat returns a FourteenBitValue from a bipolar float between -1 and 1 inclusive, where
+// -1 is the lowest value, 0 is the center and 1 is the highest. Values outside of the range are clamped.
+func NewFourteenBitValueFromSignedFloat(f float64) FourteenBitValue {
+	if math.IsNaN(f) {
+		return CenterFourteenBitValue
+	}
+	if f < 0 {
+		return NewFourteenBitValue(int(CenterFourteenBitValue) + int(math.Round(f*float64(CenterFourteenBitValue))))
+	}
+	return NewFourteenBitValue(int(CenterFourteenBitValue) + int(math.Round(f*float64(MaxFourteenBitValue-CenterFourteenBitValue))))
+}
+
+// GetLSB returns the least-significant 7-bit data byte of the value.
+func (v FourteenBitValue) GetLSB() byte {
+	return byte(v & 0x7F)
+}
+
+// GetMSB returns the most-significant 7-bit data byte of the value.
+func (v FourteenBitValue) GetMSB() byte {
+	return byte(v>>7) & 0x7F
+}
+
+// Float returns the value as a unipolar float between 0 and 1 inclusive.
+func (v FourteenBitValue) Float() float64 {
+	return float64(v) / float64(MaxFourteenBitValue)
+}
+
+// SignedFloat returns the value as a bipolar float between -1 and 1 inclusive, where the center value is 0.
+func (v FourteenBitValue) SignedFloat() float64 {
+	if v < CenterFourteenBitValue {
+		return float64(int(v)-int(CenterFourteenBitValue)) / float64(CenterFourteenBitValue)
+	}
+	return float64(v-CenterFourteenBitValue) / float64(MaxFourteenBitValue-CenterFourteenBitValue)
+}
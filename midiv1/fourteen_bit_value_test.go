@@ -0,0 +1,146 @@
+package midiv1
+
+import "testing"
+
+func Test_NewFourteenBitValue(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		value    int
+		expected FourteenBitValue
+	}{
+		"value below range is clamped": {
+			value:    -1,
+			expected: MinFourteenBitValue,
+		},
+		"value above range is clamped": {
+			value:    0x4000,
+			expected: MaxFourteenBitValue,
+		},
+		"value within range": {
+			value:    1000,
+			expected: 1000,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := NewFourteenBitValue(test.value); got != test.expected {
+				t.Fatalf("expected %d, got %d", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_FourteenBitValue_Bytes(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		value       FourteenBitValue
+		expectedMSB byte
+		expectedLSB byte
+	}{
+		"minimum value": {
+			value:       MinFourteenBitValue,
+			expectedMSB: 0x00,
+			expectedLSB: 0x00,
+		},
+		"center value": {
+			value:       CenterFourteenBitValue,
+			expectedMSB: 0x40,
+			expectedLSB: 0x00,
+		},
+		"maximum value": {
+			value:       MaxFourteenBitValue,
+			expectedMSB: 0x7F,
+			expectedLSB: 0x7F,
+		},
+		"value uses both bytes": {
+			value:       0x12C,
+			expectedMSB: 0x02,
+			expectedLSB: 0x2C,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := test.value.GetMSB(); got != test.expectedMSB {
+				t.Fatalf("expected MSB %#x, got %#x", test.expectedMSB, got)
+			}
+			if got := test.value.GetLSB(); got != test.expectedLSB {
+				t.Fatalf("expected LSB %#x, got %#x", test.expectedLSB, got)
+			}
+			if got := NewFourteenBitValueFromBytes(test.expectedMSB, test.expectedLSB); got != test.value {
+				t.Fatalf("expected %d, got %d", test.value, got)
+			}
+		})
+	}
+}
+
+func Test_FourteenBitValue_Float(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		f        float64
+		expected FourteenBitValue
+	}{
+		"zero": {
+			f:        0,
+			expected: MinFourteenBitValue,
+		},
+		"one": {
+			f:        1,
+			expected: MaxFourteenBitValue,
+		},
+		"above range is clamped": {
+			f:        2,
+			expected: MaxFourteenBitValue,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := NewFourteenBitValueFromFloat(test.f)
+			if got != test.expected {
+				t.Fatalf("expected %d, got %d", test.expected, got)
+			}
+			if test.f >= 0 && test.f <= 1 && got.Float() != test.f {
+				t.Fatalf("expected float %v, got %v", test.f, got.Float())
+			}
+		})
+	}
+}
+
+func Test_FourteenBitValue_SignedFloat(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		f        float64
+		expected FourteenBitValue
+	}{
+		"negative one": {
+			f:        -1,
+			expected: MinFourteenBitValue,
+		},
+		"zero": {
+			f:        0,
+			expected: CenterFourteenBitValue,
+		},
+		"one": {
+			f:        1,
+			expected: MaxFourteenBitValue,
+		},
+		"half": {
+			f:        -0.5,
+			expected: 0x1000,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := NewFourteenBitValueFromSignedFloat(test.f)
+			if got != test.expected {
+				t.Fatalf("expected %d, got %d", test.expected, got)
+			}
+			if got.SignedFloat() != test.f {
+				t.Fatalf("expected signed float %v, got %v", test.f, got.SignedFloat())
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package midiv1
+
+import "fmt"
+
+// GeneralMIDISystemOnMessage represents a General MIDI System On Universal Non-Real-Time System Exclusive message,
+// which switches a device into General MIDI mode and resets it to the General MIDI defaults.
+type GeneralMIDISystemOnMessage struct {
+	// DeviceID represents the device that should switch modes, or AllCallDeviceID for every device.
+	DeviceID DeviceID
+}
+
+// GetMessageName returns the name of this General MIDI System On message.
+func (gmsom *GeneralMIDISystemOnMessage) GetMessageName() string {
+	return "General MIDI System On"
+}
+
+// MarshalMIDI marshalls a GeneralMIDISystemOnMessage MIDI message into its raw bytes
+func (gmsom GeneralMIDISystemOnMessage) MarshalMIDI() ([]byte, error) {
+	return marshalUniversalMessage(UniversalNonRealTimeManufacturerID, gmsom.DeviceID, []byte{GeneralMIDISubID, GeneralMIDISystemOnSubID}, nil)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (gmsom *GeneralMIDISystemOnMessage) String() string {
+	return fmt.Sprintf(UniversalMessageStringFormat, MessageVersion, gmsom.GetMessageName(), gmsom.DeviceID)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a GeneralMIDISystemOnMessage struct pointer. General MIDI System On
+// messages are represented by six bytes (left to right): 0xF0, 0x7E, device ID, 0x09, 0x01, 0xF7.
+//
+// Example: []byte{0xF0, 0x7E, 0x7F, 0x09, 0x01, 0xF7}
+//
+// The example forms a General MIDI System On message for every device.
+func (gmsom *GeneralMIDISystemOnMessage) UnmarshalMIDI(b []byte) error {
+	deviceID, _, err := unmarshalUniversalMessage("general MIDI system on", UniversalNonRealTimeManufacturerID, []byte{GeneralMIDISubID, GeneralMIDISystemOnSubID}, 0, b)
+	if err != nil {
+		return err
+	}
+
+	*gmsom = GeneralMIDISystemOnMessage{
+		DeviceID: deviceID,
+	}
+	return nil
+}
+
+// GeneralMIDISystemOffMessage represents a General MIDI System Off Universal Non-Real-Time System Exclusive message,
+// which switches a device out of General MIDI mode.
+type GeneralMIDISystemOffMessage struct {
+	// DeviceID represents the device that should switch modes, or AllCallDeviceID for every device.
+	DeviceID DeviceID
+}
+
+// GetMessageName returns the name of this General MIDI System Off message.
+func (gmsom *GeneralMIDISystemOffMessage) GetMessageName() string {
+	return "General MIDI System Off"
+}
+
+// MarshalMIDI marshalls a GeneralMIDISystemOffMessage MIDI message into its raw bytes
+func (gmsom GeneralMIDISystemOffMessage) MarshalMIDI() ([]byte, error) {
+	return marshalUniversalMessage(UniversalNonRealTimeManufacturerID, gmsom.DeviceID, []byte{GeneralMIDISubID, GeneralMIDISystemOffSubID}, nil)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (gmsom *GeneralMIDISystemOffMessage) String() string {
+	return fmt.Sprintf(UniversalMessageStringFormat, MessageVersion, gmsom.GetMessageName(), gmsom.DeviceID)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a GeneralMIDISystemOffMessage struct pointer. General MIDI System Off
+// messages are represented by six bytes (left to right): 0xF0, 0x7E, device ID, 0x09, 0x02, 0xF7.
+//
+// Example: []byte{0xF0, 0x7E, 0x7F, 0x09, 0x02, 0xF7}
+//
+// The example forms a General MIDI System Off message for every device.
+func (gmsom *GeneralMIDISystemOffMessage) UnmarshalMIDI(b []byte) error {
+	deviceID, _, err := unmarshalUniversalMessage("general MIDI system off", UniversalNonRealTimeManufacturerID, []byte{GeneralMIDISubID, GeneralMIDISystemOffSubID}, 0, b)
+	if err != nil {
+		return err
+	}
+
+	*gmsom = GeneralMIDISystemOffMessage{
+		DeviceID: deviceID,
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package midiv1
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_GeneralMIDISystemOnMessage_GetMessageName(t *testing.T) {
+	t.Parallel()
+	message := GeneralMIDISystemOnMessage{}
+	expected := "General MIDI System On"
+	if message.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.GetMessageName())
+	}
+}
+
+func Test_GeneralMIDISystemOnMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  GeneralMIDISystemOnMessage
+		expected []byte
+		err      error
+	}{
+		"message marshalls into expected bytes": {
+			message:  GeneralMIDISystemOnMessage{DeviceID: AllCallDeviceID},
+			expected: []byte{0xF0, 0x7E, 0x7F, 0x09, 0x01, 0xF7},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_GeneralMIDISystemOnMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage GeneralMIDISystemOnMessage
+		err             error
+	}{
+		"sub-ID does not match": {
+			b:   []byte{0xF0, 0x7E, 0x7F, 0x09, 0x02, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b:               []byte{0xF0, 0x7E, 0x7F, 0x09, 0x01, 0xF7},
+			expectedMessage: GeneralMIDISystemOnMessage{DeviceID: AllCallDeviceID},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got GeneralMIDISystemOnMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
+
+func Test_GeneralMIDISystemOffMessage_GetMessageName(t *testing.T) {
+	t.Parallel()
+	message := GeneralMIDISystemOffMessage{}
+	expected := "General MIDI System Off"
+	if message.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.GetMessageName())
+	}
+}
+
+func Test_GeneralMIDISystemOffMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  GeneralMIDISystemOffMessage
+		expected []byte
+		err      error
+	}{
+		"message marshalls into expected bytes": {
+			message:  GeneralMIDISystemOffMessage{DeviceID: 0x05},
+			expected: []byte{0xF0, 0x7E, 0x05, 0x09, 0x02, 0xF7},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_GeneralMIDISystemOffMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage GeneralMIDISystemOffMessage
+		err             error
+	}{
+		"sub-ID does not match": {
+			b:   []byte{0xF0, 0x7E, 0x05, 0x09, 0x01, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b:               []byte{0xF0, 0x7E, 0x05, 0x09, 0x02, 0xF7},
+			expectedMessage: GeneralMIDISystemOffMessage{DeviceID: 0x05},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got GeneralMIDISystemOffMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
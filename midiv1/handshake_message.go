@@ -0,0 +1,197 @@
+package midiv1
+
+import "fmt"
+
+const (
+	// HandshakeMessageStringFormat represents the printf-compatible format specifically for a handshaking message string.
+	HandshakeMessageStringFormat string = "%s:%s:%d:%d"
+)
+
+// WaitMessage represents a Wait handshaking Universal Non-Real-Time System Exclusive message, which tells the sender to pause until an ACK message arrives, such as while the receiver writes a packet to disk.
+type WaitMessage struct {
+	// DeviceID represents the device ID of the device that is sending the message.
+	DeviceID DeviceID
+
+	// PacketNumber represents the number of the packet the message refers to, between 0 and 127 inclusive.
+	PacketNumber byte
+}
+
+// GetMessageName returns the name of this Wait message.
+func (wm *WaitMessage) GetMessageName() string {
+	return "Wait"
+}
+
+// MarshalMIDI marshalls a WaitMessage MIDI message into its raw bytes
+func (wm WaitMessage) MarshalMIDI() ([]byte, error) {
+	return marshalHandshakeMessage(WaitSubID, wm.DeviceID, wm.PacketNumber)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (wm *WaitMessage) String() string {
+	return fmt.Sprintf(HandshakeMessageStringFormat, MessageVersion, wm.GetMessageName(), wm.DeviceID, wm.PacketNumber)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a WaitMessage struct pointer. Wait messages are represented by six bytes
+// (left to right): 0xF0, 0x7E, device ID, 0x7C, packet number, 0xF7.
+//
+// Example: []byte{0xF0, 0x7E, 0x00, 0x7C, 0x05, 0xF7}
+//
+// The example forms a Wait message from device 0 for packet 5.
+func (wm *WaitMessage) UnmarshalMIDI(b []byte) error {
+	deviceID, packetNumber, err := unmarshalHandshakeMessage("wait", WaitSubID, b)
+	if err != nil {
+		return err
+	}
+
+	*wm = WaitMessage{
+		DeviceID:     deviceID,
+		PacketNumber: packetNumber,
+	}
+	return nil
+}
+
+// CancelMessage represents a Cancel handshaking Universal Non-Real-Time System Exclusive message, which tells the sender to abort the dump in progress.
+type CancelMessage struct {
+	// DeviceID represents the device ID of the device that is sending the message.
+	DeviceID DeviceID
+
+	// PacketNumber represents the number of the packet the message refers to, between 0 and 127 inclusive.
+	PacketNumber byte
+}
+
+// GetMessageName returns the name of this Cancel message.
+func (cm *CancelMessage) GetMessageName() string {
+	return "Cancel"
+}
+
+// MarshalMIDI marshalls a CancelMessage MIDI message into its raw bytes
+func (cm CancelMessage) MarshalMIDI() ([]byte, error) {
+	return marshalHandshakeMessage(CancelSubID, cm.DeviceID, cm.PacketNumber)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (cm *CancelMessage) String() string {
+	return fmt.Sprintf(HandshakeMessageStringFormat, MessageVersion, cm.GetMessageName(), cm.DeviceID, cm.PacketNumber)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a CancelMessage struct pointer. Cancel messages are represented by six bytes
+// (left to right): 0xF0, 0x7E, device ID, 0x7D, packet number, 0xF7.
+//
+// Example: []byte{0xF0, 0x7E, 0x00, 0x7D, 0x05, 0xF7}
+//
+// The example forms a Cancel message from device 0 for packet 5.
+func (cm *CancelMessage) UnmarshalMIDI(b []byte) error {
+	deviceID, packetNumber, err := unmarshalHandshakeMessage("cancel", CancelSubID, b)
+	if err != nil {
+		return err
+	}
+
+	*cm = CancelMessage{
+		DeviceID:     deviceID,
+		PacketNumber: packetNumber,
+	}
+	return nil
+}
+
+// NAKMessage represents a NAK handshaking Universal Non-Real-Time System Exclusive message, which tells the sender that a packet was received incorrectly and should be sent again.
+type NAKMessage struct {
+	// DeviceID represents the device ID of the device that is sending the message.
+	DeviceID DeviceID
+
+	// PacketNumber represents the number of the packet the message refers to, between 0 and 127 inclusive.
+	PacketNumber byte
+}
+
+// GetMessageName returns the name of this NAK message.
+func (nm *NAKMessage) GetMessageName() string {
+	return "NAK"
+}
+
+// MarshalMIDI marshalls a NAKMessage MIDI message into its raw bytes
+func (nm NAKMessage) MarshalMIDI() ([]byte, error) {
+	return marshalHandshakeMessage(NAKSubID, nm.DeviceID, nm.PacketNumber)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (nm *NAKMessage) String() string {
+	return fmt.Sprintf(HandshakeMessageStringFormat, MessageVersion, nm.GetMessageName(), nm.DeviceID, nm.PacketNumber)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a NAKMessage struct pointer. NAK messages are represented by six bytes
+// (left to right): 0xF0, 0x7E, device ID, 0x7E, packet number, 0xF7.
+//
+// Example: []byte{0xF0, 0x7E, 0x00, 0x7E, 0x05, 0xF7}
+//
+// The example forms a NAK message from device 0 for packet 5.
+func (nm *NAKMessage) UnmarshalMIDI(b []byte) error {
+	deviceID, packetNumber, err := unmarshalHandshakeMessage("NAK", NAKSubID, b)
+	if err != nil {
+		return err
+	}
+
+	*nm = NAKMessage{
+		DeviceID:     deviceID,
+		PacketNumber: packetNumber,
+	}
+	return nil
+}
+
+// ACKMessage represents a ACK handshaking Universal Non-Real-Time System Exclusive message, which tells the sender that a packet was received correctly and the next one can be sent.
+type ACKMessage struct {
+	// DeviceID represents the device ID of the device that is sending the message.
+	DeviceID DeviceID
+
+	// PacketNumber represents the number of the packet the message refers to, between 0 and 127 inclusive.
+	PacketNumber byte
+}
+
+// GetMessageName returns the name of this ACK message.
+func (am *ACKMessage) GetMessageName() string {
+	return "ACK"
+}
+
+// MarshalMIDI marshalls a ACKMessage MIDI message into its raw bytes
+func (am ACKMessage) MarshalMIDI() ([]byte, error) {
+	return marshalHandshakeMessage(ACKSubID, am.DeviceID, am.PacketNumber)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (am *ACKMessage) String() string {
+	return fmt.Sprintf(HandshakeMessageStringFormat, MessageVersion, am.GetMessageName(), am.DeviceID, am.PacketNumber)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a ACKMessage struct pointer. ACK messages are represented by six bytes
+// (left to right): 0xF0, 0x7E, device ID, 0x7F, packet number, 0xF7.
+//
+// Example: []byte{0xF0, 0x7E, 0x00, 0x7F, 0x05, 0xF7}
+//
+// The example forms a ACK message from device 0 for packet 5.
+func (am *ACKMessage) UnmarshalMIDI(b []byte) error {
+	deviceID, packetNumber, err := unmarshalHandshakeMessage("ACK", ACKSubID, b)
+	if err != nil {
+		return err
+	}
+
+	*am = ACKMessage{
+		DeviceID:     deviceID,
+		PacketNumber: packetNumber,
+	}
+	return nil
+}
+
+// marshalHandshakeMessage marshalls a handshaking message with the supplied sub-ID, device ID and packet number into its raw bytes.
+func marshalHandshakeMessage(subID byte, deviceID DeviceID, packetNumber byte) ([]byte, error) {
+	if ByteHasStatusMSB(packetNumber) {
+		return nil, fmt.Errorf("packet numbers must be between 0 and 127, inclusive, received %d: %w", packetNumber, ErrMarshallingMessage)
+	}
+	return marshalUniversalMessage(UniversalNonRealTimeManufacturerID, deviceID, []byte{subID}, []byte{packetNumber})
+}
+
+// unmarshalHandshakeMessage validates the raw bytes of a handshaking message and returns its device ID and packet number.
+func unmarshalHandshakeMessage(name string, subID byte, b []byte) (DeviceID, byte, error) {
+	deviceID, data, err := unmarshalUniversalMessage(name, UniversalNonRealTimeManufacturerID, []byte{subID}, 1, b)
+	if err != nil {
+		return 0, 0, err
+	}
+	return deviceID, data[0], nil
+}
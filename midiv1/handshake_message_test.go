@@ -0,0 +1,312 @@
+package midiv1
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_WaitMessage_GetMessageName(t *testing.T) {
+	t.Parallel()
+	message := WaitMessage{}
+	expected := "Wait"
+	if message.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.GetMessageName())
+	}
+}
+
+func Test_WaitMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  WaitMessage
+		expected []byte
+		err      error
+	}{
+		"packet number is out of range": {
+			message: WaitMessage{PacketNumber: 0x80},
+			err:     ErrMarshallingMessage,
+		},
+		"message marshalls into expected bytes": {
+			message:  WaitMessage{DeviceID: 0x01, PacketNumber: 0x05},
+			expected: []byte{0xF0, 0x7E, 0x01, 0x7C, 0x05, 0xF7},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_WaitMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage WaitMessage
+		err             error
+	}{
+		"packet number is missing": {
+			b:   []byte{0xF0, 0x7E, 0x01, 0x7C, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b:               []byte{0xF0, 0x7E, 0x01, 0x7C, 0x05, 0xF7},
+			expectedMessage: WaitMessage{DeviceID: 0x01, PacketNumber: 0x05},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got WaitMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
+
+func Test_CancelMessage_GetMessageName(t *testing.T) {
+	t.Parallel()
+	message := CancelMessage{}
+	expected := "Cancel"
+	if message.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.GetMessageName())
+	}
+}
+
+func Test_CancelMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  CancelMessage
+		expected []byte
+		err      error
+	}{
+		"packet number is out of range": {
+			message: CancelMessage{PacketNumber: 0x80},
+			err:     ErrMarshallingMessage,
+		},
+		"message marshalls into expected bytes": {
+			message:  CancelMessage{DeviceID: 0x01, PacketNumber: 0x05},
+			expected: []byte{0xF0, 0x7E, 0x01, 0x7D, 0x05, 0xF7},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_CancelMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage CancelMessage
+		err             error
+	}{
+		"packet number is missing": {
+			b:   []byte{0xF0, 0x7E, 0x01, 0x7D, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b:               []byte{0xF0, 0x7E, 0x01, 0x7D, 0x05, 0xF7},
+			expectedMessage: CancelMessage{DeviceID: 0x01, PacketNumber: 0x05},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got CancelMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
+
+func Test_NAKMessage_GetMessageName(t *testing.T) {
+	t.Parallel()
+	message := NAKMessage{}
+	expected := "NAK"
+	if message.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.GetMessageName())
+	}
+}
+
+func Test_NAKMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  NAKMessage
+		expected []byte
+		err      error
+	}{
+		"packet number is out of range": {
+			message: NAKMessage{PacketNumber: 0x80},
+			err:     ErrMarshallingMessage,
+		},
+		"message marshalls into expected bytes": {
+			message:  NAKMessage{DeviceID: 0x01, PacketNumber: 0x05},
+			expected: []byte{0xF0, 0x7E, 0x01, 0x7E, 0x05, 0xF7},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_NAKMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage NAKMessage
+		err             error
+	}{
+		"packet number is missing": {
+			b:   []byte{0xF0, 0x7E, 0x01, 0x7E, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b:               []byte{0xF0, 0x7E, 0x01, 0x7E, 0x05, 0xF7},
+			expectedMessage: NAKMessage{DeviceID: 0x01, PacketNumber: 0x05},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got NAKMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
+
+func Test_ACKMessage_GetMessageName(t *testing.T) {
+	t.Parallel()
+	message := ACKMessage{}
+	expected := "ACK"
+	if message.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.GetMessageName())
+	}
+}
+
+func Test_ACKMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  ACKMessage
+		expected []byte
+		err      error
+	}{
+		"packet number is out of range": {
+			message: ACKMessage{PacketNumber: 0x80},
+			err:     ErrMarshallingMessage,
+		},
+		"message marshalls into expected bytes": {
+			message:  ACKMessage{DeviceID: 0x01, PacketNumber: 0x05},
+			expected: []byte{0xF0, 0x7E, 0x01, 0x7F, 0x05, 0xF7},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_ACKMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage ACKMessage
+		err             error
+	}{
+		"packet number is missing": {
+			b:   []byte{0xF0, 0x7E, 0x01, 0x7F, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b:               []byte{0xF0, 0x7E, 0x01, 0x7F, 0x05, 0xF7},
+			expectedMessage: ACKMessage{DeviceID: 0x01, PacketNumber: 0x05},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got ACKMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
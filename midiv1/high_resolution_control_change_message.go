@@ -0,0 +1,127 @@
+package midiv1
+
+import (
+	"fmt"
+)
+
+const (
+	// HighResolutionControlChangeMessageLength represents the number of bytes in a full High Resolution Control Change
+	// message, which is a pair of Control Change messages.
+	HighResolutionControlChangeMessageLength int = 2 * ControlChangeMessageLength
+
+	// HighResolutionControlChangeMessageStringFormat represents the printf-compatible format specifically for a High
+	// Resolution Control Change message string.
+	HighResolutionControlChangeMessageStringFormat string = "%s:%s:%d:%d:%d"
+)
+
+// HighResolutionControlChangeMessage represents a 14-bit controller value, which is sent as a pair of Control Change
+// messages: the MSB on one of controllers 0 through 31, followed by the LSB on the matching controller 32 through 63.
+type HighResolutionControlChangeMessage struct {
+	// Channel represents the channel number where this message will be sent.
+	Channel Channel
+
+	// Controller represents the controller number that carries the MSB, between 0 and 31 inclusive.
+	Controller Controller
+
+	// Value represents the 14-bit value of the controller.
+	Value FourteenBitValue
+}
+
+// NewHighResolutionControlChangeMessageFromFloat returns a HighResolutionControlChangeMessage whose value is based on a
+// float between 0 and 1 inclusive. Values outside of the range are clamped.
+func NewHighResolutionControlChangeMessageFromFloat(channel Channel, controller Controller, f float64) HighResolutionControlChangeMessage {
+	return HighResolutionControlChangeMessage{
+		Channel:    channel,
+		Controller: controller,
+		Value:      NewFourteenBitValueFromFloat(f),
+	}
+}
+
+// GetMessageName returns the name of this High Resolution Control Change message.
+func (hrccm *HighResolutionControlChangeMessage) GetMessageName() string {
+	return "High Resolution Control Change"
+}
+
+// Float returns the value of the controller as a float between 0 and 1 inclusive.
+func (hrccm HighResolutionControlChangeMessage) Float() float64 {
+	return hrccm.Value.Float()
+}
+
+// ControlChanges returns the MSB and LSB Control Change messages that carry the controller value.
+func (hrccm HighResolutionControlChangeMessage) ControlChanges() ([]ControlChangeMessage, error) {
+	lsbController, err := hrccm.Controller.LSBController()
+	if err != nil {
+		return nil, fmt.Errorf("invalid high resolution controller (%v): %w", err, ErrMarshallingMessage)
+	}
+	if hrccm.Value > MaxFourteenBitValue {
+		return nil, fmt.Errorf("high resolution controller values must be between %d and %d, inclusive, received %d: %w", MinFourteenBitValue, MaxFourteenBitValue, hrccm.Value, ErrMarshallingMessage)
+	}
+	return []ControlChangeMessage{
+		{
+			Channel:    hrccm.Channel,
+			Controller: hrccm.Controller,
+			Value:      ControllerValue(hrccm.Value.GetMSB()),
+		},
+		{
+			Channel:    hrccm.Channel,
+			Controller: lsbController,
+			Value:      ControllerValue(hrccm.Value.GetLSB()),
+		},
+	}, nil
+}
+
+// MarshalMIDI marshalls a HighResolutionControlChangeMessage into the raw bytes of its MSB and LSB Control Change
+// messages, each with its full status byte.
+func (hrccm HighResolutionControlChangeMessage) MarshalMIDI() ([]byte, error) {
+	ccms, err := hrccm.ControlChanges()
+	if err != nil {
+		return nil, err
+	}
+	return marshalControlChanges(ccms)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (hrccm *HighResolutionControlChangeMessage) String() string {
+	return fmt.Sprintf(HighResolutionControlChangeMessageStringFormat, MessageVersion, hrccm.GetMessageName(), hrccm.Channel, hrccm.Controller, hrccm.Value)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a HighResolutionControlChangeMessage struct pointer. High Resolution Control
+// Change messages are represented by two full Control Change messages on the same channel: the MSB followed by the LSB.
+//
+// Example: []byte{0b10110001, 0b00000111, 0b01100100, 0b10110001, 0b00100111, 0b00000101}
+//
+// The example forms a High Resolution Control Change message for channel 2 (index 1), controller number 7 (Channel
+// Volume), MSB 100 and LSB 5.
+func (hrccm *HighResolutionControlChangeMessage) UnmarshalMIDI(b []byte) error {
+	// check the number of bytes in the message
+	if len(b) != HighResolutionControlChangeMessageLength {
+		return fmt.Errorf("high resolution control change messages are made up of %d bytes, received %d byte(s): %w", HighResolutionControlChangeMessageLength, len(b), ErrUnmarshallingMessage)
+	}
+
+	var ccms [2]ControlChangeMessage
+	for i := range ccms {
+		start := i * ControlChangeMessageLength
+		if ParseStatusFromStatusByte(b[start]) != ControlChangeMessageStatusNibble {
+			return fmt.Errorf("high resolution control change messages must be made up of control change messages, received status byte %#x: %w", b[start], ErrUnmarshallingMessage)
+		}
+		if err := ccms[i].UnmarshalMIDI(b[start : start+ControlChangeMessageLength]); err != nil {
+			return err
+		}
+	}
+
+	// make sure the second message carries the LSB of the first
+	lsbController, err := ccms[0].Controller.LSBController()
+	if err != nil {
+		return fmt.Errorf("invalid high resolution controller (%v): %w", err, ErrUnmarshallingMessage)
+	}
+	if ccms[1].Channel != ccms[0].Channel || ccms[1].Controller != lsbController {
+		return fmt.Errorf("high resolution control change messages must send the LSB on controller %d of the same channel: %w", lsbController, ErrUnmarshallingMessage)
+	}
+
+	*hrccm = HighResolutionControlChangeMessage{
+		Channel:    ccms[0].Channel,
+		Controller: ccms[0].Controller,
+		Value:      NewFourteenBitValueFromBytes(byte(ccms[0].Value), byte(ccms[1].Value)),
+	}
+	return nil
+}
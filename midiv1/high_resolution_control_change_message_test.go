@@ -0,0 +1,147 @@
+package midiv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func Test_HighResolutionControlChangeMessage_GetMessageName(t *testing.T) {
+	t.Parallel()
+	message := HighResolutionControlChangeMessage{}
+	expected := "High Resolution Control Change"
+	if message.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.GetMessageName())
+	}
+}
+
+func Test_HighResolutionControlChangeMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message       HighResolutionControlChangeMessage
+		expected      []byte
+		expectedError error
+	}{
+		"message marshalls into MSB and LSB control changes": {
+			message: HighResolutionControlChangeMessage{
+				Channel:    1,
+				Controller: ChannelVolumeMSBController,
+				Value:      NewFourteenBitValueFromBytes(100, 5),
+			},
+			expected: []byte{0b10110001, 0b00000111, 0b01100100, 0b10110001, 0b00100111, 0b00000101},
+		},
+		"controller without an LSB controller": {
+			message: HighResolutionControlChangeMessage{
+				Controller: 64,
+			},
+			expectedError: ErrMarshallingMessage,
+		},
+		"value out of range": {
+			message: HighResolutionControlChangeMessage{
+				Value: MaxFourteenBitValue + 1,
+			},
+			expectedError: ErrMarshallingMessage,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.expectedError != nil {
+				if !errors.Is(err, test.expectedError) {
+					t.Fatalf("expected error %v, got %v", test.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_HighResolutionControlChangeMessage_String(t *testing.T) {
+	t.Parallel()
+	message := HighResolutionControlChangeMessage{Channel: 1, Controller: 7, Value: 12805}
+	expected := fmt.Sprintf(HighResolutionControlChangeMessageStringFormat, MessageVersion, message.GetMessageName(), 1, 7, 12805)
+	if message.String() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.String())
+	}
+}
+
+func Test_HighResolutionControlChangeMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		bytes         []byte
+		expected      HighResolutionControlChangeMessage
+		expectedError error
+	}{
+		"bytes unmarshall into expected message": {
+			bytes: []byte{0b10110001, 0b00000111, 0b01100100, 0b10110001, 0b00100111, 0b00000101},
+			expected: HighResolutionControlChangeMessage{
+				Channel:    1,
+				Controller: ChannelVolumeMSBController,
+				Value:      NewFourteenBitValueFromBytes(100, 5),
+			},
+		},
+		"single control change": {
+			bytes:         []byte{0b10110001, 0b00000111, 0b01100100},
+			expectedError: ErrUnmarshallingMessage,
+		},
+		"not a control change": {
+			bytes:         []byte{0b10010001, 0b00000111, 0b01100100, 0b10110001, 0b00100111, 0b00000101},
+			expectedError: ErrUnmarshallingMessage,
+		},
+		"MSB on an LSB controller": {
+			bytes:         []byte{0b10110001, 0b00100111, 0b01100100, 0b10110001, 0b00100111, 0b00000101},
+			expectedError: ErrUnmarshallingMessage,
+		},
+		"LSB on a different controller": {
+			bytes:         []byte{0b10110001, 0b00000111, 0b01100100, 0b10110001, 0b00101010, 0b00000101},
+			expectedError: ErrUnmarshallingMessage,
+		},
+		"LSB on a different channel": {
+			bytes:         []byte{0b10110001, 0b00000111, 0b01100100, 0b10110010, 0b00100111, 0b00000101},
+			expectedError: ErrUnmarshallingMessage,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got HighResolutionControlChangeMessage
+			err := got.UnmarshalMIDI(test.bytes)
+			if test.expectedError != nil {
+				if !errors.Is(err, test.expectedError) {
+					t.Fatalf("expected error %v, got %v", test.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if !reflect.DeepEqual(test.expected, got) {
+				t.Fatalf("expected %+v, got %+v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_HighResolutionControlChangeMessage_Float(t *testing.T) {
+	t.Parallel()
+	message := NewHighResolutionControlChangeMessageFromFloat(0, ChannelVolumeMSBController, 0.5)
+	if message.Value != 8192 {
+		t.Fatalf("expected 8192, got %d", message.Value)
+	}
+	if math.Abs(message.Float()-0.5) > 1.0/float64(MaxFourteenBitValue) {
+		t.Fatalf("expected about 0.5, got %v", message.Float())
+	}
+	if got := NewHighResolutionControlChangeMessageFromFloat(0, 0, 2).Value; got != MaxFourteenBitValue {
+		t.Fatalf("expected %d, got %d", MaxFourteenBitValue, got)
+	}
+}
@@ -0,0 +1,118 @@
+package midiv1
+
+// MSBOnlyMode represents how a HighResolutionControllerDecoder treats an MSB that arrives without its LSB.
+type MSBOnlyMode int
+
+const (
+	// ResetLSBOnMSB reports the MSB straight away with an LSB of 0, as the MIDI 1.0 specification requires of receivers.
+	ResetLSBOnMSB MSBOnlyMode = iota
+
+	// KeepLSBOnMSB reports the MSB straight away together with the last LSB received for the controller.
+	KeepLSBOnMSB
+
+	// WaitForLSB holds the MSB back until its LSB arrives, so each pair is reported once with its full value. Until the
+	// first LSB arrives for a controller the sender is assumed to send MSBs only, and they are reported straight away.
+	WaitForLSB
+)
+
+// highResolutionController holds the bytes received for a single 14-bit controller pair.
+type highResolutionController struct {
+	// msb is the last MSB received, or -1 when none has been received
+	msb int
+
+	// lsb is the last LSB received
+	lsb int
+
+	// pairs represents whether an LSB has ever been received, meaning the sender sends both bytes
+	pairs bool
+}
+
+// HighResolutionControllerDecoder merges the MSB (0 through 31) and LSB (32 through 63) Control Change messages of each
+// channel and controller back into 14-bit controller values. An LSB updates the value with the last MSB received for
+// the controller, and is ignored until an MSB has been received. A HighResolutionControllerDecoder is not safe for
+// concurrent use.
+type HighResolutionControllerDecoder struct {
+	// mode is how an MSB that arrives without its LSB is treated
+	mode MSBOnlyMode
+
+	// controllers holds the bytes received for each channel and controller pair
+	controllers [16][MaxHighResolutionController + 1]highResolutionController
+}
+
+// HighResolutionControllerDecoderOption configures a HighResolutionControllerDecoder.
+type HighResolutionControllerDecoderOption func(*HighResolutionControllerDecoder)
+
+// WithMSBOnlyMode sets how the decoder treats an MSB that arrives without its LSB. The default is ResetLSBOnMSB.
+func WithMSBOnlyMode(mode MSBOnlyMode) HighResolutionControllerDecoderOption {
+	return func(d *HighResolutionControllerDecoder) {
+		d.mode = mode
+	}
+}
+
+// NewHighResolutionControllerDecoder returns a new HighResolutionControllerDecoder configured by any supplied options.
+func NewHighResolutionControllerDecoder(options ...HighResolutionControllerDecoderOption) *HighResolutionControllerDecoder {
+	d := &HighResolutionControllerDecoder{}
+	for i := range d.controllers {
+		for j := range d.controllers[i] {
+			d.controllers[i][j].msb = -1
+		}
+	}
+	for _, option := range options {
+		option(d)
+	}
+	return d
+}
+
+// Decode updates the decoder from a Control Change message and returns the 14-bit controller value it completes, if
+// any. Other messages and controllers outside of 0 through 63 are ignored.
+func (d *HighResolutionControllerDecoder) Decode(message Message) (HighResolutionControlChangeMessage, bool) {
+	ccm, ok := message.(*ControlChangeMessage)
+	if !ok || int(ccm.Channel) >= len(d.controllers) {
+		return HighResolutionControlChangeMessage{}, false
+	}
+
+	switch {
+	case ccm.Controller.IsHighResolutionMSB():
+		c := &d.controllers[ccm.Channel][ccm.Controller]
+		c.msb = int(ccm.Value)
+		if d.mode == WaitForLSB && c.pairs {
+			return HighResolutionControlChangeMessage{}, false
+		}
+		if d.mode != KeepLSBOnMSB {
+			c.lsb = 0
+		}
+		return c.message(ccm.Channel, ccm.Controller), true
+	case ccm.Controller.IsHighResolutionLSB():
+		controller := ccm.Controller - HighResolutionLSBOffset
+		c := &d.controllers[ccm.Channel][controller]
+		c.lsb = int(ccm.Value)
+		c.pairs = true
+		if c.msb < 0 {
+			return HighResolutionControlChangeMessage{}, false
+		}
+		return c.message(ccm.Channel, controller), true
+	}
+	return HighResolutionControlChangeMessage{}, false
+}
+
+// Value returns the 14-bit value made up of the last MSB and LSB received for the controller on the channel, and false if
+// no MSB has been received for it.
+func (d *HighResolutionControllerDecoder) Value(channel Channel, controller Controller) (FourteenBitValue, bool) {
+	if int(channel) >= len(d.controllers) || !controller.IsHighResolutionMSB() {
+		return MinFourteenBitValue, false
+	}
+	c := d.controllers[channel][controller]
+	if c.msb < 0 {
+		return MinFourteenBitValue, false
+	}
+	return NewFourteenBitValueFromBytes(byte(c.msb), byte(c.lsb)), true
+}
+
+// message returns the HighResolutionControlChangeMessage of the current controller value.
+func (c *highResolutionController) message(channel Channel, controller Controller) HighResolutionControlChangeMessage {
+	return HighResolutionControlChangeMessage{
+		Channel:    channel,
+		Controller: controller,
+		Value:      NewFourteenBitValueFromBytes(byte(c.msb), byte(c.lsb)),
+	}
+}
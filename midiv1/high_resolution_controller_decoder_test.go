@@ -0,0 +1,78 @@
+package midiv1
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_HighResolutionControllerDecoder_Decode(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		mode     MSBOnlyMode
+		messages []Message
+		expected []FourteenBitValue
+	}{
+		"reset LSB reports both bytes": {
+			messages: controlChanges(0, 7, 100, 39, 5, 7, 101),
+			expected: []FourteenBitValue{100 << 7, 100<<7 | 5, 101 << 7},
+		},
+		"keep LSB reuses the last LSB": {
+			mode:     KeepLSBOnMSB,
+			messages: controlChanges(0, 7, 100, 39, 5, 7, 101),
+			expected: []FourteenBitValue{100 << 7, 100<<7 | 5, 101<<7 | 5},
+		},
+		"wait for LSB reports pairs once": {
+			mode:     WaitForLSB,
+			messages: controlChanges(0, 7, 100, 39, 5, 7, 101, 39, 6, 7, 102, 39, 0),
+			expected: []FourteenBitValue{100 << 7, 100<<7 | 5, 101<<7 | 6, 102 << 7},
+		},
+		"wait for LSB reports MSB only senders": {
+			mode:     WaitForLSB,
+			messages: controlChanges(0, 7, 100, 7, 101),
+			expected: []FourteenBitValue{100 << 7, 101 << 7},
+		},
+		"LSB without MSB is ignored": {
+			messages: controlChanges(0, 39, 5),
+		},
+		"other controllers are ignored": {
+			messages: append(controlChanges(0, 64, 127, 101, 0), &NoteOnMessage{}),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			decoder := NewHighResolutionControllerDecoder(WithMSBOnlyMode(test.mode))
+			var got []FourteenBitValue
+			for _, message := range test.messages {
+				if m, ok := decoder.Decode(message); ok {
+					got = append(got, m.Value)
+				}
+			}
+			if !reflect.DeepEqual(test.expected, got) {
+				t.Fatalf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_HighResolutionControllerDecoder_Value(t *testing.T) {
+	t.Parallel()
+	decoder := NewHighResolutionControllerDecoder()
+	if _, ok := decoder.Value(2, ChannelVolumeMSBController); ok {
+		t.Fatalf("expected no value before any MSB")
+	}
+	for _, message := range controlChanges(2, 7, 100, 39, 5, 10, 64) {
+		decoder.Decode(message)
+	}
+
+	got, ok := decoder.Value(2, ChannelVolumeMSBController)
+	if !ok || got != 100<<7|5 {
+		t.Fatalf("expected %d, got %d (%v)", 100<<7|5, got, ok)
+	}
+	if _, ok := decoder.Value(1, ChannelVolumeMSBController); ok {
+		t.Fatalf("expected channels to be tracked separately")
+	}
+	if got, _ := decoder.Value(2, PanMSBController); got != 64<<7 {
+		t.Fatalf("expected %d, got %d", 64<<7, got)
+	}
+}
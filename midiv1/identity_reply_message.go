@@ -0,0 +1,90 @@
+package midiv1
+
+import "fmt"
+
+const (
+	// IdentityReplyMessageStringFormat represents the printf-compatible format specifically for an Identity Reply message string.
+	IdentityReplyMessageStringFormat string = "%s:%s:%d:%s:%d:%d:% X"
+
+	// MaxIdentityReplyNumber represents the highest family or model number, which are each sent as two 7-bit data bytes.
+	MaxIdentityReplyNumber uint16 = 0x3FFF
+)
+
+// IdentityReplyMessage represents an Identity Reply Universal Non-Real-Time System Exclusive message, which a device
+// sends in response to an Identity Request message.
+type IdentityReplyMessage struct {
+	// DeviceID represents the device ID of the device that is replying.
+	DeviceID DeviceID
+
+	// Manufacturer represents the manufacturer ID of the device.
+	Manufacturer ManufacturerID
+
+	// Family represents the device family code, between 0 and 0x3FFF inclusive.
+	Family uint16
+
+	// Model represents the device family member (model) code, between 0 and 0x3FFF inclusive.
+	Model uint16
+
+	// Version represents the four software revision level bytes of the device.
+	Version [4]byte
+}
+
+// GetMessageName returns the name of this Identity Reply message.
+func (irm *IdentityReplyMessage) GetMessageName() string {
+	return "Identity Reply"
+}
+
+// MarshalMIDI marshalls an IdentityReplyMessage MIDI message into its raw bytes
+func (irm IdentityReplyMessage) MarshalMIDI() ([]byte, error) {
+	if err := irm.Manufacturer.validate(); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, ErrMarshallingMessage)
+	}
+	if irm.Family > MaxIdentityReplyNumber || irm.Model > MaxIdentityReplyNumber {
+		return nil, fmt.Errorf("family and model codes must be between 0 and %#x, inclusive: %w", MaxIdentityReplyNumber, ErrMarshallingMessage)
+	}
+
+	data := append([]byte(nil), irm.Manufacturer.Bytes()...)
+	data = appendSevenBitValue(data, uint32(irm.Family), 2)
+	data = appendSevenBitValue(data, uint32(irm.Model), 2)
+	data = append(data, irm.Version[:]...)
+	return marshalUniversalMessage(UniversalNonRealTimeManufacturerID, irm.DeviceID, []byte{GeneralInformationSubID, IdentityReplySubID}, data)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (irm *IdentityReplyMessage) String() string {
+	return fmt.Sprintf(IdentityReplyMessageStringFormat, MessageVersion, irm.GetMessageName(), irm.DeviceID, irm.Manufacturer, irm.Family, irm.Model, irm.Version)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into an IdentityReplyMessage struct pointer. Identity Reply messages are
+// represented by (left to right): 0xF0, 0x7E, device ID, 0x06, 0x02, one-byte or three-byte manufacturer ID, two family
+// code bytes (LSB first), two model code bytes (LSB first), four version bytes, 0xF7.
+//
+// Example: []byte{0xF0, 0x7E, 0x10, 0x06, 0x02, 0x41, 0x2B, 0x02, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0xF7}
+//
+// The example forms an Identity Reply message from device 16 for a Roland device of family 0x012B, model 0, version 0.1.0.0.
+func (irm *IdentityReplyMessage) UnmarshalMIDI(b []byte) error {
+	deviceID, data, err := unmarshalUniversalMessage("identity reply", UniversalNonRealTimeManufacturerID, []byte{GeneralInformationSubID, IdentityReplySubID}, -1, b)
+	if err != nil {
+		return err
+	}
+
+	// form the manufacturer ID, which is followed by exactly eight bytes
+	manufacturer, n, err := ParseManufacturerID(data)
+	if err != nil {
+		return fmt.Errorf("invalid manufacturer ID (%v): %w", err, ErrUnmarshallingMessage)
+	}
+	data = data[n:]
+	if len(data) != 8 {
+		return fmt.Errorf("identity reply messages are made up of 8 bytes after their manufacturer ID, received %d byte(s): %w", len(data), ErrUnmarshallingMessage)
+	}
+
+	reply := IdentityReplyMessage{
+		DeviceID:     deviceID,
+		Manufacturer: manufacturer,
+		Family:       uint16(parseSevenBitValue(data[0:2])),
+		Model:        uint16(parseSevenBitValue(data[2:4])),
+	}
+	copy(reply.Version[:], data[4:8])
+	*irm = reply
+	return nil
+}
@@ -0,0 +1,128 @@
+package midiv1
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_IdentityReplyMessage_GetMessageName(t *testing.T) {
+	t.Parallel()
+	message := IdentityReplyMessage{}
+	expected := "Identity Reply"
+	if message.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.GetMessageName())
+	}
+}
+
+func Test_IdentityReplyMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  IdentityReplyMessage
+		expected []byte
+		err      error
+	}{
+		"family is out of range": {
+			message: IdentityReplyMessage{Manufacturer: RolandManufacturerID, Family: 0x4000},
+			err:     ErrMarshallingMessage,
+		},
+		"manufacturer ID is invalid": {
+			message: IdentityReplyMessage{Manufacturer: ManufacturerID{0x80}},
+			err:     ErrMarshallingMessage,
+		},
+		"version byte has a status MSB": {
+			message: IdentityReplyMessage{Manufacturer: RolandManufacturerID, Version: [4]byte{0x80}},
+			err:     ErrMarshallingMessage,
+		},
+		"message with one-byte manufacturer ID marshalls into expected bytes": {
+			message: IdentityReplyMessage{
+				DeviceID:     0x10,
+				Manufacturer: RolandManufacturerID,
+				Family:       0x012B,
+				Model:        0x0002,
+				Version:      [4]byte{0x00, 0x01, 0x00, 0x00},
+			},
+			expected: []byte{0xF0, 0x7E, 0x10, 0x06, 0x02, 0x41, 0x2B, 0x02, 0x02, 0x00, 0x00, 0x01, 0x00, 0x00, 0xF7},
+		},
+		"message with three-byte manufacturer ID marshalls into expected bytes": {
+			message: IdentityReplyMessage{
+				DeviceID:     0x7F,
+				Manufacturer: NovationManufacturerID,
+				Family:       0x0001,
+				Model:        0x3FFF,
+				Version:      [4]byte{0x01, 0x02, 0x03, 0x04},
+			},
+			expected: []byte{0xF0, 0x7E, 0x7F, 0x06, 0x02, 0x00, 0x20, 0x29, 0x01, 0x00, 0x7F, 0x7F, 0x01, 0x02, 0x03, 0x04, 0xF7},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_IdentityReplyMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage IdentityReplyMessage
+		err             error
+	}{
+		"message is too short": {
+			b:   []byte{0xF0, 0x7E, 0x10, 0x06, 0x02, 0x41, 0x2B, 0x02, 0x02, 0x00, 0x00, 0x01, 0x00, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"manufacturer ID is cut short": {
+			b:   []byte{0xF0, 0x7E, 0x10, 0x06, 0x02, 0x00, 0x20, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes with one-byte manufacturer ID unmarshal into expected message": {
+			b: []byte{0xF0, 0x7E, 0x10, 0x06, 0x02, 0x41, 0x2B, 0x02, 0x02, 0x00, 0x00, 0x01, 0x00, 0x00, 0xF7},
+			expectedMessage: IdentityReplyMessage{
+				DeviceID:     0x10,
+				Manufacturer: RolandManufacturerID,
+				Family:       0x012B,
+				Model:        0x0002,
+				Version:      [4]byte{0x00, 0x01, 0x00, 0x00},
+			},
+		},
+		"bytes with three-byte manufacturer ID unmarshal into expected message": {
+			b: []byte{0xF0, 0x7E, 0x7F, 0x06, 0x02, 0x00, 0x20, 0x29, 0x01, 0x00, 0x7F, 0x7F, 0x01, 0x02, 0x03, 0x04, 0xF7},
+			expectedMessage: IdentityReplyMessage{
+				DeviceID:     0x7F,
+				Manufacturer: NovationManufacturerID,
+				Family:       0x0001,
+				Model:        0x3FFF,
+				Version:      [4]byte{0x01, 0x02, 0x03, 0x04},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got IdentityReplyMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
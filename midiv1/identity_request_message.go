@@ -0,0 +1,43 @@
+package midiv1
+
+import "fmt"
+
+// IdentityRequestMessage represents an Identity Request Universal Non-Real-Time System Exclusive message, which asks
+// devices to reply with an Identity Reply message describing themselves.
+type IdentityRequestMessage struct {
+	// DeviceID represents the device that should reply, or AllCallDeviceID to ask every device.
+	DeviceID DeviceID
+}
+
+// GetMessageName returns the name of this Identity Request message.
+func (irm *IdentityRequestMessage) GetMessageName() string {
+	return "Identity Request"
+}
+
+// MarshalMIDI marshalls an IdentityRequestMessage MIDI message into its raw bytes
+func (irm IdentityRequestMessage) MarshalMIDI() ([]byte, error) {
+	return marshalUniversalMessage(UniversalNonRealTimeManufacturerID, irm.DeviceID, []byte{GeneralInformationSubID, IdentityRequestSubID}, nil)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (irm *IdentityRequestMessage) String() string {
+	return fmt.Sprintf(UniversalMessageStringFormat, MessageVersion, irm.GetMessageName(), irm.DeviceID)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into an IdentityRequestMessage struct pointer. Identity Request messages are
+// represented by six bytes (left to right): 0xF0, 0x7E, device ID, 0x06, 0x01, 0xF7.
+//
+// Example: []byte{0xF0, 0x7E, 0x7F, 0x06, 0x01, 0xF7}
+//
+// The example forms an Identity Request message for every device.
+func (irm *IdentityRequestMessage) UnmarshalMIDI(b []byte) error {
+	deviceID, _, err := unmarshalUniversalMessage("identity request", UniversalNonRealTimeManufacturerID, []byte{GeneralInformationSubID, IdentityRequestSubID}, 0, b)
+	if err != nil {
+		return err
+	}
+
+	*irm = IdentityRequestMessage{
+		DeviceID: deviceID,
+	}
+	return nil
+}
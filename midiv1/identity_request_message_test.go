@@ -0,0 +1,92 @@
+package midiv1
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_IdentityRequestMessage_GetMessageName(t *testing.T) {
+	t.Parallel()
+	message := IdentityRequestMessage{}
+	expected := "Identity Request"
+	if message.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.GetMessageName())
+	}
+}
+
+func Test_IdentityRequestMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  IdentityRequestMessage
+		expected []byte
+		err      error
+	}{
+		"device ID is out of range": {
+			message: IdentityRequestMessage{DeviceID: 0x80},
+			err:     ErrMarshallingMessage,
+		},
+		"message marshalls into expected bytes": {
+			message:  IdentityRequestMessage{DeviceID: AllCallDeviceID},
+			expected: []byte{0xF0, 0x7E, 0x7F, 0x06, 0x01, 0xF7},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_IdentityRequestMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage IdentityRequestMessage
+		err             error
+	}{
+		"manufacturer ID is not universal non-real-time": {
+			b:   []byte{0xF0, 0x7F, 0x7F, 0x06, 0x01, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"sub-ID does not match": {
+			b:   []byte{0xF0, 0x7E, 0x7F, 0x06, 0x02, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"message has trailing data": {
+			b:   []byte{0xF0, 0x7E, 0x7F, 0x06, 0x01, 0x00, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b:               []byte{0xF0, 0x7E, 0x10, 0x06, 0x01, 0xF7},
+			expectedMessage: IdentityRequestMessage{DeviceID: 0x10},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got IdentityRequestMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
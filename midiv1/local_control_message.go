@@ -0,0 +1,111 @@
+package midiv1
+
+import "fmt"
+
+const (
+	// LocalControlOffValue represents the Local Control message value that disconnects the local keyboard from its sound generator.
+	LocalControlOffValue byte = 0
+
+	// LocalControlOnValue represents the Local Control message value that reconnects the local keyboard to its sound generator.
+	LocalControlOnValue byte = 127
+
+	// LocalControlMessageStringFormat represents the printf-compatible format specifically for a Local Control message string.
+	LocalControlMessageStringFormat string = "%s:%s:%d:%t"
+)
+
+// LocalControlMessage represents a Local Control Channel Mode message, which connects or disconnects the local
+// keyboard of the receiver from its sound generator.
+type LocalControlMessage struct {
+	// Channel represents the channel number where this message will be sent.
+	Channel Channel
+
+	// On represents whether local control should be turned on (true) or off (false).
+	On bool
+}
+
+// GetMessageName returns the name of this Local Control message.
+func (lcm *LocalControlMessage) GetMessageName() string {
+	return "Local Control"
+}
+
+// MarshalMIDI marshalls a LocalControlMessage MIDI message into its raw bytes
+func (lcm LocalControlMessage) MarshalMIDI() ([]byte, error) {
+	return marshalChannelModeMessage(lcm.Channel, LocalControlController, lcm.value()), nil
+}
+
+// MarshalRunningStatusMIDI marshalls a running status MIDI message into its raw bytes.
+func (lcm LocalControlMessage) MarshalRunningStatusMIDI() ([]byte, error) {
+	return []byte{
+		byte(LocalControlController),
+		lcm.value(),
+	}, nil
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (lcm *LocalControlMessage) String() string {
+	return fmt.Sprintf(LocalControlMessageStringFormat, MessageVersion, lcm.GetMessageName(), lcm.Channel, lcm.On)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a LocalControlMessage struct pointer. Local Control messages are
+// represented by three bytes (left to right): Control Change status/channel, controller number 122, value 0 (off) or 127 (on).
+//
+// Example: []byte{0b10110001, 0b01111010, 0b01111111}
+//
+// The example forms a Local Control message for channel 2 (index 1) that turns local control on.
+func (lcm *LocalControlMessage) UnmarshalMIDI(b []byte) error {
+	channel, value, err := unmarshalChannelModeMessage("local control", LocalControlController, b)
+	if err != nil {
+		return err
+	}
+	on, err := parseLocalControlValue(value)
+	if err != nil {
+		return err
+	}
+
+	*lcm = LocalControlMessage{
+		Channel: channel,
+		On:      on,
+	}
+	return nil
+}
+
+// UnmarshalRunningStatusMIDI unmarshalls raw bytes into a LocalControlMessage struct pointer. Local Control running status messages are
+// represented by two bytes (left to right): controller number 122, value 0 (off) or 127 (on).
+//
+// Example: []byte{0b01111010, 0b01111111}
+//
+// The example forms a Local Control running status message that turns local control on.
+func (lcm *LocalControlMessage) UnmarshalRunningStatusMIDI(b []byte) error {
+	value, err := unmarshalRunningStatusChannelModeMessage("local control", LocalControlController, b)
+	if err != nil {
+		return err
+	}
+	on, err := parseLocalControlValue(value)
+	if err != nil {
+		return err
+	}
+
+	*lcm = LocalControlMessage{
+		On: on,
+	}
+	return nil
+}
+
+// value returns the data byte representing the on/off state of the message.
+func (lcm LocalControlMessage) value() byte {
+	if lcm.On {
+		return LocalControlOnValue
+	}
+	return LocalControlOffValue
+}
+
+// parseLocalControlValue returns the on/off state of a Local Control data byte. Only 0 and 127 are valid values.
+func parseLocalControlValue(value byte) (bool, error) {
+	switch value {
+	case LocalControlOffValue:
+		return false, nil
+	case LocalControlOnValue:
+		return true, nil
+	}
+	return false, fmt.Errorf("local control messages must have a value of %d (off) or %d (on), received %d: %w", LocalControlOffValue, LocalControlOnValue, value, ErrUnmarshallingMessage)
+}
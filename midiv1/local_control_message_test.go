@@ -0,0 +1,196 @@
+package midiv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func Test_LocalControlMessage_GetMessageName(t *testing.T) {
+	t.Parallel()
+	message := LocalControlMessage{}
+	expected := "Local Control"
+	if message.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.GetMessageName())
+	}
+}
+
+func Test_LocalControlMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  LocalControlMessage
+		expected []byte
+	}{
+		"local control off marshalls into expected bytes": {
+			message: LocalControlMessage{
+				Channel: 1,
+			},
+			expected: []byte{0b10110001, 0b01111010, 0b00000000},
+		},
+		"local control on marshalls into expected bytes": {
+			message: LocalControlMessage{
+				Channel: 1,
+				On:      true,
+			},
+			expected: []byte{0b10110001, 0b01111010, 0b01111111},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_LocalControlMessage_MarshalRunningStatusMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  LocalControlMessage
+		expected []byte
+	}{
+		"running status message marshalls into expected bytes": {
+			message: LocalControlMessage{
+				On: true,
+			},
+			expected: []byte{0b01111010, 0b01111111},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalRunningStatusMIDI()
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_LocalControlMessage_String(t *testing.T) {
+	t.Parallel()
+	message := LocalControlMessage{
+		Channel: 1,
+		On:      true,
+	}
+	expected := fmt.Sprintf("%s:%s:%d:%t", MessageVersion, "Local Control", 1, true)
+	if message.String() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.String())
+	}
+}
+
+func Test_LocalControlMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage LocalControlMessage
+		err             error
+	}{
+		"byte slice is not proper length": {
+			b:   []byte{0b10110001, 0b01111010},
+			err: ErrUnmarshallingMessage,
+		},
+		"first byte does not have a status MSB": {
+			b:   []byte{0b00110001, 0b01111010, 0b01111111},
+			err: ErrUnmarshallingMessage,
+		},
+		"second byte is the wrong controller number": {
+			b:   []byte{0b10110001, 0b01111011, 0b01111111},
+			err: ErrUnmarshallingMessage,
+		},
+		"third byte is neither on nor off": {
+			b:   []byte{0b10110001, 0b01111010, 0b01000000},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected local control off message": {
+			b: []byte{0b10110001, 0b01111010, 0b00000000},
+			expectedMessage: LocalControlMessage{
+				Channel: 1,
+			},
+		},
+		"bytes unmarshal into expected local control on message": {
+			b: []byte{0b10110001, 0b01111010, 0b01111111},
+			expectedMessage: LocalControlMessage{
+				Channel: 1,
+				On:      true,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got LocalControlMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil {
+				if err == nil {
+					t.Fatalf("expected non-nil %v error, got nil error", test.err)
+				}
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
+
+func Test_LocalControlMessage_UnmarshalRunningStatusMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage LocalControlMessage
+		err             error
+	}{
+		"byte slice is not proper length": {
+			b:   []byte{0b10110001, 0b01111010, 0b01111111},
+			err: ErrUnmarshallingMessage,
+		},
+		"second byte is neither on nor off": {
+			b:   []byte{0b01111010, 0b00000001},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b: []byte{0b01111010, 0b01111111},
+			expectedMessage: LocalControlMessage{
+				On: true,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got LocalControlMessage
+			err := (&got).UnmarshalRunningStatusMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil {
+				if err == nil {
+					t.Fatalf("expected non-nil %v error, got nil error", test.err)
+				}
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
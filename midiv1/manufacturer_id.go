@@ -0,0 +1,207 @@
+package midiv1
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidManufacturerID represents an invalid System Exclusive manufacturer ID.
+	ErrInvalidManufacturerID error = errors.New("invalid MIDI manufacturer ID")
+)
+
+const (
+	// ManufacturerIDStringFormat represents the printf-compatible format for the string representation of a manufacturer ID.
+	ManufacturerIDStringFormat string = "% X"
+
+	// ExtendedManufacturerIDPrefix represents the first byte of a three-byte manufacturer ID.
+	ExtendedManufacturerIDPrefix byte = 0x00
+)
+
+// ManufacturerID represents the manufacturer ID at the start of a System Exclusive message. One-byte IDs are stored in
+// the first byte with the rest left at zero, while three-byte IDs always start with 0x00 and use all three bytes.
+//
+// Example: ManufacturerID{0x41} (Roland) or ManufacturerID{0x00, 0x20, 0x29} (Novation)
+type ManufacturerID [3]byte
+
+// Well-known manufacturer IDs, as assigned by the MIDI Manufacturers Association and the Association of Musical
+// Electronics Industry.
+var (
+	// SequentialManufacturerID represents Sequential (formerly Sequential Circuits and Dave Smith Instruments).
+	SequentialManufacturerID ManufacturerID = ManufacturerID{0x01}
+
+	// MoogManufacturerID represents Moog Music.
+	MoogManufacturerID ManufacturerID = ManufacturerID{0x04}
+
+	// KurzweilManufacturerID represents Kurzweil.
+	KurzweilManufacturerID ManufacturerID = ManufacturerID{0x07}
+
+	// EnsoniqManufacturerID represents Ensoniq.
+	EnsoniqManufacturerID ManufacturerID = ManufacturerID{0x0F}
+
+	// OberheimManufacturerID represents Oberheim.
+	OberheimManufacturerID ManufacturerID = ManufacturerID{0x10}
+
+	// EmuManufacturerID represents E-mu.
+	EmuManufacturerID ManufacturerID = ManufacturerID{0x18}
+
+	// ClaviaManufacturerID represents Clavia (Nord).
+	ClaviaManufacturerID ManufacturerID = ManufacturerID{0x33}
+
+	// WaldorfManufacturerID represents Waldorf.
+	WaldorfManufacturerID ManufacturerID = ManufacturerID{0x3E}
+
+	// KawaiManufacturerID represents Kawai.
+	KawaiManufacturerID ManufacturerID = ManufacturerID{0x40}
+
+	// RolandManufacturerID represents Roland.
+	RolandManufacturerID ManufacturerID = ManufacturerID{0x41}
+
+	// KorgManufacturerID represents Korg.
+	KorgManufacturerID ManufacturerID = ManufacturerID{0x42}
+
+	// YamahaManufacturerID represents Yamaha.
+	YamahaManufacturerID ManufacturerID = ManufacturerID{0x43}
+
+	// CasioManufacturerID represents Casio.
+	CasioManufacturerID ManufacturerID = ManufacturerID{0x44}
+
+	// AkaiManufacturerID represents Akai.
+	AkaiManufacturerID ManufacturerID = ManufacturerID{0x47}
+
+	// NonCommercialManufacturerID represents the ID reserved for non-commercial use, such as research and education.
+	NonCommercialManufacturerID ManufacturerID = ManufacturerID{0x7D}
+
+	// UniversalNonRealTimeManufacturerID represents the ID of Universal Non-Real-Time System Exclusive messages.
+	UniversalNonRealTimeManufacturerID ManufacturerID = ManufacturerID{0x7E}
+
+	// UniversalRealTimeManufacturerID represents the ID of Universal Real-Time System Exclusive messages.
+	UniversalRealTimeManufacturerID ManufacturerID = ManufacturerID{0x7F}
+
+	// AlesisManufacturerID represents Alesis.
+	AlesisManufacturerID ManufacturerID = ManufacturerID{0x00, 0x00, 0x0E}
+
+	// NovationManufacturerID represents Focusrite/Novation.
+	NovationManufacturerID ManufacturerID = ManufacturerID{0x00, 0x20, 0x29}
+
+	// BehringerManufacturerID represents Behringer.
+	BehringerManufacturerID ManufacturerID = ManufacturerID{0x00, 0x20, 0x32}
+
+	// AccessManufacturerID represents Access Music.
+	AccessManufacturerID ManufacturerID = ManufacturerID{0x00, 0x20, 0x33}
+
+	// ElektronManufacturerID represents Elektron.
+	ElektronManufacturerID ManufacturerID = ManufacturerID{0x00, 0x20, 0x3C}
+
+	// ArturiaManufacturerID represents Arturia.
+	ArturiaManufacturerID ManufacturerID = ManufacturerID{0x00, 0x20, 0x6B}
+
+	// NativeInstrumentsManufacturerID represents Native Instruments.
+	NativeInstrumentsManufacturerID ManufacturerID = ManufacturerID{0x00, 0x21, 0x09}
+)
+
+// ManufacturerNames maps the well-known manufacturer IDs to the names of their manufacturers.
+var ManufacturerNames = map[ManufacturerID]string{
+	SequentialManufacturerID:           "Sequential",
+	MoogManufacturerID:                 "Moog",
+	KurzweilManufacturerID:             "Kurzweil",
+	EnsoniqManufacturerID:              "Ensoniq",
+	OberheimManufacturerID:             "Oberheim",
+	EmuManufacturerID:                  "E-mu",
+	ClaviaManufacturerID:               "Clavia",
+	WaldorfManufacturerID:              "Waldorf",
+	KawaiManufacturerID:                "Kawai",
+	RolandManufacturerID:               "Roland",
+	KorgManufacturerID:                 "Korg",
+	YamahaManufacturerID:               "Yamaha",
+	CasioManufacturerID:                "Casio",
+	AkaiManufacturerID:                 "Akai",
+	NonCommercialManufacturerID:        "Non-Commercial",
+	UniversalNonRealTimeManufacturerID: "Universal Non-Real-Time",
+	UniversalRealTimeManufacturerID:    "Universal Real-Time",
+	AlesisManufacturerID:               "Alesis",
+	NovationManufacturerID:             "Novation",
+	BehringerManufacturerID:            "Behringer",
+	AccessManufacturerID:               "Access",
+	ElektronManufacturerID:             "Elektron",
+	ArturiaManufacturerID:              "Arturia",
+	NativeInstrumentsManufacturerID:    "Native Instruments",
+}
+
+// NewManufacturerID returns a one-byte ManufacturerID based on the byte argument. Valid IDs are between 0x01 and 0x7F
+// inclusive, since 0x00 starts a three-byte ID.
+func NewManufacturerID(id byte) (ManufacturerID, error) {
+	if id == ExtendedManufacturerIDPrefix || ByteHasStatusMSB(id) {
+		return ManufacturerID{}, fmt.Errorf("valid one-byte manufacturer IDs are between %#x and %#x, inclusive: %w", 0x01, 0x7F, ErrInvalidManufacturerID)
+	}
+	return ManufacturerID{id}, nil
+}
+
+// NewExtendedManufacturerID returns a three-byte ManufacturerID (0x00, id1, id2) based on the byte arguments.
+func NewExtendedManufacturerID(id1 byte, id2 byte) (ManufacturerID, error) {
+	if ByteHasStatusMSB(id1) || ByteHasStatusMSB(id2) {
+		return ManufacturerID{}, fmt.Errorf("three-byte manufacturer IDs must be made up of data bytes: %w", ErrInvalidManufacturerID)
+	}
+	if id1 == 0 && id2 == 0 {
+		return ManufacturerID{}, fmt.Errorf("three-byte manufacturer ID 00 00 00 is reserved: %w", ErrInvalidManufacturerID)
+	}
+	return ManufacturerID{ExtendedManufacturerIDPrefix, id1, id2}, nil
+}
+
+// ParseManufacturerID returns the ManufacturerID at the start of b along with the number of bytes it occupies.
+func ParseManufacturerID(b []byte) (ManufacturerID, int, error) {
+	if len(b) == 0 {
+		return ManufacturerID{}, 0, fmt.Errorf("manufacturer IDs are made up of at least 1 byte: %w", ErrInvalidManufacturerID)
+	}
+	if b[0] != ExtendedManufacturerIDPrefix {
+		id, err := NewManufacturerID(b[0])
+		return id, 1, err
+	}
+	if len(b) < 3 {
+		return ManufacturerID{}, 0, fmt.Errorf("three-byte manufacturer IDs are made up of 3 bytes, received %d byte(s): %w", len(b), ErrInvalidManufacturerID)
+	}
+	id, err := NewExtendedManufacturerID(b[1], b[2])
+	return id, 3, err
+}
+
+// Bytes returns the bytes of the manufacturer ID as they appear within a System Exclusive message.
+func (id ManufacturerID) Bytes() []byte {
+	if id.IsExtended() {
+		return []byte{id[0], id[1], id[2]}
+	}
+	return []byte{id[0]}
+}
+
+// IsExtended returns whether the manufacturer ID is a three-byte ID.
+func (id ManufacturerID) IsExtended() bool {
+	return id[0] == ExtendedManufacturerIDPrefix
+}
+
+// Name returns the name of the manufacturer from ManufacturerNames, or an empty string if the ID is not well-known.
+func (id ManufacturerID) Name() string {
+	return ManufacturerNames[id]
+}
+
+// String returns the human-readable representation of the manufacturer ID.
+func (id ManufacturerID) String() string {
+	if name := id.Name(); name != "" {
+		return name
+	}
+	return fmt.Sprintf(ManufacturerIDStringFormat, id.Bytes())
+}
+
+// validate returns an error if the manufacturer ID cannot appear within a System Exclusive message.
+func (id ManufacturerID) validate() error {
+	if id == (ManufacturerID{}) {
+		return fmt.Errorf("manufacturer ID % X is reserved and is most likely unset: %w", id[:], ErrInvalidManufacturerID)
+	}
+	for _, b := range id {
+		if ByteHasStatusMSB(b) {
+			return fmt.Errorf("manufacturer IDs must be made up of data bytes, received % X: %w", id.Bytes(), ErrInvalidManufacturerID)
+		}
+	}
+	if !id.IsExtended() && (id[1] != 0 || id[2] != 0) {
+		return fmt.Errorf("one-byte manufacturer IDs must leave their last two bytes at zero, received % X: %w", id[:], ErrInvalidManufacturerID)
+	}
+	return nil
+}
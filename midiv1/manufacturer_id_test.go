@@ -0,0 +1,151 @@
+package midiv1
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func Test_NewManufacturerID(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		id       byte
+		expected ManufacturerID
+		err      error
+	}{
+		"extended prefix is not a one-byte ID": {
+			id:  0x00,
+			err: ErrInvalidManufacturerID,
+		},
+		"byte has a status MSB": {
+			id:  0x80,
+			err: ErrInvalidManufacturerID,
+		},
+		"valid one-byte ID": {
+			id:       0x41,
+			expected: RolandManufacturerID,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewManufacturerID(test.id)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if got != test.expected {
+				t.Fatalf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_NewExtendedManufacturerID(t *testing.T) {
+	t.Parallel()
+	if _, err := NewExtendedManufacturerID(0x20, 0x80); !errors.Is(err, ErrInvalidManufacturerID) {
+		t.Fatalf("expected %v error, got %v", ErrInvalidManufacturerID, err)
+	}
+	if _, err := NewExtendedManufacturerID(0x00, 0x00); !errors.Is(err, ErrInvalidManufacturerID) {
+		t.Fatalf("expected %v error, got %v", ErrInvalidManufacturerID, err)
+	}
+	got, err := NewExtendedManufacturerID(0x20, 0x29)
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if got != NovationManufacturerID {
+		t.Fatalf("expected %v, got %v", NovationManufacturerID, got)
+	}
+}
+
+func Test_ParseManufacturerID(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b              []byte
+		expected       ManufacturerID
+		expectedLength int
+		err            error
+	}{
+		"byte slice is empty": {
+			b:   []byte{},
+			err: ErrInvalidManufacturerID,
+		},
+		"three-byte ID is cut short": {
+			b:   []byte{0x00, 0x20},
+			err: ErrInvalidManufacturerID,
+		},
+		"one-byte ID": {
+			b:              []byte{0x43, 0x10},
+			expected:       YamahaManufacturerID,
+			expectedLength: 1,
+		},
+		"three-byte ID": {
+			b:              []byte{0x00, 0x00, 0x0E, 0x10},
+			expected:       AlesisManufacturerID,
+			expectedLength: 3,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, n, err := ParseManufacturerID(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if got != test.expected {
+				t.Fatalf("expected %v, got %v", test.expected, got)
+			}
+			if n != test.expectedLength {
+				t.Fatalf("expected length %d, got %d", test.expectedLength, n)
+			}
+		})
+	}
+}
+
+func Test_ManufacturerID_Bytes(t *testing.T) {
+	t.Parallel()
+	if !bytes.Equal(RolandManufacturerID.Bytes(), []byte{0x41}) {
+		t.Fatalf("expected %#v, got %#v", []byte{0x41}, RolandManufacturerID.Bytes())
+	}
+	if !bytes.Equal(ArturiaManufacturerID.Bytes(), []byte{0x00, 0x20, 0x6B}) {
+		t.Fatalf("expected %#v, got %#v", []byte{0x00, 0x20, 0x6B}, ArturiaManufacturerID.Bytes())
+	}
+}
+
+func Test_ManufacturerID_String(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		id       ManufacturerID
+		expected string
+	}{
+		"well-known one-byte ID": {
+			id:       RolandManufacturerID,
+			expected: "Roland",
+		},
+		"well-known three-byte ID": {
+			id:       NativeInstrumentsManufacturerID,
+			expected: "Native Instruments",
+		},
+		"unknown one-byte ID": {
+			id:       ManufacturerID{0x5A},
+			expected: "5A",
+		},
+		"unknown three-byte ID": {
+			id:       ManufacturerID{0x00, 0x01, 0x02},
+			expected: "00 01 02",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := test.id.String(); got != test.expected {
+				t.Fatalf("expected %s, got %s", test.expected, got)
+			}
+		})
+	}
+}
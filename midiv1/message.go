@@ -44,16 +44,34 @@ func ByteHasStatusMSB(b byte) bool {
 	return (b & byte(StatusMessageMSB)) == byte(StatusMessageMSB)
 }
 
+// IsRealTimeStatus returns whether the supplied byte is a System Real-Time status byte (0xF8 through 0xFF), including
+// the undefined 0xF9 and 0xFD. Real-Time bytes may appear anywhere in a stream, even within another message.
+func IsRealTimeStatus(b byte) bool {
+	return b >= RealTimeStatusMin
+}
+
 // Status represents the first four bits of the MIDI message status byte (message type and code).
 //
 // Example: 0b11010000 (Status message for Channel Pressure)
 type Status Nibble
 
+// SystemMessageStatusNibble represents the status nibble shared by every System Exclusive, System Common and
+// System Real-Time message. System messages are identified by the full status byte rather than by a channel.
+const SystemMessageStatusNibble Status = 0b11110000
+
+// RealTimeStatusMin represents the lowest System Real-Time status byte.
+const RealTimeStatusMin byte = 0xF8
+
 // MakeStatusByte creates and returns a MIDI message status byte by OR-ing the status and channel nibbles.
 func MakeStatusByte(statusNibble Status, channelNibble Channel) byte {
 	return byte(statusNibble) | byte(channelNibble)
 }
 
+// ParseStatusFromStatusByte returns a Status by AND-ing the status byte with the status nibble mask.
+func ParseStatusFromStatusByte(status byte) Status {
+	return Status(status & byte(SystemMessageStatusNibble))
+}
+
 // Channel represents second four bits of the MIDI message status byte (the ID of the channel).
 //
 // Example: 0b00001101 (index 13 is channel 14)
@@ -88,6 +106,15 @@ func NewChannelFromByte(channel byte) (Channel, error) {
 	return Channel(channel), nil
 }
 
+// AllChannels returns every MIDI channel in ascending order.
+func AllChannels() []Channel {
+	channels := make([]Channel, 0, int(MaxChannel)+1)
+	for channel := MinChannel; channel <= MaxChannel; channel++ {
+		channels = append(channels, channel)
+	}
+	return channels
+}
+
 // ParseChannelFromStatusByte returns a Channel by AND-ing the channel nibble in the status byte with its maximum value.
 func ParseChannelFromStatusByte(status byte) (Channel, error) {
 	// https://medium.com/learning-the-go-programming-language/bit-hacking-with-go-e0acee258827
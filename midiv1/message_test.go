@@ -57,6 +57,48 @@ func Test_ByteHasStatusMSB(t *testing.T) {
 	}
 }
 
+func Test_IsRealTimeStatus(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		statusByte byte
+		expected   bool
+	}{
+		"timing clock": {
+			statusByte: 0xF8,
+			expected:   true,
+		},
+		"undefined real-time status": {
+			statusByte: 0xFD,
+			expected:   true,
+		},
+		"system reset": {
+			statusByte: 0xFF,
+			expected:   true,
+		},
+		"system common status": {
+			statusByte: 0xF6,
+			expected:   false,
+		},
+		"channel status": {
+			statusByte: 0b10010101,
+			expected:   false,
+		},
+		"data byte": {
+			statusByte: 0x7F,
+			expected:   false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := IsRealTimeStatus(test.statusByte)
+			if got != test.expected {
+				t.Fatalf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
 func Test_MakeStatusByte(t *testing.T) {
 	t.Parallel()
 	tests := map[string]struct {
@@ -81,6 +123,32 @@ func Test_MakeStatusByte(t *testing.T) {
 	}
 }
 
+func Test_ParseStatusFromStatusByte(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		statusByte     byte
+		expectedStatus Status
+	}{
+		"status is note-on nibble": {
+			statusByte:     0b10010101,
+			expectedStatus: NoteOnMessageStatusNibble,
+		},
+		"status is system nibble": {
+			statusByte:     0b11111000,
+			expectedStatus: SystemMessageStatusNibble,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := ParseStatusFromStatusByte(test.statusByte)
+			if got != test.expectedStatus {
+				t.Fatalf("expected %v, got %v", test.expectedStatus, got)
+			}
+		})
+	}
+}
+
 func Test_NewChannel(t *testing.T) {
 	t.Parallel()
 	tests := map[string]struct {
@@ -164,6 +232,19 @@ func Test_NewChannelFromByte(t *testing.T) {
 	}
 }
 
+func Test_AllChannels(t *testing.T) {
+	t.Parallel()
+	got := AllChannels()
+	if len(got) != 16 {
+		t.Fatalf("expected 16 channels, got %d", len(got))
+	}
+	for i, channel := range got {
+		if channel != Channel(i) {
+			t.Fatalf("expected channel %d at index %d, got %d", i, i, channel)
+		}
+	}
+}
+
 func Test_NewNote(t *testing.T) {
 	t.Parallel()
 	tests := map[string]struct {
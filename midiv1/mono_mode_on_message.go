@@ -0,0 +1,103 @@
+package midiv1
+
+import "fmt"
+
+const (
+	// MaxMonoModeChannels represents the highest number of channels that can be requested by a Mono Mode On message.
+	MaxMonoModeChannels int = 16
+
+	// MonoModeOnMessageStringFormat represents the printf-compatible format specifically for a Mono Mode On message string.
+	MonoModeOnMessageStringFormat string = "%s:%s:%d:%d"
+)
+
+// MonoModeOnMessage represents a Mono Mode On (Poly Mode Off) Channel Mode message, which makes the receiver play
+// monophonically on one or more channels. It also causes All Notes Off.
+type MonoModeOnMessage struct {
+	// Channel represents the channel number where this message will be sent.
+	Channel Channel
+
+	// Channels represents the number of channels the receiver should assign to mono voices, starting at its basic channel.
+	// A value of 0 means the number of channels equals the number of voices in the receiver. Valid values are between
+	// 0 and 16 inclusive.
+	Channels int
+}
+
+// GetMessageName returns the name of this Mono Mode On message.
+func (mmom *MonoModeOnMessage) GetMessageName() string {
+	return "Mono Mode On"
+}
+
+// MarshalMIDI marshalls a MonoModeOnMessage MIDI message into its raw bytes
+func (mmom MonoModeOnMessage) MarshalMIDI() ([]byte, error) {
+	if err := validateMonoModeChannels(mmom.Channels); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, ErrMarshallingMessage)
+	}
+	return marshalChannelModeMessage(mmom.Channel, MonoModeOnController, byte(mmom.Channels)), nil
+}
+
+// MarshalRunningStatusMIDI marshalls a running status MIDI message into its raw bytes.
+func (mmom MonoModeOnMessage) MarshalRunningStatusMIDI() ([]byte, error) {
+	if err := validateMonoModeChannels(mmom.Channels); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, ErrMarshallingMessage)
+	}
+	return []byte{
+		byte(MonoModeOnController),
+		byte(mmom.Channels),
+	}, nil
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (mmom *MonoModeOnMessage) String() string {
+	return fmt.Sprintf(MonoModeOnMessageStringFormat, MessageVersion, mmom.GetMessageName(), mmom.Channel, mmom.Channels)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a MonoModeOnMessage struct pointer. Mono Mode On messages are
+// represented by three bytes (left to right): Control Change status/channel, controller number 126, number of channels.
+//
+// Example: []byte{0b10110001, 0b01111110, 0b00000100}
+//
+// The example forms a Mono Mode On message for channel 2 (index 1) that assigns mono voices to 4 channels.
+func (mmom *MonoModeOnMessage) UnmarshalMIDI(b []byte) error {
+	channel, value, err := unmarshalChannelModeMessage("mono mode on", MonoModeOnController, b)
+	if err != nil {
+		return err
+	}
+	if err := validateMonoModeChannels(int(value)); err != nil {
+		return fmt.Errorf("%v: %w", err, ErrUnmarshallingMessage)
+	}
+
+	*mmom = MonoModeOnMessage{
+		Channel:  channel,
+		Channels: int(value),
+	}
+	return nil
+}
+
+// UnmarshalRunningStatusMIDI unmarshalls raw bytes into a MonoModeOnMessage struct pointer. Mono Mode On running status messages are
+// represented by two bytes (left to right): controller number 126, number of channels.
+//
+// Example: []byte{0b01111110, 0b00000100}
+//
+// The example forms a Mono Mode On running status message that assigns mono voices to 4 channels.
+func (mmom *MonoModeOnMessage) UnmarshalRunningStatusMIDI(b []byte) error {
+	value, err := unmarshalRunningStatusChannelModeMessage("mono mode on", MonoModeOnController, b)
+	if err != nil {
+		return err
+	}
+	if err := validateMonoModeChannels(int(value)); err != nil {
+		return fmt.Errorf("%v: %w", err, ErrUnmarshallingMessage)
+	}
+
+	*mmom = MonoModeOnMessage{
+		Channels: int(value),
+	}
+	return nil
+}
+
+// validateMonoModeChannels returns an error if the number of channels in a Mono Mode On message is out of range.
+func validateMonoModeChannels(channels int) error {
+	if channels < 0 || channels > MaxMonoModeChannels {
+		return fmt.Errorf("mono mode on messages must request between 0 and %d channels, inclusive, received %d", MaxMonoModeChannels, channels)
+	}
+	return nil
+}
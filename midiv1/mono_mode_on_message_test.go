@@ -0,0 +1,205 @@
+package midiv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func Test_MonoModeOnMessage_GetMessageName(t *testing.T) {
+	t.Parallel()
+	message := MonoModeOnMessage{}
+	expected := "Mono Mode On"
+	if message.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.GetMessageName())
+	}
+}
+
+func Test_MonoModeOnMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  MonoModeOnMessage
+		expected []byte
+		err      error
+	}{
+		"too many channels": {
+			message: MonoModeOnMessage{
+				Channel:  1,
+				Channels: 17,
+			},
+			err: ErrMarshallingMessage,
+		},
+		"message marshalls into expected bytes": {
+			message: MonoModeOnMessage{
+				Channel:  1,
+				Channels: 4,
+			},
+			expected: []byte{0b10110001, 0b01111110, 0b00000100},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_MonoModeOnMessage_MarshalRunningStatusMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  MonoModeOnMessage
+		expected []byte
+		err      error
+	}{
+		"negative channels": {
+			message: MonoModeOnMessage{
+				Channels: -1,
+			},
+			err: ErrMarshallingMessage,
+		},
+		"running status message marshalls into expected bytes": {
+			message: MonoModeOnMessage{
+				Channels: 4,
+			},
+			expected: []byte{0b01111110, 0b00000100},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalRunningStatusMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_MonoModeOnMessage_String(t *testing.T) {
+	t.Parallel()
+	message := MonoModeOnMessage{
+		Channel:  1,
+		Channels: 4,
+	}
+	expected := fmt.Sprintf("%s:%s:%d:%d", MessageVersion, "Mono Mode On", 1, 4)
+	if message.String() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.String())
+	}
+}
+
+func Test_MonoModeOnMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage MonoModeOnMessage
+		err             error
+	}{
+		"byte slice is not proper length": {
+			b:   []byte{0b10110001, 0b01111110},
+			err: ErrUnmarshallingMessage,
+		},
+		"first byte does not have a status MSB": {
+			b:   []byte{0b00110001, 0b01111110, 0b00000100},
+			err: ErrUnmarshallingMessage,
+		},
+		"second byte is the wrong controller number": {
+			b:   []byte{0b10110001, 0b01111111, 0b00000100},
+			err: ErrUnmarshallingMessage,
+		},
+		"third byte requests too many channels": {
+			b:   []byte{0b10110001, 0b01111110, 0b00010001},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b: []byte{0b10110001, 0b01111110, 0b00000100},
+			expectedMessage: MonoModeOnMessage{
+				Channel:  1,
+				Channels: 4,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got MonoModeOnMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil {
+				if err == nil {
+					t.Fatalf("expected non-nil %v error, got nil error", test.err)
+				}
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
+
+func Test_MonoModeOnMessage_UnmarshalRunningStatusMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage MonoModeOnMessage
+		err             error
+	}{
+		"byte slice is not proper length": {
+			b:   []byte{0b10110001, 0b01111110, 0b00000100},
+			err: ErrUnmarshallingMessage,
+		},
+		"second byte requests too many channels": {
+			b:   []byte{0b01111110, 0b00010001},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b: []byte{0b01111110, 0b00000000},
+			expectedMessage: MonoModeOnMessage{
+				Channels: 0,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got MonoModeOnMessage
+			err := (&got).UnmarshalRunningStatusMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil {
+				if err == nil {
+					t.Fatalf("expected non-nil %v error, got nil error", test.err)
+				}
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
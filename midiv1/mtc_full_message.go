@@ -0,0 +1,81 @@
+package midiv1
+
+import "fmt"
+
+const (
+	// MTCFullMessageStringFormat represents the printf-compatible format specifically for an MTC Full Message string.
+	MTCFullMessageStringFormat string = "%s:%s:%d:%s:%02d:%02d:%02d:%02d"
+)
+
+// MTCFullMessage represents an MTC Full Message Universal Real-Time System Exclusive message, which sends a complete
+// SMPTE time code at once, such as when a sequencer locates to a new position.
+type MTCFullMessage struct {
+	// DeviceID represents the device that should change position, normally AllCallDeviceID.
+	DeviceID DeviceID
+
+	// Rate represents the SMPTE frame rate of the time code.
+	Rate TimeCodeRate
+
+	// Hours represents the hours of the time code, between 0 and 23 inclusive.
+	Hours int
+
+	// Minutes represents the minutes of the time code, between 0 and 59 inclusive.
+	Minutes int
+
+	// Seconds represents the seconds of the time code, between 0 and 59 inclusive.
+	Seconds int
+
+	// Frames represents the frames of the time code, lower than the number of frames per second of the rate.
+	Frames int
+}
+
+// GetMessageName returns the name of this MTC Full Message.
+func (mfm *MTCFullMessage) GetMessageName() string {
+	return "MTC Full Message"
+}
+
+// MarshalMIDI marshalls an MTCFullMessage MIDI message into its raw bytes
+func (mfm MTCFullMessage) MarshalMIDI() ([]byte, error) {
+	if err := validateTimeCode(mfm.Rate, mfm.Hours, mfm.Minutes, mfm.Seconds, mfm.Frames); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, ErrMarshallingMessage)
+	}
+	return marshalUniversalMessage(UniversalRealTimeManufacturerID, mfm.DeviceID, []byte{MIDITimeCodeSubID, MTCFullMessageSubID}, []byte{
+		makeTimeCodeHoursByte(mfm.Rate, mfm.Hours),
+		byte(mfm.Minutes),
+		byte(mfm.Seconds),
+		byte(mfm.Frames),
+	})
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (mfm *MTCFullMessage) String() string {
+	return fmt.Sprintf(MTCFullMessageStringFormat, MessageVersion, mfm.GetMessageName(), mfm.DeviceID, mfm.Rate, mfm.Hours, mfm.Minutes, mfm.Seconds, mfm.Frames)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into an MTCFullMessage struct pointer. MTC Full Messages are represented by ten
+// bytes (left to right): 0xF0, 0x7F, device ID, 0x01, 0x01, rate and hours (0rrhhhhh), minutes, seconds, frames, 0xF7.
+//
+// Example: []byte{0xF0, 0x7F, 0x7F, 0x01, 0x01, 0x61, 0x02, 0x03, 0x04, 0xF7}
+//
+// The example forms an MTC Full Message for every device at 01:02:03:04 and 30 frames per second.
+func (mfm *MTCFullMessage) UnmarshalMIDI(b []byte) error {
+	deviceID, data, err := unmarshalUniversalMessage("MTC full", UniversalRealTimeManufacturerID, []byte{MIDITimeCodeSubID, MTCFullMessageSubID}, 4, b)
+	if err != nil {
+		return err
+	}
+
+	rate, hours := parseTimeCodeHoursByte(data[0])
+	message := MTCFullMessage{
+		DeviceID: deviceID,
+		Rate:     rate,
+		Hours:    hours,
+		Minutes:  int(data[1]),
+		Seconds:  int(data[2]),
+		Frames:   int(data[3]),
+	}
+	if err := validateTimeCode(message.Rate, message.Hours, message.Minutes, message.Seconds, message.Frames); err != nil {
+		return fmt.Errorf("%v: %w", err, ErrUnmarshallingMessage)
+	}
+	*mfm = message
+	return nil
+}
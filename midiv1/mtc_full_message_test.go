@@ -0,0 +1,83 @@
+package midiv1
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_MTCFullMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  MTCFullMessage
+		expected []byte
+		err      error
+	}{
+		"frames are out of range for the rate": {
+			message: MTCFullMessage{Rate: TimeCodeRate25, Frames: 25},
+			err:     ErrMarshallingMessage,
+		},
+		"dropped frame number": {
+			message: MTCFullMessage{Rate: TimeCodeRate2997DropFrame, Minutes: 1, Frames: 0},
+			err:     ErrMarshallingMessage,
+		},
+		"message marshalls into expected bytes": {
+			message:  MTCFullMessage{DeviceID: AllCallDeviceID, Rate: TimeCodeRate30, Hours: 1, Minutes: 2, Seconds: 3, Frames: 4},
+			expected: []byte{0xF0, 0x7F, 0x7F, 0x01, 0x01, 0x61, 0x02, 0x03, 0x04, 0xF7},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_MTCFullMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage MTCFullMessage
+		err             error
+	}{
+		"frames byte is missing": {
+			b:   []byte{0xF0, 0x7F, 0x7F, 0x01, 0x01, 0x61, 0x02, 0x03, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"minutes are out of range": {
+			b:   []byte{0xF0, 0x7F, 0x7F, 0x01, 0x01, 0x61, 0x3C, 0x03, 0x04, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b:               []byte{0xF0, 0x7F, 0x7F, 0x01, 0x01, 0x4A, 0x0A, 0x00, 0x02, 0xF7},
+			expectedMessage: MTCFullMessage{DeviceID: AllCallDeviceID, Rate: TimeCodeRate2997DropFrame, Hours: 10, Minutes: 10, Seconds: 0, Frames: 2},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got MTCFullMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
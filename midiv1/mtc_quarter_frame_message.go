@@ -0,0 +1,111 @@
+package midiv1
+
+import "fmt"
+
+const (
+	// MTCQuarterFrameMessageStatus represents the status byte of an MTC Quarter Frame message.
+	MTCQuarterFrameMessageStatus byte = 0xF1
+
+	// MTCQuarterFrameMessageLength represents the number of bytes in a full MTC Quarter Frame message.
+	MTCQuarterFrameMessageLength int = 2
+
+	// MTCQuarterFrameMessageStringFormat represents the printf-compatible format specifically for an MTC Quarter Frame message string.
+	MTCQuarterFrameMessageStringFormat string = "%s:%s:%d:%d"
+
+	// MaxMTCQuarterFrameValue represents the highest value nibble of an MTC Quarter Frame message.
+	MaxMTCQuarterFrameValue byte = 0x0F
+)
+
+// MTCQuarterFramePiece represents which part of the time code an MTC Quarter Frame message carries. Eight quarter
+// frames, sent in piece order over two frames, make up a complete time code.
+type MTCQuarterFramePiece byte
+
+const (
+	// FramesLSBPiece carries the low nibble of the frames.
+	FramesLSBPiece MTCQuarterFramePiece = 0
+
+	// FramesMSBPiece carries the high bit of the frames.
+	FramesMSBPiece MTCQuarterFramePiece = 1
+
+	// SecondsLSBPiece carries the low nibble of the seconds.
+	SecondsLSBPiece MTCQuarterFramePiece = 2
+
+	// SecondsMSBPiece carries the high bits of the seconds.
+	SecondsMSBPiece MTCQuarterFramePiece = 3
+
+	// MinutesLSBPiece carries the low nibble of the minutes.
+	MinutesLSBPiece MTCQuarterFramePiece = 4
+
+	// MinutesMSBPiece carries the high bits of the minutes.
+	MinutesMSBPiece MTCQuarterFramePiece = 5
+
+	// HoursLSBPiece carries the low nibble of the hours.
+	HoursLSBPiece MTCQuarterFramePiece = 6
+
+	// RateAndHoursMSBPiece carries the high bit of the hours along with the time code rate.
+	RateAndHoursMSBPiece MTCQuarterFramePiece = 7
+
+	// MaxMTCQuarterFramePiece represents the highest MTC Quarter Frame piece.
+	MaxMTCQuarterFramePiece MTCQuarterFramePiece = RateAndHoursMSBPiece
+)
+
+// MTCQuarterFrameMessage represents an MTC Quarter Frame System Common message, which carries one eighth of a MIDI
+// Time Code position.
+type MTCQuarterFrameMessage struct {
+	// Piece represents which part of the time code the message carries.
+	Piece MTCQuarterFramePiece
+
+	// Value represents the nibble of the time code carried by the message, between 0 and 15 inclusive.
+	Value byte
+}
+
+// GetMessageName returns the name of this MTC Quarter Frame message.
+func (mqfm *MTCQuarterFrameMessage) GetMessageName() string {
+	return "MTC Quarter Frame"
+}
+
+// MarshalMIDI marshalls an MTCQuarterFrameMessage MIDI message into its raw bytes
+func (mqfm MTCQuarterFrameMessage) MarshalMIDI() ([]byte, error) {
+	if mqfm.Piece > MaxMTCQuarterFramePiece {
+		return nil, fmt.Errorf("MTC quarter frame pieces must be between 0 and %d, inclusive, received %d: %w", MaxMTCQuarterFramePiece, mqfm.Piece, ErrMarshallingMessage)
+	}
+	if mqfm.Value > MaxMTCQuarterFrameValue {
+		return nil, fmt.Errorf("MTC quarter frame values must be between 0 and %d, inclusive, received %d: %w", MaxMTCQuarterFrameValue, mqfm.Value, ErrMarshallingMessage)
+	}
+	return []byte{
+		MTCQuarterFrameMessageStatus,
+		byte(mqfm.Piece)<<4 | mqfm.Value,
+	}, nil
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (mqfm *MTCQuarterFrameMessage) String() string {
+	return fmt.Sprintf(MTCQuarterFrameMessageStringFormat, MessageVersion, mqfm.GetMessageName(), mqfm.Piece, mqfm.Value)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into an MTCQuarterFrameMessage struct pointer. MTC Quarter Frame messages are
+// represented by two bytes (left to right): 0xF1, piece and value (0pppvvvv).
+//
+// Example: []byte{0xF1, 0b00110010}
+//
+// The example forms an MTC Quarter Frame message carrying the seconds MSB piece (3) with the value 2.
+func (mqfm *MTCQuarterFrameMessage) UnmarshalMIDI(b []byte) error {
+	// check the number of bytes in the message
+	if len(b) != MTCQuarterFrameMessageLength {
+		return fmt.Errorf("MTC quarter frame messages are made up of %d bytes, received %d byte(s): %w", MTCQuarterFrameMessageLength, len(b), ErrUnmarshallingMessage)
+	}
+
+	// make sure this is the proper status byte followed by a data byte
+	if b[0] != MTCQuarterFrameMessageStatus {
+		return fmt.Errorf("MTC quarter frame messages must have status byte %#x, received %#x: %w", MTCQuarterFrameMessageStatus, b[0], ErrUnmarshallingMessage)
+	}
+	if ByteHasStatusMSB(b[1]) {
+		return fmt.Errorf("MTC quarter frame messages must have a data byte, received %#x: %w", b[1], ErrUnmarshallingMessage)
+	}
+
+	*mqfm = MTCQuarterFrameMessage{
+		Piece: MTCQuarterFramePiece(b[1] >> 4),
+		Value: b[1] & MaxMTCQuarterFrameValue,
+	}
+	return nil
+}
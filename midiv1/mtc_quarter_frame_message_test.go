@@ -0,0 +1,96 @@
+package midiv1
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_MTCQuarterFrameMessage_GetMessageName(t *testing.T) {
+	t.Parallel()
+	message := MTCQuarterFrameMessage{}
+	expected := "MTC Quarter Frame"
+	if message.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.GetMessageName())
+	}
+}
+
+func Test_MTCQuarterFrameMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  MTCQuarterFrameMessage
+		expected []byte
+		err      error
+	}{
+		"piece is out of range": {
+			message: MTCQuarterFrameMessage{Piece: 8},
+			err:     ErrMarshallingMessage,
+		},
+		"value is out of range": {
+			message: MTCQuarterFrameMessage{Value: 0x10},
+			err:     ErrMarshallingMessage,
+		},
+		"message marshalls into expected bytes": {
+			message:  MTCQuarterFrameMessage{Piece: RateAndHoursMSBPiece, Value: 0x06},
+			expected: []byte{0xF1, 0x76},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_MTCQuarterFrameMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage MTCQuarterFrameMessage
+		err             error
+	}{
+		"byte slice is not proper length": {
+			b:   []byte{0xF1},
+			err: ErrUnmarshallingMessage,
+		},
+		"status byte is not MTC quarter frame": {
+			b:   []byte{0xF3, 0x32},
+			err: ErrUnmarshallingMessage,
+		},
+		"data byte has a status MSB": {
+			b:   []byte{0xF1, 0xB2},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b:               []byte{0xF1, 0x32},
+			expectedMessage: MTCQuarterFrameMessage{Piece: SecondsMSBPiece, Value: 0x02},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got MTCQuarterFrameMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
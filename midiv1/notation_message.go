@@ -0,0 +1,250 @@
+package midiv1
+
+import "fmt"
+
+const (
+	// BarMarkerMessageStringFormat represents the printf-compatible format specifically for a Bar Marker message string.
+	BarMarkerMessageStringFormat string = "%s:%s:%d:%d"
+
+	// TimeSignatureNotationMessageStringFormat represents the printf-compatible format specifically for a Time Signature
+	// notation message string.
+	TimeSignatureNotationMessageStringFormat string = "%s:%s:%d:%t:%d/%d:%d:%d:%v"
+
+	// MinBar represents the lowest bar number a Bar Marker message can carry. Negative bars are count-in bars.
+	MinBar int = -8191
+
+	// MaxBar represents the highest bar number a Bar Marker message can carry.
+	MaxBar int = 8190
+
+	// BarMarkerNotRunning represents the Bar Marker value sent when playback is stopped.
+	BarMarkerNotRunning int = -8192
+
+	// BarMarkerRunningUnknown represents the Bar Marker value sent when playback is running but the bar is unknown.
+	BarMarkerRunningUnknown int = 8191
+
+	// MaxTimeSignatureNotationDenominator represents the highest time signature denominator a Time Signature message can carry.
+	MaxTimeSignatureNotationDenominator int = 64
+
+	// maxDataByte represents the highest value a single data byte can carry.
+	maxDataByte byte = 0x7F
+
+	// timeSignatureNotationMinLength represents the number of data bytes in a Time Signature message without additional fractions.
+	timeSignatureNotationMinLength int = 5
+)
+
+// BarMarkerMessage represents a Bar Marker Universal Real-Time System Exclusive message, which announces the bar that
+// is about to start.
+type BarMarkerMessage struct {
+	// DeviceID represents the device that the message is intended for, or AllCallDeviceID for every device.
+	DeviceID DeviceID
+
+	// Bar represents the bar number, between MinBar and MaxBar inclusive, or one of BarMarkerNotRunning and
+	// BarMarkerRunningUnknown.
+	Bar int
+}
+
+// GetMessageName returns the name of this Bar Marker message.
+func (bmm *BarMarkerMessage) GetMessageName() string {
+	return "Bar Marker"
+}
+
+// MarshalMIDI marshalls a BarMarkerMessage MIDI message into its raw bytes
+func (bmm BarMarkerMessage) MarshalMIDI() ([]byte, error) {
+	if bmm.Bar < BarMarkerNotRunning || bmm.Bar > BarMarkerRunningUnknown {
+		return nil, fmt.Errorf("bar numbers must be between %d and %d, inclusive, received %d: %w", BarMarkerNotRunning, BarMarkerRunningUnknown, bmm.Bar, ErrMarshallingMessage)
+	}
+	value := uint32(bmm.Bar) & uint32(MaxFourteenBitValue)
+	return marshalUniversalMessage(UniversalRealTimeManufacturerID, bmm.DeviceID, []byte{NotationInformationSubID, BarMarkerSubID}, appendSevenBitValue(nil, value, 2))
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (bmm *BarMarkerMessage) String() string {
+	return fmt.Sprintf(BarMarkerMessageStringFormat, MessageVersion, bmm.GetMessageName(), bmm.DeviceID, bmm.Bar)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a BarMarkerMessage struct pointer. Bar Marker messages are represented by
+// eight bytes (left to right): 0xF0, 0x7F, device ID, 0x03, 0x01, bar LSB, bar MSB, 0xF7. The bar is a signed 14-bit
+// (two's complement) value.
+//
+// Example: []byte{0xF0, 0x7F, 0x7F, 0x03, 0x01, 0x05, 0x00, 0xF7}
+//
+// The example forms a Bar Marker message for every device announcing bar 5.
+func (bmm *BarMarkerMessage) UnmarshalMIDI(b []byte) error {
+	deviceID, data, err := unmarshalUniversalMessage("bar marker", UniversalRealTimeManufacturerID, []byte{NotationInformationSubID, BarMarkerSubID}, 2, b)
+	if err != nil {
+		return err
+	}
+
+	bar := int(parseSevenBitValue(data))
+	if bar > BarMarkerRunningUnknown {
+		bar -= int(MaxFourteenBitValue) + 1
+	}
+	*bmm = BarMarkerMessage{
+		DeviceID: deviceID,
+		Bar:      bar,
+	}
+	return nil
+}
+
+// TimeSignatureFraction represents one additional fraction of a compound time signature, such as the 3/8 of 2/4+3/8.
+type TimeSignatureFraction struct {
+	// Numerator represents the number of beats.
+	Numerator int
+
+	// Denominator represents the note value of a beat, as a power of two.
+	Denominator int
+}
+
+// TimeSignatureNotationMessage represents a Time Signature Universal Real-Time System Exclusive message, which announces
+// a change of time signature either immediately or at the next bar line.
+type TimeSignatureNotationMessage struct {
+	// DeviceID represents the device that the message is intended for, or AllCallDeviceID for every device.
+	DeviceID DeviceID
+
+	// Delayed represents whether the time signature takes effect at the next bar line rather than immediately.
+	Delayed bool
+
+	// Numerator represents the number of beats in a bar.
+	Numerator int
+
+	// Denominator represents the note value of a beat, as a power of two (4 for a quarter note).
+	Denominator int
+
+	// ClocksPerClick represents the number of MIDI clocks in a metronome click.
+	ClocksPerClick int
+
+	// ThirtySecondNotesPerQuarterNote represents the number of notated 32nd notes in a MIDI quarter note (24 MIDI clocks).
+	ThirtySecondNotesPerQuarterNote int
+
+	// Additional represents the fractions that follow the first one in a compound time signature.
+	Additional []TimeSignatureFraction
+}
+
+// NewTimeSignatureNotationMessage returns a TimeSignatureNotationMessage for the supplied device with the supplied
+// numerator and denominator that takes effect immediately, using the standard 24 MIDI clocks per click and 8 32nd
+// notes per quarter note.
+func NewTimeSignatureNotationMessage(deviceID DeviceID, numerator int, denominator int) TimeSignatureNotationMessage {
+	return TimeSignatureNotationMessage{
+		DeviceID:                        deviceID,
+		Numerator:                       numerator,
+		Denominator:                     denominator,
+		ClocksPerClick:                  24,
+		ThirtySecondNotesPerQuarterNote: 8,
+	}
+}
+
+// GetMessageName returns the name of this Time Signature message.
+func (tsnm *TimeSignatureNotationMessage) GetMessageName() string {
+	return "Time Signature"
+}
+
+// MarshalMIDI marshalls a TimeSignatureNotationMessage MIDI message into its raw bytes
+func (tsnm TimeSignatureNotationMessage) MarshalMIDI() ([]byte, error) {
+	fractions := append([]TimeSignatureFraction{{Numerator: tsnm.Numerator, Denominator: tsnm.Denominator}}, tsnm.Additional...)
+	length := timeSignatureNotationMinLength - 1 + 2*len(tsnm.Additional)
+	if length > int(maxDataByte) {
+		return nil, fmt.Errorf("time signatures can carry at most %d additional fractions, received %d: %w", (int(maxDataByte)-timeSignatureNotationMinLength+1)/2, len(tsnm.Additional), ErrMarshallingMessage)
+	}
+	if tsnm.ClocksPerClick < 0 || tsnm.ClocksPerClick > int(maxDataByte) || tsnm.ThirtySecondNotesPerQuarterNote < 0 || tsnm.ThirtySecondNotesPerQuarterNote > int(maxDataByte) {
+		return nil, fmt.Errorf("clocks per click and 32nd notes per quarter note must be between 0 and %d, inclusive: %w", maxDataByte, ErrMarshallingMessage)
+	}
+
+	encoded := make([][2]byte, 0, len(fractions))
+	for _, fraction := range fractions {
+		exponent, err := encodeTimeSignatureFraction(fraction)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", err, ErrMarshallingMessage)
+		}
+		encoded = append(encoded, [2]byte{byte(fraction.Numerator), exponent})
+	}
+
+	data := make([]byte, 0, length+1)
+	data = append(data, byte(length), encoded[0][0], encoded[0][1], byte(tsnm.ClocksPerClick), byte(tsnm.ThirtySecondNotesPerQuarterNote))
+	for _, fraction := range encoded[1:] {
+		data = append(data, fraction[0], fraction[1])
+	}
+	return marshalUniversalMessage(UniversalRealTimeManufacturerID, tsnm.DeviceID, []byte{NotationInformationSubID, tsnm.subID()}, data)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (tsnm *TimeSignatureNotationMessage) String() string {
+	return fmt.Sprintf(TimeSignatureNotationMessageStringFormat, MessageVersion, tsnm.GetMessageName(), tsnm.DeviceID, tsnm.Delayed, tsnm.Numerator, tsnm.Denominator, tsnm.ClocksPerClick, tsnm.ThirtySecondNotesPerQuarterNote, tsnm.Additional)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a TimeSignatureNotationMessage struct pointer. Time Signature messages are
+// represented by (left to right): 0xF0, 0x7F, device ID, 0x03, 0x02 (immediate) or 0x42 (delayed), number of bytes
+// that follow, numerator, denominator (as a power of two), MIDI clocks per click, 32nd notes per quarter note, any
+// number of additional numerator and denominator pairs, 0xF7.
+//
+// Example: []byte{0xF0, 0x7F, 0x7F, 0x03, 0x02, 0x04, 0x06, 0x03, 0x24, 0x08, 0xF7}
+//
+// The example forms a Time Signature message for every device changing to 6/8 immediately, clicking every dotted quarter note.
+func (tsnm *TimeSignatureNotationMessage) UnmarshalMIDI(b []byte) error {
+	// the sub-ID #2 decides whether the change is delayed, so read it before validating the rest of the message
+	delayed := len(b) > universalMessagePrefixLength+1 && b[universalMessagePrefixLength+1] == TimeSignatureDelayedSubID
+	subID := TimeSignatureImmediateSubID
+	if delayed {
+		subID = TimeSignatureDelayedSubID
+	}
+	deviceID, data, err := unmarshalUniversalMessage("time signature", UniversalRealTimeManufacturerID, []byte{NotationInformationSubID, subID}, -1, b)
+	if err != nil {
+		return err
+	}
+	if len(data) < timeSignatureNotationMinLength || (len(data)-timeSignatureNotationMinLength)%2 != 0 {
+		return fmt.Errorf("time signature messages are made up of %d data bytes plus pairs of additional fraction bytes after their sub-IDs, received %d byte(s): %w", timeSignatureNotationMinLength, len(data), ErrUnmarshallingMessage)
+	}
+	if int(data[0]) != len(data)-1 {
+		return fmt.Errorf("time signature message length byte %d does not match the %d byte(s) that follow it: %w", data[0], len(data)-1, ErrUnmarshallingMessage)
+	}
+
+	message := TimeSignatureNotationMessage{
+		DeviceID:                        deviceID,
+		Delayed:                         delayed,
+		Numerator:                       int(data[1]),
+		Denominator:                     decodeTimeSignatureDenominator(data[2]),
+		ClocksPerClick:                  int(data[3]),
+		ThirtySecondNotesPerQuarterNote: int(data[4]),
+	}
+	for i := timeSignatureNotationMinLength; i < len(data); i += 2 {
+		message.Additional = append(message.Additional, TimeSignatureFraction{
+			Numerator:   int(data[i]),
+			Denominator: decodeTimeSignatureDenominator(data[i+1]),
+		})
+	}
+	for _, fraction := range append([]TimeSignatureFraction{{Numerator: message.Numerator, Denominator: message.Denominator}}, message.Additional...) {
+		if _, err := encodeTimeSignatureFraction(fraction); err != nil {
+			return fmt.Errorf("%v: %w", err, ErrUnmarshallingMessage)
+		}
+	}
+	*tsnm = message
+	return nil
+}
+
+// subID returns the sub-ID #2 of the Time Signature message.
+func (tsnm TimeSignatureNotationMessage) subID() byte {
+	if tsnm.Delayed {
+		return TimeSignatureDelayedSubID
+	}
+	return TimeSignatureImmediateSubID
+}
+
+// encodeTimeSignatureFraction validates the fraction and returns its denominator as a power of two exponent.
+func encodeTimeSignatureFraction(fraction TimeSignatureFraction) (byte, error) {
+	if fraction.Numerator < 1 || fraction.Numerator > int(maxDataByte) {
+		return 0, fmt.Errorf("time signature numerators must be between 1 and %d, inclusive, received %d", maxDataByte, fraction.Numerator)
+	}
+	for exponent := 0; 1<<exponent <= MaxTimeSignatureNotationDenominator; exponent++ {
+		if 1<<exponent == fraction.Denominator {
+			return byte(exponent), nil
+		}
+	}
+	return 0, fmt.Errorf("time signature denominators must be a power of two between 1 and %d, inclusive, received %d", MaxTimeSignatureNotationDenominator, fraction.Denominator)
+}
+
+// decodeTimeSignatureDenominator returns the denominator for the power of two exponent, or 0 if the exponent is too large.
+func decodeTimeSignatureDenominator(exponent byte) int {
+	if 1<<exponent > MaxTimeSignatureNotationDenominator {
+		return 0
+	}
+	return 1 << exponent
+}
@@ -0,0 +1,194 @@
+package midiv1
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_BarMarkerMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  BarMarkerMessage
+		expected []byte
+		err      error
+	}{
+		"bar is out of range": {
+			message: BarMarkerMessage{Bar: 8192},
+			err:     ErrMarshallingMessage,
+		},
+		"positive bar": {
+			message:  BarMarkerMessage{DeviceID: AllCallDeviceID, Bar: 200},
+			expected: []byte{0xF0, 0x7F, 0x7F, 0x03, 0x01, 0x48, 0x01, 0xF7},
+		},
+		"count-in bar": {
+			message:  BarMarkerMessage{DeviceID: AllCallDeviceID, Bar: -1},
+			expected: []byte{0xF0, 0x7F, 0x7F, 0x03, 0x01, 0x7F, 0x7F, 0xF7},
+		},
+		"not running": {
+			message:  BarMarkerMessage{DeviceID: AllCallDeviceID, Bar: BarMarkerNotRunning},
+			expected: []byte{0xF0, 0x7F, 0x7F, 0x03, 0x01, 0x00, 0x40, 0xF7},
+		},
+		"running with an unknown bar": {
+			message:  BarMarkerMessage{DeviceID: AllCallDeviceID, Bar: BarMarkerRunningUnknown},
+			expected: []byte{0xF0, 0x7F, 0x7F, 0x03, 0x01, 0x7F, 0x3F, 0xF7},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_BarMarkerMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage BarMarkerMessage
+		err             error
+	}{
+		"bar MSB is missing": {
+			b:   []byte{0xF0, 0x7F, 0x7F, 0x03, 0x01, 0x05, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"positive bar": {
+			b:               []byte{0xF0, 0x7F, 0x7F, 0x03, 0x01, 0x05, 0x00, 0xF7},
+			expectedMessage: BarMarkerMessage{DeviceID: AllCallDeviceID, Bar: 5},
+		},
+		"count-in bar": {
+			b:               []byte{0xF0, 0x7F, 0x7F, 0x03, 0x01, 0x7E, 0x7F, 0xF7},
+			expectedMessage: BarMarkerMessage{DeviceID: AllCallDeviceID, Bar: -2},
+		},
+		"not running": {
+			b:               []byte{0xF0, 0x7F, 0x7F, 0x03, 0x01, 0x00, 0x40, 0xF7},
+			expectedMessage: BarMarkerMessage{DeviceID: AllCallDeviceID, Bar: BarMarkerNotRunning},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got BarMarkerMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
+
+func Test_TimeSignatureNotationMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  TimeSignatureNotationMessage
+		expected []byte
+		err      error
+	}{
+		"denominator is not a power of two": {
+			message: NewTimeSignatureNotationMessage(AllCallDeviceID, 3, 6),
+			err:     ErrMarshallingMessage,
+		},
+		"numerator is zero": {
+			message: NewTimeSignatureNotationMessage(AllCallDeviceID, 0, 4),
+			err:     ErrMarshallingMessage,
+		},
+		"immediate time signature": {
+			message:  NewTimeSignatureNotationMessage(AllCallDeviceID, 4, 4),
+			expected: []byte{0xF0, 0x7F, 0x7F, 0x03, 0x02, 0x04, 0x04, 0x02, 0x18, 0x08, 0xF7},
+		},
+		"delayed compound time signature": {
+			message: TimeSignatureNotationMessage{
+				DeviceID:                        0x01,
+				Delayed:                         true,
+				Numerator:                       2,
+				Denominator:                     4,
+				ClocksPerClick:                  24,
+				ThirtySecondNotesPerQuarterNote: 8,
+				Additional:                      []TimeSignatureFraction{{Numerator: 3, Denominator: 8}},
+			},
+			expected: []byte{0xF0, 0x7F, 0x01, 0x03, 0x42, 0x06, 0x02, 0x02, 0x18, 0x08, 0x03, 0x03, 0xF7},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_TimeSignatureNotationMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage TimeSignatureNotationMessage
+		err             error
+	}{
+		"length byte does not match": {
+			b:   []byte{0xF0, 0x7F, 0x7F, 0x03, 0x02, 0x06, 0x06, 0x03, 0x24, 0x08, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"additional fraction is incomplete": {
+			b:   []byte{0xF0, 0x7F, 0x7F, 0x03, 0x02, 0x05, 0x06, 0x03, 0x24, 0x08, 0x03, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"immediate time signature": {
+			b:               []byte{0xF0, 0x7F, 0x7F, 0x03, 0x02, 0x04, 0x06, 0x03, 0x24, 0x08, 0xF7},
+			expectedMessage: TimeSignatureNotationMessage{DeviceID: AllCallDeviceID, Numerator: 6, Denominator: 8, ClocksPerClick: 36, ThirtySecondNotesPerQuarterNote: 8},
+		},
+		"delayed compound time signature": {
+			b: []byte{0xF0, 0x7F, 0x01, 0x03, 0x42, 0x06, 0x02, 0x02, 0x18, 0x08, 0x03, 0x03, 0xF7},
+			expectedMessage: TimeSignatureNotationMessage{
+				DeviceID:                        0x01,
+				Delayed:                         true,
+				Numerator:                       2,
+				Denominator:                     4,
+				ClocksPerClick:                  24,
+				ThirtySecondNotesPerQuarterNote: 8,
+				Additional:                      []TimeSignatureFraction{{Numerator: 3, Denominator: 8}},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got TimeSignatureNotationMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
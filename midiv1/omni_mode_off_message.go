@@ -0,0 +1,72 @@
+package midiv1
+
+import "fmt"
+
+// OmniModeOffMessage represents a Omni Mode Off Channel Mode message, which makes the receiver respond only to its basic channel. It also causes All Notes Off.
+type OmniModeOffMessage struct {
+	// Channel represents the channel number where this message will be sent.
+	Channel Channel
+}
+
+// GetMessageName returns the name of this Omni Mode Off message.
+func (omom *OmniModeOffMessage) GetMessageName() string {
+	return "Omni Mode Off"
+}
+
+// MarshalMIDI marshalls a OmniModeOffMessage MIDI message into its raw bytes
+func (omom OmniModeOffMessage) MarshalMIDI() ([]byte, error) {
+	return marshalChannelModeMessage(omom.Channel, OmniModeOffController, 0), nil
+}
+
+// MarshalRunningStatusMIDI marshalls a running status MIDI message into its raw bytes.
+func (omom OmniModeOffMessage) MarshalRunningStatusMIDI() ([]byte, error) {
+	return []byte{
+		byte(OmniModeOffController),
+		0,
+	}, nil
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (omom *OmniModeOffMessage) String() string {
+	return fmt.Sprintf(ChannelModeMessageStringFormat, MessageVersion, omom.GetMessageName(), omom.Channel)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a OmniModeOffMessage struct pointer. Omni Mode Off messages are
+// represented by three bytes (left to right): Control Change status/channel, controller number 124, value 0.
+//
+// Example: []byte{0b10110001, 0b01111100, 0b00000000}
+//
+// The example forms a Omni Mode Off message for channel 2 (index 1).
+func (omom *OmniModeOffMessage) UnmarshalMIDI(b []byte) error {
+	channel, value, err := unmarshalChannelModeMessage("omni mode off", OmniModeOffController, b)
+	if err != nil {
+		return err
+	}
+	if err := requireZeroChannelModeValue("omni mode off", value); err != nil {
+		return err
+	}
+
+	*omom = OmniModeOffMessage{
+		Channel: channel,
+	}
+	return nil
+}
+
+// UnmarshalRunningStatusMIDI unmarshalls raw bytes into a OmniModeOffMessage struct pointer. Omni Mode Off running status messages are
+// represented by two bytes (left to right): controller number 124, value 0.
+//
+// Example: []byte{0b01111100, 0b00000000}
+//
+// The example forms a Omni Mode Off running status message.
+func (omom *OmniModeOffMessage) UnmarshalRunningStatusMIDI(b []byte) error {
+	value, err := unmarshalRunningStatusChannelModeMessage("omni mode off", OmniModeOffController, b)
+	if err != nil {
+		return err
+	}
+	if err := requireZeroChannelModeValue("omni mode off", value); err != nil {
+		return err
+	}
+
+	*omom = OmniModeOffMessage{}
+	return nil
+}
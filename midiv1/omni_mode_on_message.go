@@ -0,0 +1,72 @@
+package midiv1
+
+import "fmt"
+
+// OmniModeOnMessage represents a Omni Mode On Channel Mode message, which makes the receiver respond to messages on every channel. It also causes All Notes Off.
+type OmniModeOnMessage struct {
+	// Channel represents the channel number where this message will be sent.
+	Channel Channel
+}
+
+// GetMessageName returns the name of this Omni Mode On message.
+func (onmm *OmniModeOnMessage) GetMessageName() string {
+	return "Omni Mode On"
+}
+
+// MarshalMIDI marshalls a OmniModeOnMessage MIDI message into its raw bytes
+func (onmm OmniModeOnMessage) MarshalMIDI() ([]byte, error) {
+	return marshalChannelModeMessage(onmm.Channel, OmniModeOnController, 0), nil
+}
+
+// MarshalRunningStatusMIDI marshalls a running status MIDI message into its raw bytes.
+func (onmm OmniModeOnMessage) MarshalRunningStatusMIDI() ([]byte, error) {
+	return []byte{
+		byte(OmniModeOnController),
+		0,
+	}, nil
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (onmm *OmniModeOnMessage) String() string {
+	return fmt.Sprintf(ChannelModeMessageStringFormat, MessageVersion, onmm.GetMessageName(), onmm.Channel)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a OmniModeOnMessage struct pointer. Omni Mode On messages are
+// represented by three bytes (left to right): Control Change status/channel, controller number 125, value 0.
+//
+// Example: []byte{0b10110001, 0b01111101, 0b00000000}
+//
+// The example forms a Omni Mode On message for channel 2 (index 1).
+func (onmm *OmniModeOnMessage) UnmarshalMIDI(b []byte) error {
+	channel, value, err := unmarshalChannelModeMessage("omni mode on", OmniModeOnController, b)
+	if err != nil {
+		return err
+	}
+	if err := requireZeroChannelModeValue("omni mode on", value); err != nil {
+		return err
+	}
+
+	*onmm = OmniModeOnMessage{
+		Channel: channel,
+	}
+	return nil
+}
+
+// UnmarshalRunningStatusMIDI unmarshalls raw bytes into a OmniModeOnMessage struct pointer. Omni Mode On running status messages are
+// represented by two bytes (left to right): controller number 125, value 0.
+//
+// Example: []byte{0b01111101, 0b00000000}
+//
+// The example forms a Omni Mode On running status message.
+func (onmm *OmniModeOnMessage) UnmarshalRunningStatusMIDI(b []byte) error {
+	value, err := unmarshalRunningStatusChannelModeMessage("omni mode on", OmniModeOnController, b)
+	if err != nil {
+		return err
+	}
+	if err := requireZeroChannelModeValue("omni mode on", value); err != nil {
+		return err
+	}
+
+	*onmm = OmniModeOnMessage{}
+	return nil
+}
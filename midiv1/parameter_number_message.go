@@ -0,0 +1,412 @@
+package midiv1
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// ParameterNumberMessageStringFormat represents the printf-compatible format specifically for a Registered or
+	// Non-Registered Parameter Number message string.
+	ParameterNumberMessageStringFormat string = "%s:%s:%d:%s:%s:%d"
+)
+
+// RegisteredParameter represents the 14-bit parameter number of a Registered Parameter Number (RPN), as assigned by the
+// MIDI Manufacturers Association.
+type RegisteredParameter FourteenBitValue
+
+const (
+	// PitchBendSensitivityParameter represents the range of the Pitch Bend Change message, with semitones in the MSB and
+	// cents in the LSB.
+	PitchBendSensitivityParameter RegisteredParameter = 0x0000
+
+	// FineTuningParameter represents the channel fine tuning, from 100 cents flat (0x0000) through A440 (0x2000) to just
+	// under 100 cents sharp (0x3FFF).
+	FineTuningParameter RegisteredParameter = 0x0001
+
+	// CoarseTuningParameter represents the channel coarse tuning in semitones, with 64 in the MSB as A440.
+	CoarseTuningParameter RegisteredParameter = 0x0002
+
+	// TuningProgramSelectParameter represents the tuning program of the MIDI Tuning Standard, in the MSB.
+	TuningProgramSelectParameter RegisteredParameter = 0x0003
+
+	// TuningBankSelectParameter represents the tuning bank of the MIDI Tuning Standard, in the MSB.
+	TuningBankSelectParameter RegisteredParameter = 0x0004
+
+	// ModulationDepthRangeParameter represents the range of the modulation wheel, with semitones in the MSB and
+	// 100/128 cent steps in the LSB.
+	ModulationDepthRangeParameter RegisteredParameter = 0x0005
+
+	// MPEConfigurationParameter represents the MPE Configuration Message, which sets the number of member channels of an
+	// MPE zone in the MSB when it is sent on the manager channel of the zone.
+	MPEConfigurationParameter RegisteredParameter = 0x0006
+
+	// NullParameter represents the RPN Null parameter (0x7F, 0x7F), which deselects the current parameter so stray Data
+	// Entry messages are ignored.
+	NullParameter RegisteredParameter = 0x3FFF
+)
+
+// RegisteredParameterNames maps the registered parameters to their names.
+var RegisteredParameterNames = map[RegisteredParameter]string{
+	PitchBendSensitivityParameter: "Pitch Bend Sensitivity",
+	FineTuningParameter:           "Fine Tuning",
+	CoarseTuningParameter:         "Coarse Tuning",
+	TuningProgramSelectParameter:  "Tuning Program Select",
+	TuningBankSelectParameter:     "Tuning Bank Select",
+	ModulationDepthRangeParameter: "Modulation Depth Range",
+	MPEConfigurationParameter:     "MPE Configuration",
+	NullParameter:                 "Null",
+}
+
+// String returns the human-readable representation of the registered parameter.
+func (rp RegisteredParameter) String() string {
+	if name, ok := RegisteredParameterNames[rp]; ok {
+		return name
+	}
+	return fmt.Sprintf("RPN %#04x", uint16(rp))
+}
+
+// ParameterStep represents how a Registered or Non-Registered Parameter Number message changes the value of its parameter.
+type ParameterStep int
+
+const (
+	// SetParameterStep sets the parameter to the value with the Data Entry controllers (6 and 38).
+	SetParameterStep ParameterStep = iota
+
+	// IncrementParameterStep raises the parameter with the Data Increment controller (96).
+	IncrementParameterStep
+
+	// DecrementParameterStep lowers the parameter with the Data Decrement controller (97).
+	DecrementParameterStep
+)
+
+// String returns the human-readable representation of the parameter step.
+func (ps ParameterStep) String() string {
+	switch ps {
+	case IncrementParameterStep:
+		return "Increment"
+	case DecrementParameterStep:
+		return "Decrement"
+	}
+	return "Set"
+}
+
+// RegisteredParameterNumberMessage represents a Registered Parameter Number (RPN) change, which is sent as a sequence of
+// Control Change messages: the parameter number (101, 100), the data (6 and optionally 38, or 96 or 97) and usually the
+// RPN Null parameter (101, 100) to close the sequence.
+type RegisteredParameterNumberMessage struct {
+	// Channel represents the channel number where this message will be sent.
+	Channel Channel
+
+	// Parameter represents the registered parameter that changes.
+	Parameter RegisteredParameter
+
+	// Step represents whether the parameter is set, incremented or decremented.
+	Step ParameterStep
+
+	// Value represents the new 14-bit value of the parameter when it is set, or the data byte (normally 0) of the Data
+	// Increment or Data Decrement controller otherwise.
+	Value FourteenBitValue
+
+	// MSBOnly represents whether only the Data Entry MSB (6) is sent, for parameters that do not use the LSB.
+	MSBOnly bool
+
+	// SkipNull represents whether the closing RPN Null parameter is left out.
+	SkipNull bool
+}
+
+// NewPitchBendSensitivityMessage returns a RegisteredParameterNumberMessage that sets the Pitch Bend Change range of the
+// channel to the supplied semitones and cents.
+func NewPitchBendSensitivityMessage(channel Channel, semitones int, cents int) RegisteredParameterNumberMessage {
+	return RegisteredParameterNumberMessage{
+		Channel:   channel,
+		Parameter: PitchBendSensitivityParameter,
+		Value:     NewFourteenBitValueFromBytes(byte(semitones), byte(cents)),
+	}
+}
+
+// NewFineTuningMessage returns a RegisteredParameterNumberMessage that detunes the channel by the supplied number of
+// cents, between -100 and just under 100. Offsets beyond the range are clamped.
+func NewFineTuningMessage(channel Channel, cents float64) RegisteredParameterNumberMessage {
+	offset := math.Round(cents * float64(CenterFourteenBitValue) / 100)
+	return RegisteredParameterNumberMessage{
+		Channel:   channel,
+		Parameter: FineTuningParameter,
+		Value:     NewFourteenBitValue(int(CenterFourteenBitValue) + int(math.Max(math.Min(offset, float64(CenterFourteenBitValue)), -float64(CenterFourteenBitValue)))),
+	}
+}
+
+// NewCoarseTuningMessage returns a RegisteredParameterNumberMessage that transposes the channel by the supplied number
+// of semitones, between -64 and 63.
+func NewCoarseTuningMessage(channel Channel, semitones int) RegisteredParameterNumberMessage {
+	return RegisteredParameterNumberMessage{
+		Channel:   channel,
+		Parameter: CoarseTuningParameter,
+		Value:     NewFourteenBitValueFromBytes(byte(semitones+int(CenterControllerValue)), 0),
+		MSBOnly:   true,
+	}
+}
+
+// NewTuningProgramSelectMessage returns a RegisteredParameterNumberMessage that selects a MIDI Tuning Standard tuning
+// program for the channel.
+func NewTuningProgramSelectMessage(channel Channel, program int) RegisteredParameterNumberMessage {
+	return RegisteredParameterNumberMessage{
+		Channel:   channel,
+		Parameter: TuningProgramSelectParameter,
+		Value:     NewFourteenBitValueFromBytes(byte(program), 0),
+		MSBOnly:   true,
+	}
+}
+
+// NewTuningBankSelectMessage returns a RegisteredParameterNumberMessage that selects a MIDI Tuning Standard tuning bank
+// for the channel.
+func NewTuningBankSelectMessage(channel Channel, bank int) RegisteredParameterNumberMessage {
+	return RegisteredParameterNumberMessage{
+		Channel:   channel,
+		Parameter: TuningBankSelectParameter,
+		Value:     NewFourteenBitValueFromBytes(byte(bank), 0),
+		MSBOnly:   true,
+	}
+}
+
+// NewModulationDepthRangeMessage returns a RegisteredParameterNumberMessage that sets the range of the modulation wheel
+// of the channel to the supplied semitones and cents.
+func NewModulationDepthRangeMessage(channel Channel, semitones int, cents float64) RegisteredParameterNumberMessage {
+	return RegisteredParameterNumberMessage{
+		Channel:   channel,
+		Parameter: ModulationDepthRangeParameter,
+		Value:     NewFourteenBitValueFromBytes(byte(semitones), byte(math.Round(cents*128/100))),
+	}
+}
+
+// NewMPEConfigurationMessage returns a RegisteredParameterNumberMessage that sets the number of member channels of the
+// MPE zone whose manager channel is the supplied channel, where 0 member channels turns the zone off.
+func NewMPEConfigurationMessage(channel Channel, memberChannels int) RegisteredParameterNumberMessage {
+	return RegisteredParameterNumberMessage{
+		Channel:   channel,
+		Parameter: MPEConfigurationParameter,
+		Value:     NewFourteenBitValueFromBytes(byte(memberChannels), 0),
+		MSBOnly:   true,
+	}
+}
+
+// GetMessageName returns the name of this Registered Parameter Number message.
+func (rpnm *RegisteredParameterNumberMessage) GetMessageName() string {
+	return "Registered Parameter Number"
+}
+
+// ControlChanges returns the sequence of Control Change messages that carries the parameter change.
+func (rpnm RegisteredParameterNumberMessage) ControlChanges() ([]ControlChangeMessage, error) {
+	return parameterControlChanges(rpnm.Channel, RegisteredParameterNumberMSBController, RegisteredParameterNumberLSBController, FourteenBitValue(rpnm.Parameter), rpnm.Step, rpnm.Value, rpnm.MSBOnly, rpnm.SkipNull)
+}
+
+// MarshalMIDI marshalls a RegisteredParameterNumberMessage into the raw bytes of its Control Change messages, each with
+// its full status byte.
+func (rpnm RegisteredParameterNumberMessage) MarshalMIDI() ([]byte, error) {
+	ccms, err := rpnm.ControlChanges()
+	if err != nil {
+		return nil, err
+	}
+	return marshalControlChanges(ccms)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (rpnm *RegisteredParameterNumberMessage) String() string {
+	return fmt.Sprintf(ParameterNumberMessageStringFormat, MessageVersion, rpnm.GetMessageName(), rpnm.Channel, rpnm.Parameter, rpnm.Step, rpnm.Value)
+}
+
+// UnmarshalMIDI unmarshalls the raw bytes of a sequence of Control Change messages into a
+// RegisteredParameterNumberMessage struct pointer. Every Control Change message must have its full status byte.
+//
+// Example: []byte{0xB0, 0x65, 0x00, 0xB0, 0x64, 0x00, 0xB0, 0x06, 0x0C, 0xB0, 0x65, 0x7F, 0xB0, 0x64, 0x7F}
+//
+// The example forms a Registered Parameter Number message for channel 1 (index 0) that sets the pitch bend
+// sensitivity to 12 semitones without an LSB.
+func (rpnm *RegisteredParameterNumberMessage) UnmarshalMIDI(b []byte) error {
+	change, err := unmarshalParameterChange("registered parameter number", true, b)
+	if err != nil {
+		return err
+	}
+
+	*rpnm = RegisteredParameterNumberMessage{
+		Channel:   change.Channel,
+		Parameter: RegisteredParameter(change.Parameter),
+		Step:      change.Step,
+		Value:     change.Value,
+		MSBOnly:   change.msbOnly,
+		SkipNull:  !change.null,
+	}
+	return nil
+}
+
+// NonRegisteredParameterNumberMessage represents a Non-Registered Parameter Number (NRPN) change, whose parameters are
+// defined by each manufacturer. It is sent as a sequence of Control Change messages: the parameter number (99, 98),
+// the data (6 and optionally 38, or 96 or 97) and usually the RPN Null parameter (101, 100) to close the sequence.
+type NonRegisteredParameterNumberMessage struct {
+	// Channel represents the channel number where this message will be sent.
+	Channel Channel
+
+	// Parameter represents the 14-bit manufacturer-defined parameter number that changes.
+	Parameter FourteenBitValue
+
+	// Step represents whether the parameter is set, incremented or decremented.
+	Step ParameterStep
+
+	// Value represents the new 14-bit value of the parameter when it is set, or the data byte (normally 0) of the Data
+	// Increment or Data Decrement controller otherwise.
+	Value FourteenBitValue
+
+	// MSBOnly represents whether only the Data Entry MSB (6) is sent, for parameters that do not use the LSB.
+	MSBOnly bool
+
+	// SkipNull represents whether the closing RPN Null parameter is left out.
+	SkipNull bool
+}
+
+// GetMessageName returns the name of this Non-Registered Parameter Number message.
+func (nrpnm *NonRegisteredParameterNumberMessage) GetMessageName() string {
+	return "Non-Registered Parameter Number"
+}
+
+// ControlChanges returns the sequence of Control Change messages that carries the parameter change.
+func (nrpnm NonRegisteredParameterNumberMessage) ControlChanges() ([]ControlChangeMessage, error) {
+	return parameterControlChanges(nrpnm.Channel, NonRegisteredParameterNumberMSBController, NonRegisteredParameterNumberLSBController, nrpnm.Parameter, nrpnm.Step, nrpnm.Value, nrpnm.MSBOnly, nrpnm.SkipNull)
+}
+
+// MarshalMIDI marshalls a NonRegisteredParameterNumberMessage into the raw bytes of its Control Change messages, each
+// with its full status byte.
+func (nrpnm NonRegisteredParameterNumberMessage) MarshalMIDI() ([]byte, error) {
+	ccms, err := nrpnm.ControlChanges()
+	if err != nil {
+		return nil, err
+	}
+	return marshalControlChanges(ccms)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (nrpnm *NonRegisteredParameterNumberMessage) String() string {
+	return fmt.Sprintf(ParameterNumberMessageStringFormat, MessageVersion, nrpnm.GetMessageName(), nrpnm.Channel, fmt.Sprintf("NRPN %#04x", uint16(nrpnm.Parameter)), nrpnm.Step, nrpnm.Value)
+}
+
+// UnmarshalMIDI unmarshalls the raw bytes of a sequence of Control Change messages into a
+// NonRegisteredParameterNumberMessage struct pointer. Every Control Change message must have its full status byte.
+//
+// Example: []byte{0xB1, 0x63, 0x01, 0xB1, 0x62, 0x08, 0xB1, 0x06, 0x40, 0xB1, 0x26, 0x00, 0xB1, 0x65, 0x7F, 0xB1, 0x64, 0x7F}
+//
+// The example forms a Non-Registered Parameter Number message for channel 2 (index 1) that sets parameter 0x0088 to 0x2000.
+func (nrpnm *NonRegisteredParameterNumberMessage) UnmarshalMIDI(b []byte) error {
+	change, err := unmarshalParameterChange("non-registered parameter number", false, b)
+	if err != nil {
+		return err
+	}
+
+	*nrpnm = NonRegisteredParameterNumberMessage{
+		Channel:   change.Channel,
+		Parameter: change.Parameter,
+		Step:      change.Step,
+		Value:     change.Value,
+		MSBOnly:   change.msbOnly,
+		SkipNull:  !change.null,
+	}
+	return nil
+}
+
+// parameterControlChanges returns the sequence of Control Change messages that selects a parameter with the supplied
+// parameter number controllers and changes its value.
+func parameterControlChanges(channel Channel, msbController Controller, lsbController Controller, parameter FourteenBitValue, step ParameterStep, value FourteenBitValue, msbOnly bool, skipNull bool) ([]ControlChangeMessage, error) {
+	if parameter > MaxFourteenBitValue {
+		return nil, fmt.Errorf("parameter numbers must be between %d and %d, inclusive, received %d: %w", MinFourteenBitValue, MaxFourteenBitValue, parameter, ErrMarshallingMessage)
+	}
+	if value > MaxFourteenBitValue || (step != SetParameterStep && value > FourteenBitValue(MaxControllerValue)) {
+		return nil, fmt.Errorf("parameter value %d is out of range for a %s step: %w", value, step, ErrMarshallingMessage)
+	}
+
+	cc := func(controller Controller, value byte) ControlChangeMessage {
+		return ControlChangeMessage{
+			Channel:    channel,
+			Controller: controller,
+			Value:      ControllerValue(value),
+		}
+	}
+	ccms := []ControlChangeMessage{
+		cc(msbController, parameter.GetMSB()),
+		cc(lsbController, parameter.GetLSB()),
+	}
+	switch step {
+	case SetParameterStep:
+		ccms = append(ccms, cc(DataEntryMSBController, value.GetMSB()))
+		if !msbOnly {
+			ccms = append(ccms, cc(DataEntryLSBController, value.GetLSB()))
+		}
+	case IncrementParameterStep:
+		ccms = append(ccms, cc(DataIncrementController, byte(value)))
+	case DecrementParameterStep:
+		ccms = append(ccms, cc(DataDecrementController, byte(value)))
+	default:
+		return nil, fmt.Errorf("unknown parameter step %d: %w", step, ErrMarshallingMessage)
+	}
+	if !skipNull {
+		ccms = append(ccms,
+			cc(RegisteredParameterNumberMSBController, NullParameter.msb()),
+			cc(RegisteredParameterNumberLSBController, NullParameter.lsb()),
+		)
+	}
+	return ccms, nil
+}
+
+// marshalControlChanges marshalls a sequence of Control Change messages into their raw bytes.
+func marshalControlChanges(ccms []ControlChangeMessage) ([]byte, error) {
+	b := make([]byte, 0, len(ccms)*ControlChangeMessageLength)
+	for _, ccm := range ccms {
+		cb, err := ccm.MarshalMIDI()
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, cb...)
+	}
+	return b, nil
+}
+
+// unmarshalParameterChange folds the raw bytes of a sequence of Control Change messages into the last parameter change
+// they carry. The name is used to describe the message in any returned errors.
+func unmarshalParameterChange(name string, registered bool, b []byte) (ParameterChange, error) {
+	if len(b) == 0 || len(b)%ControlChangeMessageLength != 0 {
+		return ParameterChange{}, fmt.Errorf("%s messages are made up of whole %d-byte control change messages, received %d byte(s): %w", name, ControlChangeMessageLength, len(b), ErrUnmarshallingMessage)
+	}
+
+	parser := NewParameterParser()
+	var change ParameterChange
+	found := false
+	for i := 0; i < len(b); i += ControlChangeMessageLength {
+		if ParseStatusFromStatusByte(b[i]) != ControlChangeMessageStatusNibble {
+			return ParameterChange{}, fmt.Errorf("%s messages must be made up of control change messages, received status byte %#x: %w", name, b[i], ErrUnmarshallingMessage)
+		}
+		var ccm ControlChangeMessage
+		if err := (&ccm).UnmarshalMIDI(b[i : i+ControlChangeMessageLength]); err != nil {
+			return ParameterChange{}, err
+		}
+		if c, ok := parser.Parse(&ccm); ok {
+			change = c
+			found = true
+		}
+	}
+
+	if !found {
+		return ParameterChange{}, fmt.Errorf("%s messages must select a parameter and change its value: %w", name, ErrUnmarshallingMessage)
+	}
+	change.null = parser.channels[change.Channel].isNull()
+	if change.Registered != registered {
+		return ParameterChange{}, fmt.Errorf("%s messages must use the matching parameter number controllers: %w", name, ErrUnmarshallingMessage)
+	}
+	return change, nil
+}
+
+// msb returns the MSB of the registered parameter number.
+func (rp RegisteredParameter) msb() byte {
+	return FourteenBitValue(rp).GetMSB()
+}
+
+// lsb returns the LSB of the registered parameter number.
+func (rp RegisteredParameter) lsb() byte {
+	return FourteenBitValue(rp).GetLSB()
+}
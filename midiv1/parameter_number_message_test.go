@@ -0,0 +1,274 @@
+package midiv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func Test_RegisteredParameter_String(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		parameter RegisteredParameter
+		expected  string
+	}{
+		"named parameter": {
+			parameter: ModulationDepthRangeParameter,
+			expected:  "Modulation Depth Range",
+		},
+		"unnamed parameter": {
+			parameter: 0x0085,
+			expected:  "RPN 0x0085",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if test.parameter.String() != test.expected {
+				t.Fatalf("expected %s, got %s", test.expected, test.parameter.String())
+			}
+		})
+	}
+}
+
+func Test_RegisteredParameterNumberMessage_GetMessageName(t *testing.T) {
+	t.Parallel()
+	message := RegisteredParameterNumberMessage{}
+	expected := "Registered Parameter Number"
+	if message.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.GetMessageName())
+	}
+}
+
+func Test_RegisteredParameterNumberMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message       RegisteredParameterNumberMessage
+		expected      []byte
+		expectedError error
+	}{
+		"pitch bend sensitivity marshalls into data entry and null": {
+			message:  NewPitchBendSensitivityMessage(0, 12, 50),
+			expected: []byte{0xB0, 0x65, 0x00, 0xB0, 0x64, 0x00, 0xB0, 0x06, 0x0C, 0xB0, 0x26, 0x32, 0xB0, 0x65, 0x7F, 0xB0, 0x64, 0x7F},
+		},
+		"coarse tuning marshalls without data entry LSB": {
+			message:  NewCoarseTuningMessage(2, -2),
+			expected: []byte{0xB2, 0x65, 0x00, 0xB2, 0x64, 0x02, 0xB2, 0x06, 0x3E, 0xB2, 0x65, 0x7F, 0xB2, 0x64, 0x7F},
+		},
+		"increment without null": {
+			message: RegisteredParameterNumberMessage{
+				Channel:   1,
+				Parameter: FineTuningParameter,
+				Step:      IncrementParameterStep,
+				SkipNull:  true,
+			},
+			expected: []byte{0xB1, 0x65, 0x00, 0xB1, 0x64, 0x01, 0xB1, 0x60, 0x00},
+		},
+		"decrement": {
+			message: RegisteredParameterNumberMessage{
+				Parameter: TuningBankSelectParameter,
+				Step:      DecrementParameterStep,
+			},
+			expected: []byte{0xB0, 0x65, 0x00, 0xB0, 0x64, 0x04, 0xB0, 0x61, 0x00, 0xB0, 0x65, 0x7F, 0xB0, 0x64, 0x7F},
+		},
+		"value out of range": {
+			message: RegisteredParameterNumberMessage{
+				Value: MaxFourteenBitValue + 1,
+			},
+			expectedError: ErrMarshallingMessage,
+		},
+		"increment data byte out of range": {
+			message: RegisteredParameterNumberMessage{
+				Step:  IncrementParameterStep,
+				Value: 0x80,
+			},
+			expectedError: ErrMarshallingMessage,
+		},
+		"unknown step": {
+			message: RegisteredParameterNumberMessage{
+				Step: ParameterStep(5),
+			},
+			expectedError: ErrMarshallingMessage,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.expectedError != nil {
+				if !errors.Is(err, test.expectedError) {
+					t.Fatalf("expected error %v, got %v", test.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected % X, got % X", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_RegisteredParameterNumberMessage_String(t *testing.T) {
+	t.Parallel()
+	message := NewPitchBendSensitivityMessage(1, 2, 0)
+	expected := fmt.Sprintf(ParameterNumberMessageStringFormat, MessageVersion, message.GetMessageName(), 1, "Pitch Bend Sensitivity", "Set", 256)
+	if message.String() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.String())
+	}
+}
+
+func Test_RegisteredParameterNumberMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		bytes         []byte
+		expected      RegisteredParameterNumberMessage
+		expectedError error
+	}{
+		"full value with null": {
+			bytes:    []byte{0xB0, 0x65, 0x00, 0xB0, 0x64, 0x00, 0xB0, 0x06, 0x0C, 0xB0, 0x26, 0x32, 0xB0, 0x65, 0x7F, 0xB0, 0x64, 0x7F},
+			expected: NewPitchBendSensitivityMessage(0, 12, 50),
+		},
+		"MSB only with null": {
+			bytes:    []byte{0xB2, 0x65, 0x00, 0xB2, 0x64, 0x02, 0xB2, 0x06, 0x3E, 0xB2, 0x65, 0x7F, 0xB2, 0x64, 0x7F},
+			expected: NewCoarseTuningMessage(2, -2),
+		},
+		"increment without null": {
+			bytes: []byte{0xB1, 0x65, 0x00, 0xB1, 0x64, 0x01, 0xB1, 0x60, 0x00},
+			expected: RegisteredParameterNumberMessage{
+				Channel:   1,
+				Parameter: FineTuningParameter,
+				Step:      IncrementParameterStep,
+				SkipNull:  true,
+			},
+		},
+		"partial message": {
+			bytes:         []byte{0xB0, 0x65, 0x00, 0xB0},
+			expectedError: ErrUnmarshallingMessage,
+		},
+		"not a control change": {
+			bytes:         []byte{0x90, 0x3C, 0x40},
+			expectedError: ErrUnmarshallingMessage,
+		},
+		"no data entry": {
+			bytes:         []byte{0xB0, 0x65, 0x00, 0xB0, 0x64, 0x00},
+			expectedError: ErrUnmarshallingMessage,
+		},
+		"non-registered parameter number": {
+			bytes:         []byte{0xB0, 0x63, 0x00, 0xB0, 0x62, 0x00, 0xB0, 0x06, 0x0C},
+			expectedError: ErrUnmarshallingMessage,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got RegisteredParameterNumberMessage
+			err := got.UnmarshalMIDI(test.bytes)
+			if test.expectedError != nil {
+				if !errors.Is(err, test.expectedError) {
+					t.Fatalf("expected error %v, got %v", test.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if !reflect.DeepEqual(test.expected, got) {
+				t.Fatalf("expected %+v, got %+v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_NewFineTuningMessage(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		cents    float64
+		expected FourteenBitValue
+	}{
+		"in tune": {
+			cents:    0,
+			expected: CenterFourteenBitValue,
+		},
+		"50 cents flat": {
+			cents:    -50,
+			expected: 0x1000,
+		},
+		"100 cents flat": {
+			cents:    -100,
+			expected: MinFourteenBitValue,
+		},
+		"sharp beyond the range": {
+			cents:    150,
+			expected: MaxFourteenBitValue,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := NewFineTuningMessage(0, test.cents)
+			if got.Value != test.expected {
+				t.Fatalf("expected %#x, got %#x", test.expected, got.Value)
+			}
+		})
+	}
+}
+
+func Test_NewModulationDepthRangeMessage(t *testing.T) {
+	t.Parallel()
+	got := NewModulationDepthRangeMessage(0, 1, 50)
+	if got.Value.GetMSB() != 1 || got.Value.GetLSB() != 64 {
+		t.Fatalf("expected MSB 1 and LSB 64, got MSB %d and LSB %d", got.Value.GetMSB(), got.Value.GetLSB())
+	}
+}
+
+func Test_NewMPEConfigurationMessage(t *testing.T) {
+	t.Parallel()
+	message := NewMPEConfigurationMessage(MaxChannel, 5)
+	expected := []byte{0xBF, 0x65, 0x00, 0xBF, 0x64, 0x06, 0xBF, 0x06, 0x05, 0xBF, 0x65, 0x7F, 0xBF, 0x64, 0x7F}
+	got, err := message.MarshalMIDI()
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if !bytes.Equal(expected, got) {
+		t.Fatalf("expected %#v, got %#v", expected, got)
+	}
+}
+
+func Test_NonRegisteredParameterNumberMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	message := NonRegisteredParameterNumberMessage{
+		Channel:   1,
+		Parameter: 0x0088,
+		Value:     CenterFourteenBitValue,
+	}
+	expected := []byte{0xB1, 0x63, 0x01, 0xB1, 0x62, 0x08, 0xB1, 0x06, 0x40, 0xB1, 0x26, 0x00, 0xB1, 0x65, 0x7F, 0xB1, 0x64, 0x7F}
+	got, err := message.MarshalMIDI()
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if !bytes.Equal(expected, got) {
+		t.Fatalf("expected % X, got % X", expected, got)
+	}
+
+	var unmarshalled NonRegisteredParameterNumberMessage
+	if err := unmarshalled.UnmarshalMIDI(got); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if !reflect.DeepEqual(message, unmarshalled) {
+		t.Fatalf("expected %+v, got %+v", message, unmarshalled)
+	}
+}
+
+func Test_NonRegisteredParameterNumberMessage_String(t *testing.T) {
+	t.Parallel()
+	message := NonRegisteredParameterNumberMessage{Parameter: 0x0088, Step: DecrementParameterStep}
+	expected := fmt.Sprintf(ParameterNumberMessageStringFormat, MessageVersion, message.GetMessageName(), 0, "NRPN 0x0088", "Decrement", 0)
+	if message.String() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.String())
+	}
+}
@@ -0,0 +1,141 @@
+package midiv1
+
+// ParameterChange represents a change to a Registered or Non-Registered Parameter Number, folded back together from
+// the Control Change messages that carried it.
+type ParameterChange struct {
+	// Channel represents the channel of the parameter.
+	Channel Channel
+
+	// Registered represents whether the parameter is a Registered Parameter Number (RPN) rather than a Non-Registered
+	// Parameter Number (NRPN).
+	Registered bool
+
+	// Parameter represents the 14-bit parameter number.
+	Parameter FourteenBitValue
+
+	// Step represents whether the parameter was set, incremented or decremented.
+	Step ParameterStep
+
+	// Value represents the 14-bit value of the parameter when it was set, or the data byte of the Data Increment or
+	// Data Decrement controller otherwise.
+	Value FourteenBitValue
+
+	// msbOnly represents whether the value was set by a Data Entry MSB that has not been followed by an LSB
+	msbOnly bool
+
+	// null represents whether the parameter was deselected by the RPN Null parameter after the change
+	null bool
+}
+
+// parameterSelection represents the parameter selected on a single channel and the data entered for it so far.
+type parameterSelection struct {
+	// registered represents whether the selected parameter is an RPN rather than an NRPN
+	registered bool
+
+	// msb and lsb are the parameter number bytes, or -1 when they have not been received
+	msb, lsb int
+
+	// dataMSB is the last Data Entry MSB for the parameter, or -1 when none has been received
+	dataMSB int
+}
+
+// ParameterParser folds streams of Control Change messages back into Registered and Non-Registered Parameter Number
+// changes. It keeps track of the parameter selected on each channel, so Data Entry, Data Increment and Data Decrement
+// messages that arrive later, even without the parameter number being sent again, are reported against it.
+//
+// A Data Entry MSB (6) reports the change straight away with an LSB of 0, and a Data Entry LSB (38) that follows it
+// reports the change again with the full 14-bit value. Data Entry messages received while no parameter, or the RPN
+// Null parameter, is selected are ignored. A ParameterParser is not safe for concurrent use.
+type ParameterParser struct {
+	// channels holds the parameter selection of each channel
+	channels [16]parameterSelection
+}
+
+// NewParameterParser returns a new ParameterParser with no parameter selected on any channel.
+func NewParameterParser() *ParameterParser {
+	p := &ParameterParser{}
+	for i := range p.channels {
+		p.channels[i] = parameterSelection{msb: -1, lsb: -1, dataMSB: -1}
+	}
+	return p
+}
+
+// Parse updates the parser from a Control Change message and returns the parameter change it completes, if any. Other
+// messages and unrelated controllers are ignored.
+func (p *ParameterParser) Parse(message Message) (ParameterChange, bool) {
+	ccm, ok := message.(*ControlChangeMessage)
+	if !ok {
+		return ParameterChange{}, false
+	}
+	if int(ccm.Channel) >= len(p.channels) {
+		return ParameterChange{}, false
+	}
+
+	selection := &p.channels[ccm.Channel]
+	value := int(ccm.Value)
+	switch ccm.Controller {
+	case RegisteredParameterNumberMSBController, NonRegisteredParameterNumberMSBController:
+		selection.selectByte(ccm.Controller == RegisteredParameterNumberMSBController, &selection.msb, value)
+	case RegisteredParameterNumberLSBController, NonRegisteredParameterNumberLSBController:
+		selection.selectByte(ccm.Controller == RegisteredParameterNumberLSBController, &selection.lsb, value)
+	case DataEntryMSBController:
+		if !selection.selected() {
+			return ParameterChange{}, false
+		}
+		selection.dataMSB = value
+		change := selection.change(ccm.Channel, SetParameterStep, NewFourteenBitValueFromBytes(byte(value), 0))
+		change.msbOnly = true
+		return change, true
+	case DataEntryLSBController:
+		if !selection.selected() || selection.dataMSB < 0 {
+			return ParameterChange{}, false
+		}
+		return selection.change(ccm.Channel, SetParameterStep, NewFourteenBitValueFromBytes(byte(selection.dataMSB), byte(value))), true
+	case DataIncrementController, DataDecrementController:
+		if !selection.selected() {
+			return ParameterChange{}, false
+		}
+		// the value of the parameter is no longer known, so a later LSB on its own cannot complete it
+		selection.dataMSB = -1
+		step := IncrementParameterStep
+		if ccm.Controller == DataDecrementController {
+			step = DecrementParameterStep
+		}
+		return selection.change(ccm.Channel, step, FourteenBitValue(value)), true
+	}
+	return ParameterChange{}, false
+}
+
+// selectByte records a parameter number byte. Switching between RPN and NRPN controllers starts a new selection.
+func (ps *parameterSelection) selectByte(registered bool, b *int, value int) {
+	if ps.registered != registered {
+		ps.registered = registered
+		ps.msb, ps.lsb = -1, -1
+	}
+	*b = value
+	ps.dataMSB = -1
+}
+
+// selected returns whether a complete parameter number other than the RPN Null parameter is selected.
+func (ps *parameterSelection) selected() bool {
+	if ps.msb < 0 || ps.lsb < 0 {
+		return false
+	}
+	return !ps.isNull()
+}
+
+// isNull returns whether the RPN Null parameter is selected.
+func (ps *parameterSelection) isNull() bool {
+	return ps.registered && ps.msb == int(NullParameter.msb()) && ps.lsb == int(NullParameter.lsb())
+}
+
+// change returns the ParameterChange of the selected parameter.
+func (ps *parameterSelection) change(channel Channel, step ParameterStep, value FourteenBitValue) ParameterChange {
+	return ParameterChange{
+		Channel:    channel,
+		Registered: ps.registered,
+		Parameter:  NewFourteenBitValueFromBytes(byte(ps.msb), byte(ps.lsb)),
+		Step:       step,
+		Value:      value,
+	}
+}
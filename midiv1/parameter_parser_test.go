@@ -0,0 +1,88 @@
+package midiv1
+
+import (
+	"reflect"
+	"testing"
+)
+
+// controlChanges returns Control Change messages on the supplied channel from pairs of controller numbers and values.
+func controlChanges(channel Channel, pairs ...int) []Message {
+	var messages []Message
+	for i := 0; i+1 < len(pairs); i += 2 {
+		messages = append(messages, &ControlChangeMessage{
+			Channel:    channel,
+			Controller: Controller(pairs[i]),
+			Value:      ControllerValue(pairs[i+1]),
+		})
+	}
+	return messages
+}
+
+func Test_ParameterParser_Parse(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		messages []Message
+		expected []ParameterChange
+	}{
+		"data entry MSB and LSB report twice": {
+			messages: controlChanges(0, 101, 0, 100, 0, 6, 12, 38, 50, 101, 127, 100, 127),
+			expected: []ParameterChange{
+				{Registered: true, Parameter: 0, Value: 12 << 7, msbOnly: true},
+				{Registered: true, Parameter: 0, Value: 12<<7 | 50},
+			},
+		},
+		"non-registered parameter with increment and decrement": {
+			messages: controlChanges(3, 99, 1, 98, 8, 96, 0, 97, 2),
+			expected: []ParameterChange{
+				{Channel: 3, Parameter: 0x0088, Step: IncrementParameterStep},
+				{Channel: 3, Parameter: 0x0088, Step: DecrementParameterStep, Value: 2},
+			},
+		},
+		"selection is kept for later data entry": {
+			messages: controlChanges(0, 101, 0, 100, 2, 6, 60, 7, 100, 6, 62),
+			expected: []ParameterChange{
+				{Registered: true, Parameter: 2, Value: 60 << 7, msbOnly: true},
+				{Registered: true, Parameter: 2, Value: 62 << 7, msbOnly: true},
+			},
+		},
+		"data entry after null is ignored": {
+			messages: controlChanges(0, 101, 0, 100, 0, 101, 127, 100, 127, 6, 12, 96, 0),
+		},
+		"data entry without selection is ignored": {
+			messages: controlChanges(0, 6, 12, 38, 0),
+		},
+		"LSB after increment is ignored": {
+			messages: controlChanges(0, 101, 0, 100, 1, 96, 0, 38, 5),
+			expected: []ParameterChange{
+				{Registered: true, Parameter: 1, Step: IncrementParameterStep},
+			},
+		},
+		"switching to non-registered controllers starts a new selection": {
+			messages: controlChanges(0, 101, 0, 100, 0, 99, 5, 6, 1),
+		},
+		"channels are tracked separately": {
+			messages: append(controlChanges(0, 101, 0, 100, 0), append(controlChanges(1, 6, 1), controlChanges(0, 6, 2)...)...),
+			expected: []ParameterChange{
+				{Registered: true, Parameter: 0, Value: 2 << 7, msbOnly: true},
+			},
+		},
+		"other messages are ignored": {
+			messages: []Message{&NoteOnMessage{}, &ProgramChangeMessage{}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			parser := NewParameterParser()
+			var got []ParameterChange
+			for _, message := range test.messages {
+				if change, ok := parser.Parse(message); ok {
+					got = append(got, change)
+				}
+			}
+			if !reflect.DeepEqual(test.expected, got) {
+				t.Fatalf("expected %+v, got %+v", test.expected, got)
+			}
+		})
+	}
+}
@@ -1,23 +1,30 @@
 package midiv1
 
+import "math"
+
 const (
 	// MinPitchBend represents the value of the lowest pitch bend.
 	MinPitchBend PitchBend = -8192
 
 	// MaxPitchBend represents the value of the highest pitch bend.
-	MaxPitchBend PitchBend = 8192
+	MaxPitchBend PitchBend = 8191
 
 	// ZeroPitchBend represents the value for no pitch bend.
 	ZeroPitchBend PitchBend = 0
+
+	// DefaultPitchBendRange represents the pitch bend range in semitones that receivers use until they are sent a Pitch
+	// Bend Sensitivity parameter.
+	DefaultPitchBendRange float64 = 2
 )
 
-// PitchBend represents the pitch bend value of an individual MIDI note. Valid values are between -8192 and 8192 inclusive
+// PitchBend represents the pitch bend value of an individual MIDI note. Valid values are between -8192 and 8191 inclusive
 // when converted to an integer.
 //
-// PitchBend is only used in conjunction with Pitch Bend Channel Voice messages.
+// On the wire, the pitch bend is sent as a 14-bit value between 0 and 16383 that is centred at 8192, split into two
+// 7-bit data bytes. PitchBend is only used in conjunction with Pitch Bend Channel Voice messages.
 type PitchBend int16
 
-// NewPitchBend returns a PitchBend instance from an integer value.
+// NewPitchBend returns a PitchBend instance from an integer value, clamped within the overall minimum and maximum values.
 func NewPitchBend(pitchBend int) PitchBend {
 	if pitchBend < int(MinPitchBend) {
 		return MinPitchBend
@@ -28,19 +35,54 @@ func NewPitchBend(pitchBend int) PitchBend {
 	return PitchBend(pitchBend)
 }
 
-// NewPitchBendFromBytes returns a PitchBend instance from a most-significant byte and a least-significant byte.
+// NewPitchBendFromBytes returns a PitchBend instance from a most-significant and a least-significant 7-bit data byte. The
+// MSB of each byte is ignored.
 func NewPitchBendFromBytes(msb byte, lsb byte) PitchBend {
-	return NewPitchBend(int((int16(msb) << 8) | int16(lsb)))
+	return NewPitchBendFromFourteenBitValue(NewFourteenBitValueFromBytes(msb, lsb))
+}
+
+// NewPitchBendFromFourteenBitValue returns a PitchBend instance from the 14-bit value sent on the wire, where 8192 is no
+// pitch bend.
+func NewPitchBendFromFourteenBitValue(value FourteenBitValue) PitchBend {
+	return NewPitchBend(int(value) - int(CenterFourteenBitValue))
+}
+
+// NewPitchBendFromFloat returns a PitchBend instance from a float between -1 and 1 inclusive, where -1 is the lowest pitch
+// bend, 0 is no pitch bend and 1 is the highest. Values outside of the range are clamped.
+func NewPitchBendFromFloat(f float64) PitchBend {
+	return NewPitchBendFromFourteenBitValue(NewFourteenBitValueFromSignedFloat(f))
+}
+
+// NewPitchBendFromSemitones returns a PitchBend instance that bends by the supplied number of semitones, given the pitch
+// bend range of the receiver in semitones. Bends beyond the range are clamped.
+func NewPitchBendFromSemitones(semitones float64, bendRange float64) PitchBend {
+	if bendRange <= 0 {
+		return ZeroPitchBend
+	}
+	return NewPitchBendFromFloat(semitones / bendRange)
+}
+
+// FourteenBitValue returns the 14-bit value sent on the wire for the pitch bend, where 8192 is no pitch bend.
+func (pb PitchBend) FourteenBitValue() FourteenBitValue {
+	return NewFourteenBitValue(int(pb) + int(CenterFourteenBitValue))
+}
+
+// Float returns the pitch bend as a float between -1 and 1 inclusive, where 0 is no pitch bend.
+func (pb PitchBend) Float() float64 {
+	return pb.FourteenBitValue().SignedFloat()
+}
+
+// Semitones returns the number of semitones of the pitch bend, given the pitch bend range of the receiver in semitones.
+func (pb PitchBend) Semitones(bendRange float64) float64 {
+	return pb.Float() * math.Max(bendRange, 0)
 }
 
-// GetLSB returns the least-significant byte of the pitch bend value.
+// GetLSB returns the least-significant 7-bit data byte of the pitch bend value.
 func (pb PitchBend) GetLSB() byte {
-	// https://stackoverflow.com/a/17260211
-	return byte(pb & 0xFF)
+	return pb.FourteenBitValue().GetLSB()
 }
 
-// GetMSB returns the most-significant byte of the pitch bend value.
+// GetMSB returns the most-significant 7-bit data byte of the pitch bend value.
 func (pb PitchBend) GetMSB() byte {
-	// https://stackoverflow.com/a/17260211
-	return byte(pb >> 8)
+	return pb.FourteenBitValue().GetMSB()
 }
@@ -4,7 +4,7 @@ import "fmt"
 
 const (
 	// PitchBendChangeMessageStatusCode represents the message code within the status nibble
-	PitchBendChangeMessageCode Nibble = 0b01110000
+	PitchBendChangeMessageCode Nibble = 0b01100000
 
 	// PitchBendChangeMessageLength represents the number of bytes in a full Pitch Bend Change message.
 	PitchBendChangeMessageLength int = 3
@@ -32,15 +32,18 @@ func (pbm *PitchBendChangeMessage) GetMessageName() string {
 
 // MarshalMIDI marshalls a PitchBendChangeMessage MIDI message into its raw bytes
 func (pbm PitchBendChangeMessage) MarshalMIDI() ([]byte, error) {
-	return []byte{
-		MakeStatusByte(PitchBendChangeMessageStatusNibble, pbm.Channel),
-		pbm.PitchBend.GetLSB(),
-		pbm.PitchBend.GetMSB(),
-	}, nil
+	b, err := pbm.MarshalRunningStatusMIDI()
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{MakeStatusByte(PitchBendChangeMessageStatusNibble, pbm.Channel)}, b...), nil
 }
 
 // MarshalRunningStatusMIDI marshalls a running status MIDI message into its raw bytes.
 func (pbm PitchBendChangeMessage) MarshalRunningStatusMIDI() ([]byte, error) {
+	if pbm.PitchBend < MinPitchBend || pbm.PitchBend > MaxPitchBend {
+		return nil, fmt.Errorf("pitch bend values must be between %d and %d, inclusive, received %d: %w", MinPitchBend, MaxPitchBend, pbm.PitchBend, ErrMarshallingMessage)
+	}
 	return []byte{
 		pbm.PitchBend.GetLSB(),
 		pbm.PitchBend.GetMSB(),
@@ -55,9 +58,10 @@ func (pbm *PitchBendChangeMessage) String() string {
 // UnmarshalMIDI unmarshalls raw bytes into a PitchBendChangeMessage struct pointer. Pitch Bend Change messages are
 // represented by three bytes (left to right): status/channel, pitch bend LSB, pitch bend MSB.
 //
-// Example: []byte{0b11110001, 0b11100010, 0b00011101}
+// Example: []byte{0b11100001, 0b01100010, 0b01111011}
 //
-// The example forms a Pitch Bend Change message for channel 2 (index 1), pitch bend value 7650 (LSB: E2, MSB: 1D).
+// The example forms a Pitch Bend Change message for channel 2 (index 1), pitch bend value 7650 (LSB: 62, MSB: 7B), which
+// is 15842 on the wire.
 func (pbm *PitchBendChangeMessage) UnmarshalMIDI(b []byte) error {
 	// check the number of bytes in the message
 	if len(b) != PitchBendChangeMessageLength {
@@ -75,8 +79,11 @@ func (pbm *PitchBendChangeMessage) UnmarshalMIDI(b []byte) error {
 		return err
 	}
 
-	// form the pitch bend
-	pitchBend := NewPitchBendFromBytes(b[2], b[1])
+	// form the pitch bend from the two 7-bit data bytes
+	pitchBend, err := unmarshalPitchBend(b[1], b[2])
+	if err != nil {
+		return err
+	}
 
 	*pbm = PitchBendChangeMessage{
 		Channel:   channel,
@@ -88,20 +95,31 @@ func (pbm *PitchBendChangeMessage) UnmarshalMIDI(b []byte) error {
 // UnmarshalRunningStatusMIDI unmarshalls raw bytes into a PitchBendChangeMessage struct pointer. Pitch Bend Change running status messages are
 // represented by two bytes (left to right): pitch bend LSB, pitch bend MSB.
 //
-// Example: []byte{0b11100010, 0b00011101}
+// Example: []byte{0b01100010, 0b01111011}
 //
-// The example forms a Pitch Bend Change running status message for pitch bend value 7650 (LSB: E2, MSB: 1D).
+// The example forms a Pitch Bend Change running status message for pitch bend value 7650 (LSB: 62, MSB: 7B).
 func (pbm *PitchBendChangeMessage) UnmarshalRunningStatusMIDI(b []byte) error {
 	// check the number of bytes in the running status message
 	if len(b) != PitchBendChangeMessageLength-1 {
 		return fmt.Errorf("pitch bend change running status messages are made up of %d bytes, received %d byte(s): %w", PitchBendChangeMessageLength-1, len(b), ErrUnmarshallingMessage)
 	}
 
-	// form the pitch bend
-	pitchBend := NewPitchBendFromBytes(b[1], b[0])
+	// form the pitch bend from the two 7-bit data bytes
+	pitchBend, err := unmarshalPitchBend(b[0], b[1])
+	if err != nil {
+		return err
+	}
 
 	*pbm = PitchBendChangeMessage{
 		PitchBend: pitchBend,
 	}
 	return nil
 }
+
+// unmarshalPitchBend forms a PitchBend from the LSB and MSB data bytes of a Pitch Bend Change message.
+func unmarshalPitchBend(lsb byte, msb byte) (PitchBend, error) {
+	if ByteHasStatusMSB(lsb) || ByteHasStatusMSB(msb) {
+		return ZeroPitchBend, fmt.Errorf("pitch bend change data bytes must not have a status MSB, received LSB %#x and MSB %#x: %w", lsb, msb, ErrUnmarshallingMessage)
+	}
+	return NewPitchBendFromBytes(msb, lsb), nil
+}
@@ -22,19 +22,50 @@ func Test_PitchBendChangeMessage_MarshalMIDI(t *testing.T) {
 	tests := map[string]struct {
 		message  PitchBendChangeMessage
 		expected []byte
+		err      error
 	}{
 		"message marshalls into expected bytes": {
 			message: PitchBendChangeMessage{
 				Channel:   1,
 				PitchBend: 7650,
 			},
-			expected: []byte{0b11110001, 0b11100010, 0b00011101},
+			expected: []byte{0b11100001, 0b01100010, 0b01111011},
+		},
+		"no pitch bend marshalls into the center value": {
+			message: PitchBendChangeMessage{
+				PitchBend: ZeroPitchBend,
+			},
+			expected: []byte{0b11100000, 0b00000000, 0b01000000},
+		},
+		"lowest pitch bend marshalls into zero": {
+			message: PitchBendChangeMessage{
+				PitchBend: MinPitchBend,
+			},
+			expected: []byte{0b11100000, 0b00000000, 0b00000000},
+		},
+		"highest pitch bend marshalls into 16383": {
+			message: PitchBendChangeMessage{
+				PitchBend: MaxPitchBend,
+			},
+			expected: []byte{0b11100000, 0b01111111, 0b01111111},
+		},
+		"pitch bend out of range": {
+			message: PitchBendChangeMessage{
+				PitchBend: MaxPitchBend + 1,
+			},
+			err: ErrMarshallingMessage,
 		},
 	}
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			got, err := test.message.MarshalMIDI()
+			if test.err != nil {
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+				return
+			}
 			if err != nil {
 				t.Fatalf("expected nil error, got %v", err)
 			}
@@ -55,7 +86,7 @@ func Test_PitchBendChangeMessage_MarshalRunningStatusMIDI(t *testing.T) {
 			message: PitchBendChangeMessage{
 				PitchBend: 7650,
 			},
-			expected: []byte{0b11100010, 0b00011101},
+			expected: []byte{0b01100010, 0b01111011},
 		},
 	}
 
@@ -92,15 +123,26 @@ func Test_PitchBendChangeMessage_UnmarshalMIDI(t *testing.T) {
 		err             error
 	}{
 		"byte slice is not proper length": {
-			b:   []byte{0b11110001, 0b01000000},
+			b:   []byte{0b11100001, 0b01000000},
 			err: ErrUnmarshallingMessage,
 		},
 		"first byte does not have a status MSB": {
-			b:   []byte{0b01110001, 0b01000000, 0b00100000},
+			b:   []byte{0b01100001, 0b01000000, 0b00100000},
 			err: ErrUnmarshallingMessage,
 		},
+		"data byte has a status MSB": {
+			b:   []byte{0b11100001, 0b11100010, 0b00011101},
+			err: ErrUnmarshallingMessage,
+		},
+		"center value unmarshals into no pitch bend": {
+			b: []byte{0b11100001, 0b00000000, 0b01000000},
+			expectedMessage: PitchBendChangeMessage{
+				Channel:   1,
+				PitchBend: ZeroPitchBend,
+			},
+		},
 		"bytes unmarshal into expected message": {
-			b: []byte{0b11010001, 0b11100010, 0b00011101},
+			b: []byte{0b11100001, 0b01100010, 0b01111011},
 			expectedMessage: PitchBendChangeMessage{
 				Channel:   1,
 				PitchBend: 7650,
@@ -138,11 +180,11 @@ func Test_PitchBendChangeMessage_UnmarshalRunningStatusMIDI(t *testing.T) {
 		err             error
 	}{
 		"byte slice is not proper length": {
-			b:   []byte{0b11110001, 0b11100010, 0b00011101},
+			b:   []byte{0b11100001, 0b01100010, 0b01111011},
 			err: ErrUnmarshallingMessage,
 		},
 		"bytes unmarshal into expected message": {
-			b: []byte{0b11100010, 0b00011101},
+			b: []byte{0b01100010, 0b01111011},
 			expectedMessage: PitchBendChangeMessage{
 				PitchBend: 7650,
 			},
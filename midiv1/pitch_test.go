@@ -1,6 +1,9 @@
 package midiv1
 
-import "testing"
+import (
+	"math"
+	"testing"
+)
 
 func Test_NewPitchBend(t *testing.T) {
 	t.Parallel()
@@ -13,7 +16,7 @@ func Test_NewPitchBend(t *testing.T) {
 			expectedPitchBend: MinPitchBend,
 		},
 		"pitch bend clamps to maximum value": {
-			pitchBendInt:      8913,
+			pitchBendInt:      8192,
 			expectedPitchBend: MaxPitchBend,
 		},
 		"pitch bend is intended integer": {
@@ -39,17 +42,25 @@ func Test_NewPitchBendFromBytes(t *testing.T) {
 		expectedPitchBend PitchBend
 	}{
 		"pitch bend MSB and LSB make up -8192": {
-			pitchBendBytes:    [2]byte{0xE0, 0x00},
+			pitchBendBytes:    [2]byte{0x00, 0x00},
 			expectedPitchBend: MinPitchBend,
 		},
-		"pitch bend MSB and LSB make up 8192": {
-			pitchBendBytes:    [2]byte{0x20, 0x00},
+		"pitch bend MSB and LSB make up 8191": {
+			pitchBendBytes:    [2]byte{0x7F, 0x7F},
 			expectedPitchBend: MaxPitchBend,
 		},
+		"pitch bend MSB and LSB make up 0": {
+			pitchBendBytes:    [2]byte{0x40, 0x00},
+			expectedPitchBend: ZeroPitchBend,
+		},
 		"pitch bend MSB and LSB make up 53": {
-			pitchBendBytes:    [2]byte{0x00, 0x35},
+			pitchBendBytes:    [2]byte{0x40, 0x35},
 			expectedPitchBend: 53,
 		},
+		"pitch bend MSB and LSB make up -1": {
+			pitchBendBytes:    [2]byte{0x3F, 0x7F},
+			expectedPitchBend: -1,
+		},
 	}
 
 	for name, test := range tests {
@@ -76,6 +87,10 @@ func Test_PitchBend_GetLSB(t *testing.T) {
 			pitchBend:   53,
 			expectedLSB: 0x35,
 		},
+		"pitch bend LSB is 0x7F": {
+			pitchBend:   MaxPitchBend,
+			expectedLSB: 0x7F,
+		},
 	}
 
 	for name, test := range tests {
@@ -94,17 +109,17 @@ func Test_PitchBend_GetMSB(t *testing.T) {
 		pitchBend   PitchBend
 		expectedMSB byte
 	}{
-		"pitch bend MSB is 0xE0": {
+		"pitch bend MSB is 0x00": {
 			pitchBend:   MinPitchBend,
-			expectedMSB: 0xE0,
+			expectedMSB: 0x00,
 		},
-		"pitch bend MSB is 0x20": {
+		"pitch bend MSB is 0x7F": {
 			pitchBend:   MaxPitchBend,
-			expectedMSB: 0x20,
+			expectedMSB: 0x7F,
 		},
-		"pitch bend MSB is 0x00": {
+		"pitch bend MSB is 0x40": {
 			pitchBend:   53,
-			expectedMSB: 0x00,
+			expectedMSB: 0x40,
 		},
 	}
 
@@ -117,3 +132,86 @@ func Test_PitchBend_GetMSB(t *testing.T) {
 		})
 	}
 }
+
+func Test_PitchBend_Float(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		f                 float64
+		expectedPitchBend PitchBend
+	}{
+		"-1 is the lowest pitch bend": {
+			f:                 -1,
+			expectedPitchBend: MinPitchBend,
+		},
+		"0 is no pitch bend": {
+			f:                 0,
+			expectedPitchBend: ZeroPitchBend,
+		},
+		"1 is the highest pitch bend": {
+			f:                 1,
+			expectedPitchBend: MaxPitchBend,
+		},
+		"-0.5 is half the lowest pitch bend": {
+			f:                 -0.5,
+			expectedPitchBend: -4096,
+		},
+		"values beyond the range are clamped": {
+			f:                 3,
+			expectedPitchBend: MaxPitchBend,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := NewPitchBendFromFloat(test.f)
+			if got != test.expectedPitchBend {
+				t.Fatalf("expected %v, got %v", test.expectedPitchBend, got)
+			}
+			if math.Abs(got.Float()-math.Max(math.Min(test.f, 1), -1)) > 1e-9 {
+				t.Fatalf("expected %v, got %v", test.f, got.Float())
+			}
+		})
+	}
+}
+
+func Test_PitchBend_Semitones(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		semitones         float64
+		bendRange         float64
+		expectedPitchBend PitchBend
+	}{
+		"a whole step down with the default range": {
+			semitones:         -2,
+			bendRange:         DefaultPitchBendRange,
+			expectedPitchBend: MinPitchBend,
+		},
+		"a semitone up with the default range": {
+			semitones:         1,
+			bendRange:         DefaultPitchBendRange,
+			expectedPitchBend: 4096,
+		},
+		"a fifth down with a 12 semitone range": {
+			semitones:         -7,
+			bendRange:         12,
+			expectedPitchBend: -4779,
+		},
+		"no range is no pitch bend": {
+			semitones:         1,
+			bendRange:         0,
+			expectedPitchBend: ZeroPitchBend,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := NewPitchBendFromSemitones(test.semitones, test.bendRange)
+			if got != test.expectedPitchBend {
+				t.Fatalf("expected %v, got %v", test.expectedPitchBend, got)
+			}
+			if test.bendRange > 0 && math.Abs(got.Semitones(test.bendRange)-test.semitones) > test.bendRange/8192 {
+				t.Fatalf("expected about %v semitones, got %v", test.semitones, got.Semitones(test.bendRange))
+			}
+		})
+	}
+}
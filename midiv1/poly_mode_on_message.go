@@ -0,0 +1,72 @@
+package midiv1
+
+import "fmt"
+
+// PolyModeOnMessage represents a Poly Mode On Channel Mode message, which makes the receiver play polyphonically (Mono Mode Off). It also causes All Notes Off.
+type PolyModeOnMessage struct {
+	// Channel represents the channel number where this message will be sent.
+	Channel Channel
+}
+
+// GetMessageName returns the name of this Poly Mode On message.
+func (pmom *PolyModeOnMessage) GetMessageName() string {
+	return "Poly Mode On"
+}
+
+// MarshalMIDI marshalls a PolyModeOnMessage MIDI message into its raw bytes
+func (pmom PolyModeOnMessage) MarshalMIDI() ([]byte, error) {
+	return marshalChannelModeMessage(pmom.Channel, PolyModeOnController, 0), nil
+}
+
+// MarshalRunningStatusMIDI marshalls a running status MIDI message into its raw bytes.
+func (pmom PolyModeOnMessage) MarshalRunningStatusMIDI() ([]byte, error) {
+	return []byte{
+		byte(PolyModeOnController),
+		0,
+	}, nil
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (pmom *PolyModeOnMessage) String() string {
+	return fmt.Sprintf(ChannelModeMessageStringFormat, MessageVersion, pmom.GetMessageName(), pmom.Channel)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a PolyModeOnMessage struct pointer. Poly Mode On messages are
+// represented by three bytes (left to right): Control Change status/channel, controller number 127, value 0.
+//
+// Example: []byte{0b10110001, 0b01111111, 0b00000000}
+//
+// The example forms a Poly Mode On message for channel 2 (index 1).
+func (pmom *PolyModeOnMessage) UnmarshalMIDI(b []byte) error {
+	channel, value, err := unmarshalChannelModeMessage("poly mode on", PolyModeOnController, b)
+	if err != nil {
+		return err
+	}
+	if err := requireZeroChannelModeValue("poly mode on", value); err != nil {
+		return err
+	}
+
+	*pmom = PolyModeOnMessage{
+		Channel: channel,
+	}
+	return nil
+}
+
+// UnmarshalRunningStatusMIDI unmarshalls raw bytes into a PolyModeOnMessage struct pointer. Poly Mode On running status messages are
+// represented by two bytes (left to right): controller number 127, value 0.
+//
+// Example: []byte{0b01111111, 0b00000000}
+//
+// The example forms a Poly Mode On running status message.
+func (pmom *PolyModeOnMessage) UnmarshalRunningStatusMIDI(b []byte) error {
+	value, err := unmarshalRunningStatusChannelModeMessage("poly mode on", PolyModeOnController, b)
+	if err != nil {
+		return err
+	}
+	if err := requireZeroChannelModeValue("poly mode on", value); err != nil {
+		return err
+	}
+
+	*pmom = PolyModeOnMessage{}
+	return nil
+}
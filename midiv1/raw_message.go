@@ -0,0 +1,51 @@
+package midiv1
+
+import "fmt"
+
+const (
+	// RawMessageStringFormat represents the printf-compatible format specifically for a raw message string.
+	RawMessageStringFormat string = "%s:%s:% X"
+)
+
+// RawMessage represents the bytes of a complete MIDI message that does not map to a supported message type, such as
+// an undefined System message. It allows those bytes to be passed along without being interpreted.
+type RawMessage struct {
+	// Bytes represents the raw bytes of the message, starting with its status byte.
+	Bytes []byte
+}
+
+// GetMessageName returns the name of this raw message.
+func (rm *RawMessage) GetMessageName() string {
+	return "Raw"
+}
+
+// MarshalMIDI marshalls a RawMessage MIDI message into its raw bytes
+func (rm RawMessage) MarshalMIDI() ([]byte, error) {
+	if len(rm.Bytes) == 0 || !ByteHasStatusMSB(rm.Bytes[0]) {
+		return nil, fmt.Errorf("raw messages must start with a status byte: %w", ErrMarshallingMessage)
+	}
+	return append([]byte(nil), rm.Bytes...), nil
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (rm *RawMessage) String() string {
+	return fmt.Sprintf(RawMessageStringFormat, MessageVersion, rm.GetMessageName(), rm.Bytes)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a RawMessage struct pointer. Raw messages are represented by a status byte
+// followed by any number of bytes, which are copied as-is.
+//
+// Example: []byte{0b11110101}
+//
+// The example forms a raw message for the undefined 0xF5 System Common status byte.
+func (rm *RawMessage) UnmarshalMIDI(b []byte) error {
+	// make sure this starts with a status byte with the proper MSB
+	if len(b) == 0 || !ByteHasStatusMSB(b[0]) {
+		return fmt.Errorf("raw messages must start with a status byte: %w", ErrUnmarshallingMessage)
+	}
+
+	*rm = RawMessage{
+		Bytes: append([]byte(nil), b...),
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package midiv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func Test_RawMessage_GetMessageName(t *testing.T) {
+	t.Parallel()
+	message := RawMessage{}
+	expected := "Raw"
+	if message.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.GetMessageName())
+	}
+}
+
+func Test_RawMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  RawMessage
+		expected []byte
+		err      error
+	}{
+		"message has no bytes": {
+			message: RawMessage{},
+			err:     ErrMarshallingMessage,
+		},
+		"message does not start with a status byte": {
+			message: RawMessage{
+				Bytes: []byte{0b01110101},
+			},
+			err: ErrMarshallingMessage,
+		},
+		"message marshalls into expected bytes": {
+			message: RawMessage{
+				Bytes: []byte{0b11110101},
+			},
+			expected: []byte{0b11110101},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_RawMessage_String(t *testing.T) {
+	t.Parallel()
+	message := RawMessage{
+		Bytes: []byte{0xF5, 0x01},
+	}
+	expected := fmt.Sprintf("%s:%s:%s", MessageVersion, "Raw", "F5 01")
+	if message.String() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.String())
+	}
+}
+
+func Test_RawMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage RawMessage
+		err             error
+	}{
+		"byte slice is empty": {
+			b:   []byte{},
+			err: ErrUnmarshallingMessage,
+		},
+		"first byte does not have a status MSB": {
+			b:   []byte{0b01110101},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b: []byte{0b11110101},
+			expectedMessage: RawMessage{
+				Bytes: []byte{0b11110101},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got RawMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil {
+				if err == nil {
+					t.Fatalf("expected non-nil %v error, got nil error", test.err)
+				}
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
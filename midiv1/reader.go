@@ -0,0 +1,225 @@
+package midiv1
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Reader reads complete MIDI 1.0 messages from a raw byte stream, such as a serial port or a hardware dump.
+//
+// Running status is tracked across messages, so data bytes that arrive without a status byte are decoded with the
+// UnmarshalRunningStatusMIDI method of the remembered message type and have the remembered Channel filled in. System
+// Real-Time bytes interleaved within another message are returned on their own without disturbing the message in progress.
+//
+// Messages without a supported type (such as undefined System Common messages) are returned as *RawMessage values, and
+// Channel Mode messages with a value their type rejects are returned as *ControlChangeMessage values.
+type Reader struct {
+	// r is the underlying byte source
+	r io.ByteReader
+
+	// maxSystemExclusiveSize is the size of the largest System Exclusive message that is read, or 0 for no limit
+	maxSystemExclusiveSize int
+
+	// runningStatus is the last Channel Voice or Channel Mode status byte, or 0 when running status is not available
+	runningStatus byte
+
+	// pending holds the status byte and any data bytes of the message in progress
+	pending []byte
+
+	// pendingLength is the expected length of the message in progress, or 0 for a System Exclusive message
+	pendingLength int
+
+	// pendingRunningStatus represents whether the message in progress began with a running status data byte
+	pendingRunningStatus bool
+}
+
+// ReaderOption configures a Reader.
+type ReaderOption func(*Reader)
+
+// WithMaxSystemExclusiveSize sets the size, in bytes and including the status and EOX bytes, of the largest System
+// Exclusive message that is read. A size of 0 or less reads System Exclusive messages of any size, which is the default.
+func WithMaxSystemExclusiveSize(size int) ReaderOption {
+	return func(r *Reader) {
+		r.maxSystemExclusiveSize = size
+	}
+}
+
+// NewReader returns a new Reader that reads MIDI messages from r, configured by any supplied options. If r does not
+// implement io.ByteReader it is buffered.
+func NewReader(r io.Reader, options ...ReaderOption) *Reader {
+	br, ok := r.(io.ByteReader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	reader := &Reader{
+		r: br,
+	}
+	for _, option := range options {
+		option(reader)
+	}
+	return reader
+}
+
+// ReadMessage reads and returns the next complete MIDI message from the stream.
+//
+// Stray data bytes that arrive without any status byte to apply them to are ignored. A status byte that arrives before
+// the message in progress is complete discards the incomplete message. io.ErrUnexpectedEOF is returned when the stream
+// ends in the middle of a message, and io.EOF is returned when it ends between messages.
+//
+// A System Exclusive message that grows past the size set by WithMaxSystemExclusiveSize is discarded as soon as it does,
+// with an ErrUnmarshallingMessage error. The rest of its bytes are then ignored as stray data bytes.
+func (r *Reader) ReadMessage() (Message, error) {
+	for {
+		c, err := r.r.ReadByte()
+		if err != nil {
+			if errors.Is(err, io.EOF) && len(r.pending) > 0 {
+				r.reset()
+				return nil, io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+
+		switch {
+		case IsRealTimeStatus(c):
+			// real-time bytes may appear anywhere and do not affect the message in progress or running status
+			return r.unmarshal([]byte{c}, false)
+		case c == EndOfExclusiveStatus:
+			if len(r.pending) == 0 || r.pending[0] != SystemExclusiveMessageStatus {
+				// an EOX without a System Exclusive message in progress is ignored, but still cancels running status
+				r.reset()
+				r.runningStatus = 0
+				continue
+			}
+			r.pending = append(r.pending, c)
+			if err := r.checkSystemExclusiveSize(); err != nil {
+				return nil, err
+			}
+			return r.complete()
+		case ByteHasStatusMSB(c):
+			if err := r.begin(c); err != nil {
+				return nil, err
+			}
+		default:
+			if len(r.pending) == 0 {
+				if r.runningStatus == 0 {
+					// there is no status to apply this data byte to
+					continue
+				}
+				length, err := MessageLength(r.runningStatus)
+				if err != nil {
+					return nil, err
+				}
+				r.pending = append(r.pending, r.runningStatus)
+				r.pendingLength = length
+				r.pendingRunningStatus = true
+			}
+			r.pending = append(r.pending, c)
+			if err := r.checkSystemExclusiveSize(); err != nil {
+				return nil, err
+			}
+		}
+
+		if r.pendingLength > 0 && len(r.pending) == r.pendingLength {
+			return r.complete()
+		}
+	}
+}
+
+// begin starts a new message in progress from a non-real-time status byte.
+func (r *Reader) begin(status byte) error {
+	r.reset()
+	r.pending = append(r.pending, status)
+
+	// System Exclusive messages continue until an EOX byte and cancel running status
+	if status == SystemExclusiveMessageStatus {
+		r.runningStatus = 0
+		return nil
+	}
+
+	length, err := MessageLength(status)
+	if err != nil {
+		r.reset()
+		return err
+	}
+	r.pendingLength = length
+
+	// System Common messages cancel running status, while channel messages replace it
+	if ParseStatusFromStatusByte(status) == SystemMessageStatusNibble {
+		r.runningStatus = 0
+	} else {
+		r.runningStatus = status
+	}
+	return nil
+}
+
+// checkSystemExclusiveSize discards a System Exclusive message in progress that has grown past the maximum size.
+func (r *Reader) checkSystemExclusiveSize() error {
+	if r.maxSystemExclusiveSize <= 0 || r.pending[0] != SystemExclusiveMessageStatus || len(r.pending) <= r.maxSystemExclusiveSize {
+		return nil
+	}
+	r.reset()
+	return fmt.Errorf("system exclusive messages are made up of at most %d bytes: %w", r.maxSystemExclusiveSize, ErrUnmarshallingMessage)
+}
+
+// complete unmarshals the message in progress and resets it.
+func (r *Reader) complete() (Message, error) {
+	b := append([]byte(nil), r.pending...)
+	runningStatus := r.pendingRunningStatus
+	r.reset()
+	return r.unmarshal(b, runningStatus)
+}
+
+// reset discards the message in progress.
+func (r *Reader) reset() {
+	r.pending = r.pending[:0]
+	r.pendingLength = 0
+	r.pendingRunningStatus = false
+}
+
+// unmarshal turns complete message bytes into a Message. Bytes that began with a running status data byte start with the
+// remembered status byte.
+//
+// Channel Mode messages with a value their type rejects, such as an All Notes Off message with a non-zero value, are
+// returned as a *ControlChangeMessage so that one non-conforming sender does not stop the stream.
+func (r *Reader) unmarshal(b []byte, runningStatus bool) (Message, error) {
+	if runningStatus {
+		message, err := UnmarshalRunningStatus(b[0], b[1:])
+		if err != nil {
+			if cc, ok := unmarshalChannelModeAsControlChange(b); ok {
+				return cc, nil
+			}
+			return nil, err
+		}
+		return message, nil
+	}
+	message, err := Unmarshal(b)
+	if errors.Is(err, ErrUnsupportedMessage) {
+		raw := &RawMessage{}
+		if err := raw.UnmarshalMIDI(b); err != nil {
+			return nil, err
+		}
+		return raw, nil
+	}
+	if err != nil {
+		if cc, ok := unmarshalChannelModeAsControlChange(b); ok {
+			return cc, nil
+		}
+		return nil, fmt.Errorf("unable to read message %#v: %w", b, err)
+	}
+	return message, nil
+}
+
+// unmarshalChannelModeAsControlChange unmarshalls the bytes of a Channel Mode message into a plain *ControlChangeMessage,
+// returning false when the bytes are not a well-formed Control Change message for a Channel Mode controller number.
+func unmarshalChannelModeAsControlChange(b []byte) (*ControlChangeMessage, bool) {
+	if len(b) != ControlChangeMessageLength || ParseStatusFromStatusByte(b[0]) != ControlChangeMessageStatusNibble || !Controller(b[1]).IsChannelMode() {
+		return nil, false
+	}
+	cc := &ControlChangeMessage{}
+	if err := cc.UnmarshalMIDI(b); err != nil {
+		return nil, false
+	}
+	return cc, true
+}
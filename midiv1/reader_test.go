@@ -0,0 +1,173 @@
+package midiv1
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+)
+
+func Test_Reader_ReadMessage(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b                []byte
+		expectedMessages []Message
+		err              error
+	}{
+		"complete messages are read in order": {
+			b: []byte{0b10010001, 0b01000000, 0b00100000, 0b11000010, 0b01000000},
+			expectedMessages: []Message{
+				&NoteOnMessage{Channel: 1, Note: 64, Velocity: 32},
+				&ProgramChangeMessage{Channel: 2, Program: 64},
+			},
+		},
+		"running status messages have the remembered channel": {
+			b: []byte{0b10010011, 0b01000000, 0b00100000, 0b01000001, 0b00000000, 0b01000010, 0b01111111},
+			expectedMessages: []Message{
+				&NoteOnMessage{Channel: 3, Note: 64, Velocity: 32},
+				&NoteOnMessage{Channel: 3, Note: 65, Velocity: 0},
+				&NoteOnMessage{Channel: 3, Note: 66, Velocity: 127},
+			},
+		},
+		"channel pressure messages keep the stream in frame": {
+			b: []byte{0b11010010, 0b00100000, 0b00100001, 0b10010010, 0b01000000, 0b00100000},
+			expectedMessages: []Message{
+				&ChannelPressureMessage{Channel: 2, Pressure: 32},
+				&ChannelPressureMessage{Channel: 2, Pressure: 33},
+				&NoteOnMessage{Channel: 2, Note: 64, Velocity: 32},
+			},
+		},
+		"running status switches to channel mode messages": {
+			b: []byte{0b10110000, 0b00000111, 0b01100100, 0b01111011, 0b00000000},
+			expectedMessages: []Message{
+				&ControlChangeMessage{Channel: 0, Controller: ChannelVolumeMSBController, Value: 100},
+				&AllNotesOffMessage{Channel: 0},
+			},
+		},
+		"channel mode messages with a rejected value are read as control change messages": {
+			b: []byte{0b10110000, 0b01111011, 0b01111111, 0b01111000, 0b00000001, 0b01111011, 0b00000000},
+			expectedMessages: []Message{
+				&ControlChangeMessage{Channel: 0, Controller: AllNotesOffController, Value: 127},
+				&ControlChangeMessage{Channel: 0, Controller: AllSoundOffController, Value: 1},
+				&AllNotesOffMessage{Channel: 0},
+			},
+		},
+		"real-time bytes interleaved within a message are returned first": {
+			b: []byte{0b10010001, 0xF8, 0b01000000, 0xFE, 0b00100000, 0b01000001, 0xF8, 0b00100000},
+			expectedMessages: []Message{
+				&TimingClockMessage{},
+				&ActiveSensingMessage{},
+				&NoteOnMessage{Channel: 1, Note: 64, Velocity: 32},
+				&TimingClockMessage{},
+				&NoteOnMessage{Channel: 1, Note: 65, Velocity: 32},
+			},
+		},
+		"system exclusive messages are read until EOX": {
+			b: []byte{0xF0, 0x7E, 0xF8, 0x7F, 0x06, 0x01, 0xF7},
+			expectedMessages: []Message{
+				&TimingClockMessage{},
+				&IdentityRequestMessage{DeviceID: AllCallDeviceID},
+			},
+		},
+		"system common messages cancel running status": {
+			b: []byte{0b10010001, 0b01000000, 0b00100000, 0xF6, 0b01000001, 0b00100000},
+			expectedMessages: []Message{
+				&NoteOnMessage{Channel: 1, Note: 64, Velocity: 32},
+				&TuneRequestMessage{},
+			},
+		},
+		"stray data bytes are ignored": {
+			b: []byte{0b01000000, 0b00100000, 0b11000010, 0b01000000},
+			expectedMessages: []Message{
+				&ProgramChangeMessage{Channel: 2, Program: 64},
+			},
+		},
+		"status byte discards an incomplete message": {
+			b: []byte{0b10010001, 0b01000000, 0b11000010, 0b01000000},
+			expectedMessages: []Message{
+				&ProgramChangeMessage{Channel: 2, Program: 64},
+			},
+		},
+		"stream ends in the middle of a message": {
+			b: []byte{0b11000010, 0b01000000, 0b10010001, 0b01000000},
+			expectedMessages: []Message{
+				&ProgramChangeMessage{Channel: 2, Program: 64},
+			},
+			err: io.ErrUnexpectedEOF,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			reader := NewReader(bytes.NewReader(test.b))
+			var got []Message
+			var err error
+			for {
+				var message Message
+				message, err = reader.ReadMessage()
+				if err != nil {
+					break
+				}
+				got = append(got, message)
+			}
+			if test.err == nil && !errors.Is(err, io.EOF) {
+				t.Fatalf("expected io.EOF error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedMessages, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessages, got)
+			}
+		})
+	}
+}
+
+func Test_Reader_ReadMessage_InvalidData(t *testing.T) {
+	t.Parallel()
+
+	// an invalid message is reported without losing the messages after it
+	reader := NewReader(bytes.NewReader([]byte{0xF0, 0x7E, 0x7F, 0x09, 0x01, 0x00, 0xF7, 0b11000010, 0b01000000}))
+	if _, err := reader.ReadMessage(); !errors.Is(err, ErrUnmarshallingMessage) {
+		t.Fatalf("expected %v error, got %v", ErrUnmarshallingMessage, err)
+	}
+	got, err := reader.ReadMessage()
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	expected := &ProgramChangeMessage{Channel: 2, Program: 64}
+	if !reflect.DeepEqual(expected, got) {
+		t.Fatalf("expected %+v, got %+v", expected, got)
+	}
+}
+
+func Test_Reader_ReadMessage_MaxSystemExclusiveSize(t *testing.T) {
+	t.Parallel()
+
+	// a System Exclusive message past the maximum size is reported and the stream picks up again after it
+	reader := NewReader(bytes.NewReader([]byte{
+		0xF0, 0x7D, 0x01, 0x02, 0x03, 0xF7,
+		0xF0, 0x7D, 0x01, 0x02, 0x03, 0x04, 0xF7,
+		0b11000010, 0b01000000,
+	}), WithMaxSystemExclusiveSize(6))
+	got, err := reader.ReadMessage()
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	expected := Message(&SystemExclusiveMessage{Manufacturer: NonCommercialManufacturerID, Data: []byte{0x01, 0x02, 0x03}})
+	if !reflect.DeepEqual(expected, got) {
+		t.Fatalf("expected %+v, got %+v", expected, got)
+	}
+	if _, err := reader.ReadMessage(); !errors.Is(err, ErrUnmarshallingMessage) {
+		t.Fatalf("expected %v error, got %v", ErrUnmarshallingMessage, err)
+	}
+	got, err = reader.ReadMessage()
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	expected = &ProgramChangeMessage{Channel: 2, Program: 64}
+	if !reflect.DeepEqual(expected, got) {
+		t.Fatalf("expected %+v, got %+v", expected, got)
+	}
+}
@@ -0,0 +1,72 @@
+package midiv1
+
+import "fmt"
+
+// ResetAllControllersMessage represents a Reset All Controllers Channel Mode message, which resets all controllers on the channel to their default values.
+type ResetAllControllersMessage struct {
+	// Channel represents the channel number where this message will be sent.
+	Channel Channel
+}
+
+// GetMessageName returns the name of this Reset All Controllers message.
+func (racm *ResetAllControllersMessage) GetMessageName() string {
+	return "Reset All Controllers"
+}
+
+// MarshalMIDI marshalls a ResetAllControllersMessage MIDI message into its raw bytes
+func (racm ResetAllControllersMessage) MarshalMIDI() ([]byte, error) {
+	return marshalChannelModeMessage(racm.Channel, ResetAllControllersController, 0), nil
+}
+
+// MarshalRunningStatusMIDI marshalls a running status MIDI message into its raw bytes.
+func (racm ResetAllControllersMessage) MarshalRunningStatusMIDI() ([]byte, error) {
+	return []byte{
+		byte(ResetAllControllersController),
+		0,
+	}, nil
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (racm *ResetAllControllersMessage) String() string {
+	return fmt.Sprintf(ChannelModeMessageStringFormat, MessageVersion, racm.GetMessageName(), racm.Channel)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a ResetAllControllersMessage struct pointer. Reset All Controllers messages are
+// represented by three bytes (left to right): Control Change status/channel, controller number 121, value 0.
+//
+// Example: []byte{0b10110001, 0b01111001, 0b00000000}
+//
+// The example forms a Reset All Controllers message for channel 2 (index 1).
+func (racm *ResetAllControllersMessage) UnmarshalMIDI(b []byte) error {
+	channel, value, err := unmarshalChannelModeMessage("reset all controllers", ResetAllControllersController, b)
+	if err != nil {
+		return err
+	}
+	if err := requireZeroChannelModeValue("reset all controllers", value); err != nil {
+		return err
+	}
+
+	*racm = ResetAllControllersMessage{
+		Channel: channel,
+	}
+	return nil
+}
+
+// UnmarshalRunningStatusMIDI unmarshalls raw bytes into a ResetAllControllersMessage struct pointer. Reset All Controllers running status messages are
+// represented by two bytes (left to right): controller number 121, value 0.
+//
+// Example: []byte{0b01111001, 0b00000000}
+//
+// The example forms a Reset All Controllers running status message.
+func (racm *ResetAllControllersMessage) UnmarshalRunningStatusMIDI(b []byte) error {
+	value, err := unmarshalRunningStatusChannelModeMessage("reset all controllers", ResetAllControllersController, b)
+	if err != nil {
+		return err
+	}
+	if err := requireZeroChannelModeValue("reset all controllers", value); err != nil {
+		return err
+	}
+
+	*racm = ResetAllControllersMessage{}
+	return nil
+}
@@ -0,0 +1,88 @@
+package midiv1
+
+import "fmt"
+
+var (
+	// ErrInvalidChecksum represents a Sample Dump Data Packet whose checksum does not match its contents.
+	ErrInvalidChecksum error = fmt.Errorf("invalid MIDI sample dump checksum: %w", ErrUnmarshallingMessage)
+)
+
+const (
+	// SampleDumpDataPacketMessageStringFormat represents the printf-compatible format specifically for a Sample Dump Data Packet message string.
+	SampleDumpDataPacketMessageStringFormat string = "%s:%s:%d:%d:% X"
+
+	// SampleDumpDataPacketLength represents the number of sample data bytes in every Sample Dump Data Packet message.
+	SampleDumpDataPacketLength int = 120
+)
+
+// SampleDumpDataPacketMessage represents a Sample Dump Data Packet Universal Non-Real-Time System Exclusive message,
+// which carries 120 bytes of sample data followed by a checksum.
+type SampleDumpDataPacketMessage struct {
+	// DeviceID represents the device ID of the device that is sending the dump.
+	DeviceID DeviceID
+
+	// PacketNumber represents the running packet count, between 0 and 127 inclusive, which wraps back to 0 after 127.
+	PacketNumber byte
+
+	// Data represents the sample data bytes of the packet. Data shorter than 120 bytes is padded with zeros when marshalled.
+	Data []byte
+}
+
+// GetMessageName returns the name of this Sample Dump Data Packet message.
+func (sddpm *SampleDumpDataPacketMessage) GetMessageName() string {
+	return "Sample Dump Data Packet"
+}
+
+// Checksum returns the checksum of the packet: the XOR of every byte between the 0xF0 status byte and the checksum,
+// with its MSB cleared.
+func (sddpm SampleDumpDataPacketMessage) Checksum() byte {
+	checksum := UniversalNonRealTimeManufacturerID[0] ^ byte(sddpm.DeviceID) ^ SampleDumpDataPacketSubID ^ sddpm.PacketNumber
+	for _, b := range sddpm.Data {
+		checksum ^= b
+	}
+	return checksum & 0x7F
+}
+
+// MarshalMIDI marshalls a SampleDumpDataPacketMessage MIDI message into its raw bytes
+func (sddpm SampleDumpDataPacketMessage) MarshalMIDI() ([]byte, error) {
+	if ByteHasStatusMSB(sddpm.PacketNumber) {
+		return nil, fmt.Errorf("packet numbers must be between 0 and 127, inclusive, received %d: %w", sddpm.PacketNumber, ErrMarshallingMessage)
+	}
+	if len(sddpm.Data) > SampleDumpDataPacketLength {
+		return nil, fmt.Errorf("sample dump data packets hold at most %d bytes, received %d byte(s): %w", SampleDumpDataPacketLength, len(sddpm.Data), ErrMarshallingMessage)
+	}
+
+	data := make([]byte, 0, 1+SampleDumpDataPacketLength+1)
+	data = append(data, sddpm.PacketNumber)
+	data = append(data, sddpm.Data...)
+	data = append(data, make([]byte, SampleDumpDataPacketLength-len(sddpm.Data))...)
+	data = append(data, sddpm.Checksum())
+	return marshalUniversalMessage(UniversalNonRealTimeManufacturerID, sddpm.DeviceID, []byte{SampleDumpDataPacketSubID}, data)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (sddpm *SampleDumpDataPacketMessage) String() string {
+	return fmt.Sprintf(SampleDumpDataPacketMessageStringFormat, MessageVersion, sddpm.GetMessageName(), sddpm.DeviceID, sddpm.PacketNumber, sddpm.Data)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a SampleDumpDataPacketMessage struct pointer. Sample Dump Data Packet
+// messages are represented by 127 bytes (left to right): 0xF0, 0x7E, device ID, 0x02, packet number, 120 data bytes,
+// checksum, 0xF7. An error wrapping ErrInvalidChecksum is returned when the checksum does not match.
+func (sddpm *SampleDumpDataPacketMessage) UnmarshalMIDI(b []byte) error {
+	deviceID, data, err := unmarshalUniversalMessage("sample dump data packet", UniversalNonRealTimeManufacturerID, []byte{SampleDumpDataPacketSubID}, 1+SampleDumpDataPacketLength+1, b)
+	if err != nil {
+		return err
+	}
+
+	packet := SampleDumpDataPacketMessage{
+		DeviceID:     deviceID,
+		PacketNumber: data[0],
+		Data:         append([]byte(nil), data[1:1+SampleDumpDataPacketLength]...),
+	}
+	checksum := data[len(data)-1]
+	if packet.Checksum() != checksum {
+		return fmt.Errorf("sample dump data packet %d has checksum %#x, expected %#x: %w", packet.PacketNumber, checksum, packet.Checksum(), ErrInvalidChecksum)
+	}
+	*sddpm = packet
+	return nil
+}
@@ -0,0 +1,111 @@
+package midiv1
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// sampleDumpDataPacketTestBytes returns the raw bytes of a data packet from device 0 with packet number 3, whose
+// sample data starts with 0x01, 0x02, 0x03 and is otherwise padded with zeros.
+func sampleDumpDataPacketTestBytes() []byte {
+	b := []byte{0xF0, 0x7E, 0x00, 0x02, 0x03, 0x01, 0x02, 0x03}
+	b = append(b, make([]byte, SampleDumpDataPacketLength-3)...)
+	// 0x7E ^ 0x00 ^ 0x02 ^ 0x03 ^ 0x01 ^ 0x02 ^ 0x03 = 0x7F
+	return append(b, 0x7F, 0xF7)
+}
+
+func Test_SampleDumpDataPacketMessage_GetMessageName(t *testing.T) {
+	t.Parallel()
+	message := SampleDumpDataPacketMessage{}
+	expected := "Sample Dump Data Packet"
+	if message.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.GetMessageName())
+	}
+}
+
+func Test_SampleDumpDataPacketMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  SampleDumpDataPacketMessage
+		expected []byte
+		err      error
+	}{
+		"packet number is out of range": {
+			message: SampleDumpDataPacketMessage{PacketNumber: 0x80},
+			err:     ErrMarshallingMessage,
+		},
+		"data is too long": {
+			message: SampleDumpDataPacketMessage{Data: make([]byte, SampleDumpDataPacketLength+1)},
+			err:     ErrMarshallingMessage,
+		},
+		"data byte has a status MSB": {
+			message: SampleDumpDataPacketMessage{Data: []byte{0x80}},
+			err:     ErrMarshallingMessage,
+		},
+		"short data is padded with zeros": {
+			message:  SampleDumpDataPacketMessage{PacketNumber: 3, Data: []byte{0x01, 0x02, 0x03}},
+			expected: sampleDumpDataPacketTestBytes(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_SampleDumpDataPacketMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	badChecksum := sampleDumpDataPacketTestBytes()
+	badChecksum[len(badChecksum)-2] = 0x00
+	expectedData := append([]byte{0x01, 0x02, 0x03}, make([]byte, SampleDumpDataPacketLength-3)...)
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage SampleDumpDataPacketMessage
+		err             error
+	}{
+		"packet is too short": {
+			b:   []byte{0xF0, 0x7E, 0x00, 0x02, 0x03, 0x01, 0x7E, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"checksum does not match": {
+			b:   badChecksum,
+			err: ErrInvalidChecksum,
+		},
+		"bytes unmarshal into expected message": {
+			b: sampleDumpDataPacketTestBytes(),
+			expectedMessage: SampleDumpDataPacketMessage{
+				PacketNumber: 3,
+				Data:         expectedData,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got SampleDumpDataPacketMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
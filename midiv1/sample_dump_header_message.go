@@ -0,0 +1,149 @@
+package midiv1
+
+import "fmt"
+
+const (
+	// SampleDumpHeaderMessageStringFormat represents the printf-compatible format specifically for a Sample Dump Header message string.
+	SampleDumpHeaderMessageStringFormat string = "%s:%s:%d:%d:%d:%d:%d:%d:%d:%#x"
+
+	// MaxSampleNumber represents the highest sample number, which is sent as two 7-bit data bytes.
+	MaxSampleNumber int = 0x3FFF
+
+	// MinSampleBitsPerSample represents the lowest sample resolution supported by the Sample Dump Standard.
+	MinSampleBitsPerSample int = 8
+
+	// MaxSampleBitsPerSample represents the highest sample resolution supported by the Sample Dump Standard.
+	MaxSampleBitsPerSample int = 28
+
+	// MaxSampleDumpValue represents the highest sample period, length or loop point, which are each sent as three 7-bit data bytes.
+	MaxSampleDumpValue uint32 = 0x1FFFFF
+
+	// sampleDumpHeaderDataLength represents the number of data bytes in a Sample Dump Header message after its sub-ID.
+	sampleDumpHeaderDataLength int = 16
+)
+
+// SampleLoopType represents the loop type of a sample within a Sample Dump Header message.
+type SampleLoopType byte
+
+const (
+	// ForwardSampleLoopType represents a loop that plays forwards only.
+	ForwardSampleLoopType SampleLoopType = 0x00
+
+	// AlternatingSampleLoopType represents a loop that plays backwards and forwards.
+	AlternatingSampleLoopType SampleLoopType = 0x01
+
+	// LoopOffSampleLoopType represents a sample without a loop.
+	LoopOffSampleLoopType SampleLoopType = 0x7F
+)
+
+// SampleDumpHeaderMessage represents a Sample Dump Header Universal Non-Real-Time System Exclusive message, which
+// describes a sample before its data packets are sent.
+type SampleDumpHeaderMessage struct {
+	// DeviceID represents the device ID of the device that is sending the dump.
+	DeviceID DeviceID
+
+	// SampleNumber represents the number of the sample being dumped, between 0 and 0x3FFF inclusive.
+	SampleNumber int
+
+	// BitsPerSample represents the resolution of the sample, between 8 and 28 inclusive.
+	BitsPerSample int
+
+	// SamplePeriod represents the length of a single sample in nanoseconds (1 / sample rate).
+	SamplePeriod uint32
+
+	// Length represents the length of the sample in words.
+	Length uint32
+
+	// LoopStart represents the word number at which the loop starts.
+	LoopStart uint32
+
+	// LoopEnd represents the word number at which the loop ends.
+	LoopEnd uint32
+
+	// LoopType represents how the loop is played.
+	LoopType SampleLoopType
+}
+
+// GetMessageName returns the name of this Sample Dump Header message.
+func (sdhm *SampleDumpHeaderMessage) GetMessageName() string {
+	return "Sample Dump Header"
+}
+
+// MarshalMIDI marshalls a SampleDumpHeaderMessage MIDI message into its raw bytes
+func (sdhm SampleDumpHeaderMessage) MarshalMIDI() ([]byte, error) {
+	if err := sdhm.validate(); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, ErrMarshallingMessage)
+	}
+
+	data := make([]byte, 0, sampleDumpHeaderDataLength)
+	data = appendSevenBitValue(data, uint32(sdhm.SampleNumber), 2)
+	data = append(data, byte(sdhm.BitsPerSample))
+	data = appendSevenBitValue(data, sdhm.SamplePeriod, 3)
+	data = appendSevenBitValue(data, sdhm.Length, 3)
+	data = appendSevenBitValue(data, sdhm.LoopStart, 3)
+	data = appendSevenBitValue(data, sdhm.LoopEnd, 3)
+	data = append(data, byte(sdhm.LoopType))
+	return marshalUniversalMessage(UniversalNonRealTimeManufacturerID, sdhm.DeviceID, []byte{SampleDumpHeaderSubID}, data)
+}
+
+// SampleRate returns the sample rate in hertz, based on the sample period.
+func (sdhm SampleDumpHeaderMessage) SampleRate() float64 {
+	if sdhm.SamplePeriod == 0 {
+		return 0
+	}
+	return 1e9 / float64(sdhm.SamplePeriod)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (sdhm *SampleDumpHeaderMessage) String() string {
+	return fmt.Sprintf(SampleDumpHeaderMessageStringFormat, MessageVersion, sdhm.GetMessageName(), sdhm.DeviceID, sdhm.SampleNumber, sdhm.BitsPerSample, sdhm.SamplePeriod, sdhm.Length, sdhm.LoopStart, sdhm.LoopEnd, byte(sdhm.LoopType))
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a SampleDumpHeaderMessage struct pointer. Sample Dump Header messages are
+// represented by 21 bytes (left to right): 0xF0, 0x7E, device ID, 0x01, two sample number bytes, bits per sample,
+// three sample period bytes, three length bytes, three loop start bytes, three loop end bytes, loop type, 0xF7. The
+// multi-byte values are sent 7 bits at a time, LSB first.
+//
+// Example: []byte{0xF0, 0x7E, 0x00, 0x01, 0x05, 0x00, 0x10, 0x13, 0x31, 0x01, 0x00, 0x10, 0x00, 0x00, 0x00, 0x00, 0x7F, 0x0F, 0x00, 0x00, 0xF7}
+//
+// The example forms a Sample Dump Header message for sample 5: 16-bit, 22675 nanoseconds per sample (about 44.1kHz),
+// 2048 words long, looping forwards over the whole sample.
+func (sdhm *SampleDumpHeaderMessage) UnmarshalMIDI(b []byte) error {
+	deviceID, data, err := unmarshalUniversalMessage("sample dump header", UniversalNonRealTimeManufacturerID, []byte{SampleDumpHeaderSubID}, sampleDumpHeaderDataLength, b)
+	if err != nil {
+		return err
+	}
+
+	header := SampleDumpHeaderMessage{
+		DeviceID:      deviceID,
+		SampleNumber:  int(parseSevenBitValue(data[0:2])),
+		BitsPerSample: int(data[2]),
+		SamplePeriod:  parseSevenBitValue(data[3:6]),
+		Length:        parseSevenBitValue(data[6:9]),
+		LoopStart:     parseSevenBitValue(data[9:12]),
+		LoopEnd:       parseSevenBitValue(data[12:15]),
+		LoopType:      SampleLoopType(data[15]),
+	}
+	if err := header.validate(); err != nil {
+		return fmt.Errorf("%v: %w", err, ErrUnmarshallingMessage)
+	}
+	*sdhm = header
+	return nil
+}
+
+// validate returns an error if any field of the header is out of range.
+func (sdhm SampleDumpHeaderMessage) validate() error {
+	switch {
+	case sdhm.SampleNumber < 0 || sdhm.SampleNumber > MaxSampleNumber:
+		return fmt.Errorf("sample numbers must be between 0 and %d, inclusive, received %d", MaxSampleNumber, sdhm.SampleNumber)
+	case sdhm.BitsPerSample < MinSampleBitsPerSample || sdhm.BitsPerSample > MaxSampleBitsPerSample:
+		return fmt.Errorf("sample resolutions must be between %d and %d bits, inclusive, received %d", MinSampleBitsPerSample, MaxSampleBitsPerSample, sdhm.BitsPerSample)
+	case sdhm.SamplePeriod > MaxSampleDumpValue, sdhm.Length > MaxSampleDumpValue, sdhm.LoopStart > MaxSampleDumpValue, sdhm.LoopEnd > MaxSampleDumpValue:
+		return fmt.Errorf("sample periods, lengths and loop points must be at most %#x", MaxSampleDumpValue)
+	}
+	switch sdhm.LoopType {
+	case ForwardSampleLoopType, AlternatingSampleLoopType, LoopOffSampleLoopType:
+		return nil
+	}
+	return fmt.Errorf("sample loop types must be %#x, %#x or %#x, received %#x", ForwardSampleLoopType, AlternatingSampleLoopType, LoopOffSampleLoopType, byte(sdhm.LoopType))
+}
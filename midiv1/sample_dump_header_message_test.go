@@ -0,0 +1,122 @@
+package midiv1
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_SampleDumpHeaderMessage_GetMessageName(t *testing.T) {
+	t.Parallel()
+	message := SampleDumpHeaderMessage{}
+	expected := "Sample Dump Header"
+	if message.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.GetMessageName())
+	}
+}
+
+func Test_SampleDumpHeaderMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  SampleDumpHeaderMessage
+		expected []byte
+		err      error
+	}{
+		"bits per sample is too low": {
+			message: SampleDumpHeaderMessage{BitsPerSample: 7},
+			err:     ErrMarshallingMessage,
+		},
+		"sample number is out of range": {
+			message: SampleDumpHeaderMessage{SampleNumber: 0x4000, BitsPerSample: 16},
+			err:     ErrMarshallingMessage,
+		},
+		"length is out of range": {
+			message: SampleDumpHeaderMessage{BitsPerSample: 16, Length: 0x200000},
+			err:     ErrMarshallingMessage,
+		},
+		"loop type is invalid": {
+			message: SampleDumpHeaderMessage{BitsPerSample: 16, LoopType: 0x02},
+			err:     ErrMarshallingMessage,
+		},
+		"message marshalls into expected bytes": {
+			message: SampleDumpHeaderMessage{
+				SampleNumber:  5,
+				BitsPerSample: 16,
+				SamplePeriod:  22675,
+				Length:        2048,
+				LoopEnd:       2047,
+				LoopType:      ForwardSampleLoopType,
+			},
+			expected: []byte{0xF0, 0x7E, 0x00, 0x01, 0x05, 0x00, 0x10, 0x13, 0x31, 0x01, 0x00, 0x10, 0x00, 0x00, 0x00, 0x00, 0x7F, 0x0F, 0x00, 0x00, 0xF7},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_SampleDumpHeaderMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage SampleDumpHeaderMessage
+		err             error
+	}{
+		"message is too short": {
+			b:   []byte{0xF0, 0x7E, 0x00, 0x01, 0x05, 0x00, 0x10, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"loop type is invalid": {
+			b:   []byte{0xF0, 0x7E, 0x00, 0x01, 0x05, 0x00, 0x10, 0x13, 0x31, 0x01, 0x00, 0x10, 0x00, 0x00, 0x00, 0x00, 0x7F, 0x0F, 0x00, 0x02, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b: []byte{0xF0, 0x7E, 0x00, 0x01, 0x05, 0x00, 0x10, 0x13, 0x31, 0x01, 0x00, 0x10, 0x00, 0x00, 0x00, 0x00, 0x7F, 0x0F, 0x00, 0x00, 0xF7},
+			expectedMessage: SampleDumpHeaderMessage{
+				SampleNumber:  5,
+				BitsPerSample: 16,
+				SamplePeriod:  22675,
+				Length:        2048,
+				LoopEnd:       2047,
+				LoopType:      ForwardSampleLoopType,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got SampleDumpHeaderMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
+
+func Test_SampleDumpHeaderMessage_SampleRate(t *testing.T) {
+	t.Parallel()
+	message := SampleDumpHeaderMessage{SamplePeriod: 20000}
+	if message.SampleRate() != 50000 {
+		t.Fatalf("expected 50000, got %v", message.SampleRate())
+	}
+}
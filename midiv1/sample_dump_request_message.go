@@ -0,0 +1,55 @@
+package midiv1
+
+import "fmt"
+
+const (
+	// SampleDumpRequestMessageStringFormat represents the printf-compatible format specifically for a Sample Dump Request message string.
+	SampleDumpRequestMessageStringFormat string = "%s:%s:%d:%d"
+)
+
+// SampleDumpRequestMessage represents a Sample Dump Request Universal Non-Real-Time System Exclusive message, which
+// asks a device to send a sample with a Sample Dump Header message followed by Sample Dump Data Packet messages.
+type SampleDumpRequestMessage struct {
+	// DeviceID represents the device that should send the dump.
+	DeviceID DeviceID
+
+	// SampleNumber represents the number of the requested sample, between 0 and 0x3FFF inclusive.
+	SampleNumber int
+}
+
+// GetMessageName returns the name of this Sample Dump Request message.
+func (sdrm *SampleDumpRequestMessage) GetMessageName() string {
+	return "Sample Dump Request"
+}
+
+// MarshalMIDI marshalls a SampleDumpRequestMessage MIDI message into its raw bytes
+func (sdrm SampleDumpRequestMessage) MarshalMIDI() ([]byte, error) {
+	if sdrm.SampleNumber < 0 || sdrm.SampleNumber > MaxSampleNumber {
+		return nil, fmt.Errorf("sample numbers must be between 0 and %d, inclusive, received %d: %w", MaxSampleNumber, sdrm.SampleNumber, ErrMarshallingMessage)
+	}
+	return marshalUniversalMessage(UniversalNonRealTimeManufacturerID, sdrm.DeviceID, []byte{SampleDumpRequestSubID}, appendSevenBitValue(nil, uint32(sdrm.SampleNumber), 2))
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (sdrm *SampleDumpRequestMessage) String() string {
+	return fmt.Sprintf(SampleDumpRequestMessageStringFormat, MessageVersion, sdrm.GetMessageName(), sdrm.DeviceID, sdrm.SampleNumber)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a SampleDumpRequestMessage struct pointer. Sample Dump Request messages are
+// represented by seven bytes (left to right): 0xF0, 0x7E, device ID, 0x03, sample number LSB, sample number MSB, 0xF7.
+//
+// Example: []byte{0xF0, 0x7E, 0x00, 0x03, 0x05, 0x01, 0xF7}
+//
+// The example forms a Sample Dump Request message to device 0 for sample 133.
+func (sdrm *SampleDumpRequestMessage) UnmarshalMIDI(b []byte) error {
+	deviceID, data, err := unmarshalUniversalMessage("sample dump request", UniversalNonRealTimeManufacturerID, []byte{SampleDumpRequestSubID}, 2, b)
+	if err != nil {
+		return err
+	}
+
+	*sdrm = SampleDumpRequestMessage{
+		DeviceID:     deviceID,
+		SampleNumber: int(parseSevenBitValue(data)),
+	}
+	return nil
+}
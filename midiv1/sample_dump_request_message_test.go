@@ -0,0 +1,84 @@
+package midiv1
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_SampleDumpRequestMessage_GetMessageName(t *testing.T) {
+	t.Parallel()
+	message := SampleDumpRequestMessage{}
+	expected := "Sample Dump Request"
+	if message.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.GetMessageName())
+	}
+}
+
+func Test_SampleDumpRequestMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  SampleDumpRequestMessage
+		expected []byte
+		err      error
+	}{
+		"sample number is out of range": {
+			message: SampleDumpRequestMessage{SampleNumber: 0x4000},
+			err:     ErrMarshallingMessage,
+		},
+		"message marshalls into expected bytes": {
+			message:  SampleDumpRequestMessage{SampleNumber: 133},
+			expected: []byte{0xF0, 0x7E, 0x00, 0x03, 0x05, 0x01, 0xF7},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_SampleDumpRequestMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage SampleDumpRequestMessage
+		err             error
+	}{
+		"sample number is missing": {
+			b:   []byte{0xF0, 0x7E, 0x00, 0x03, 0x05, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b:               []byte{0xF0, 0x7E, 0x00, 0x03, 0x05, 0x01, 0xF7},
+			expectedMessage: SampleDumpRequestMessage{SampleNumber: 133},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got SampleDumpRequestMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
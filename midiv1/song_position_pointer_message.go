@@ -0,0 +1,85 @@
+package midiv1
+
+import "fmt"
+
+const (
+	// SongPositionPointerMessageStatus represents the status byte of a Song Position Pointer message.
+	SongPositionPointerMessageStatus byte = 0xF2
+
+	// SongPositionPointerMessageLength represents the number of bytes in a full Song Position Pointer message.
+	SongPositionPointerMessageLength int = 3
+
+	// SongPositionPointerMessageStringFormat represents the printf-compatible format specifically for a Song Position Pointer message string.
+	SongPositionPointerMessageStringFormat string = "%s:%s:%d"
+
+	// ClocksPerMIDIBeat represents the number of Timing Clock messages in a MIDI beat (a sixteenth note).
+	ClocksPerMIDIBeat int = 6
+)
+
+// SongPositionPointerMessage represents a Song Position Pointer System Common message, which moves a sequencer to a
+// position within the current song.
+type SongPositionPointerMessage struct {
+	// Beats represents the number of MIDI beats (sixteenth notes) since the start of the song.
+	Beats FourteenBitValue
+}
+
+// NewSongPositionPointerMessageFromClocks returns a SongPositionPointerMessage for the MIDI beat that contains the
+// supplied number of Timing Clocks since the start of the song. Positions beyond the range of the message are clamped.
+func NewSongPositionPointerMessageFromClocks(clocks int) SongPositionPointerMessage {
+	return SongPositionPointerMessage{
+		Beats: NewFourteenBitValue(clocks / ClocksPerMIDIBeat),
+	}
+}
+
+// Clocks returns the number of Timing Clocks between the start of the song and the position.
+func (sppm SongPositionPointerMessage) Clocks() int {
+	return int(sppm.Beats) * ClocksPerMIDIBeat
+}
+
+// GetMessageName returns the name of this Song Position Pointer message.
+func (sppm *SongPositionPointerMessage) GetMessageName() string {
+	return "Song Position Pointer"
+}
+
+// MarshalMIDI marshalls a SongPositionPointerMessage MIDI message into its raw bytes
+func (sppm SongPositionPointerMessage) MarshalMIDI() ([]byte, error) {
+	if sppm.Beats > MaxFourteenBitValue {
+		return nil, fmt.Errorf("song positions must be between %d and %d MIDI beats, inclusive, received %d: %w", MinFourteenBitValue, MaxFourteenBitValue, sppm.Beats, ErrMarshallingMessage)
+	}
+	return []byte{
+		SongPositionPointerMessageStatus,
+		sppm.Beats.GetLSB(),
+		sppm.Beats.GetMSB(),
+	}, nil
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (sppm *SongPositionPointerMessage) String() string {
+	return fmt.Sprintf(SongPositionPointerMessageStringFormat, MessageVersion, sppm.GetMessageName(), sppm.Beats)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a SongPositionPointerMessage struct pointer. Song Position Pointer messages
+// are represented by three bytes (left to right): 0xF2, beats LSB, beats MSB.
+//
+// Example: []byte{0xF2, 0b00000000, 0b00000001}
+//
+// The example forms a Song Position Pointer message for MIDI beat 128 (the start of bar 9 in 4/4).
+func (sppm *SongPositionPointerMessage) UnmarshalMIDI(b []byte) error {
+	// check the number of bytes in the message
+	if len(b) != SongPositionPointerMessageLength {
+		return fmt.Errorf("song position pointer messages are made up of %d bytes, received %d byte(s): %w", SongPositionPointerMessageLength, len(b), ErrUnmarshallingMessage)
+	}
+
+	// make sure this is the proper status byte followed by data bytes
+	if b[0] != SongPositionPointerMessageStatus {
+		return fmt.Errorf("song position pointer messages must have status byte %#x, received %#x: %w", SongPositionPointerMessageStatus, b[0], ErrUnmarshallingMessage)
+	}
+	if ByteHasStatusMSB(b[1]) || ByteHasStatusMSB(b[2]) {
+		return fmt.Errorf("song position pointer messages must have data bytes, received %#x and %#x: %w", b[1], b[2], ErrUnmarshallingMessage)
+	}
+
+	*sppm = SongPositionPointerMessage{
+		Beats: NewFourteenBitValueFromBytes(b[2], b[1]),
+	}
+	return nil
+}
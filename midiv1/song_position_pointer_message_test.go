@@ -0,0 +1,103 @@
+package midiv1
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_SongPositionPointerMessage_GetMessageName(t *testing.T) {
+	t.Parallel()
+	message := SongPositionPointerMessage{}
+	expected := "Song Position Pointer"
+	if message.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.GetMessageName())
+	}
+}
+
+func Test_SongPositionPointerMessage_Clocks(t *testing.T) {
+	t.Parallel()
+	message := NewSongPositionPointerMessageFromClocks(100)
+	if message.Beats != 16 {
+		t.Fatalf("expected 16 beats, got %d", message.Beats)
+	}
+	if message.Clocks() != 96 {
+		t.Fatalf("expected 96 clocks, got %d", message.Clocks())
+	}
+}
+
+func Test_SongPositionPointerMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  SongPositionPointerMessage
+		expected []byte
+		err      error
+	}{
+		"beats are out of range": {
+			message: SongPositionPointerMessage{Beats: 0x4000},
+			err:     ErrMarshallingMessage,
+		},
+		"message marshalls into expected bytes": {
+			message:  SongPositionPointerMessage{Beats: 0x0180},
+			expected: []byte{0xF2, 0x00, 0x03},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_SongPositionPointerMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage SongPositionPointerMessage
+		err             error
+	}{
+		"byte slice is not proper length": {
+			b:   []byte{0xF2, 0x00},
+			err: ErrUnmarshallingMessage,
+		},
+		"status byte is not song position pointer": {
+			b:   []byte{0xF1, 0x00, 0x01},
+			err: ErrUnmarshallingMessage,
+		},
+		"data byte has a status MSB": {
+			b:   []byte{0xF2, 0x00, 0x81},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b:               []byte{0xF2, 0x7F, 0x7F},
+			expectedMessage: SongPositionPointerMessage{Beats: MaxFourteenBitValue},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got SongPositionPointerMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package midiv1
+
+import "fmt"
+
+const (
+	// SongSelectMessageStatus represents the status byte of a Song Select message.
+	SongSelectMessageStatus byte = 0xF3
+
+	// SongSelectMessageLength represents the number of bytes in a full Song Select message.
+	SongSelectMessageLength int = 2
+
+	// SongSelectMessageStringFormat represents the printf-compatible format specifically for a Song Select message string.
+	SongSelectMessageStringFormat string = "%s:%s:%d"
+
+	// MaxSong represents the highest song number available.
+	MaxSong byte = 0x7F
+)
+
+// SongSelectMessage represents a Song Select System Common message, which chooses the song or sequence to be played.
+type SongSelectMessage struct {
+	// Song represents the song number, between 0 and 127 inclusive.
+	Song byte
+}
+
+// GetMessageName returns the name of this Song Select message.
+func (ssm *SongSelectMessage) GetMessageName() string {
+	return "Song Select"
+}
+
+// MarshalMIDI marshalls a SongSelectMessage MIDI message into its raw bytes
+func (ssm SongSelectMessage) MarshalMIDI() ([]byte, error) {
+	if ssm.Song > MaxSong {
+		return nil, fmt.Errorf("song numbers must be between 0 and %d, inclusive, received %d: %w", MaxSong, ssm.Song, ErrMarshallingMessage)
+	}
+	return []byte{
+		SongSelectMessageStatus,
+		ssm.Song,
+	}, nil
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (ssm *SongSelectMessage) String() string {
+	return fmt.Sprintf(SongSelectMessageStringFormat, MessageVersion, ssm.GetMessageName(), ssm.Song)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a SongSelectMessage struct pointer. Song Select messages are represented by
+// two bytes (left to right): 0xF3, song number.
+//
+// Example: []byte{0xF3, 0b00000101}
+//
+// The example forms a Song Select message for song number 5.
+func (ssm *SongSelectMessage) UnmarshalMIDI(b []byte) error {
+	// check the number of bytes in the message
+	if len(b) != SongSelectMessageLength {
+		return fmt.Errorf("song select messages are made up of %d bytes, received %d byte(s): %w", SongSelectMessageLength, len(b), ErrUnmarshallingMessage)
+	}
+
+	// make sure this is the proper status byte followed by a data byte
+	if b[0] != SongSelectMessageStatus {
+		return fmt.Errorf("song select messages must have status byte %#x, received %#x: %w", SongSelectMessageStatus, b[0], ErrUnmarshallingMessage)
+	}
+	if ByteHasStatusMSB(b[1]) {
+		return fmt.Errorf("song select messages must have a data byte, received %#x: %w", b[1], ErrUnmarshallingMessage)
+	}
+
+	*ssm = SongSelectMessage{
+		Song: b[1],
+	}
+	return nil
+}
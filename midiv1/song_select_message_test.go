@@ -0,0 +1,92 @@
+package midiv1
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_SongSelectMessage_GetMessageName(t *testing.T) {
+	t.Parallel()
+	message := SongSelectMessage{}
+	expected := "Song Select"
+	if message.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.GetMessageName())
+	}
+}
+
+func Test_SongSelectMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  SongSelectMessage
+		expected []byte
+		err      error
+	}{
+		"song is out of range": {
+			message: SongSelectMessage{Song: 0x80},
+			err:     ErrMarshallingMessage,
+		},
+		"message marshalls into expected bytes": {
+			message:  SongSelectMessage{Song: 5},
+			expected: []byte{0xF3, 0x05},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_SongSelectMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage SongSelectMessage
+		err             error
+	}{
+		"byte slice is not proper length": {
+			b:   []byte{0xF3, 0x05, 0x00},
+			err: ErrUnmarshallingMessage,
+		},
+		"status byte is not song select": {
+			b:   []byte{0xF1, 0x05},
+			err: ErrUnmarshallingMessage,
+		},
+		"data byte has a status MSB": {
+			b:   []byte{0xF3, 0x85},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b:               []byte{0xF3, 0x05},
+			expectedMessage: SongSelectMessage{Song: 5},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got SongSelectMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
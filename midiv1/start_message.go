@@ -0,0 +1,41 @@
+package midiv1
+
+import "fmt"
+
+const (
+	// StartMessageStatus represents the status byte of a Start message.
+	StartMessageStatus byte = 0xFA
+
+	// StartMessageLength represents the number of bytes in a full Start message.
+	StartMessageLength int = 1
+)
+
+// StartMessage represents a Start System Real-Time message, which starts playback from the beginning of the current song.
+type StartMessage struct{}
+
+// GetMessageName returns the name of this Start message.
+func (sm *StartMessage) GetMessageName() string {
+	return "Start"
+}
+
+// MarshalMIDI marshalls a StartMessage MIDI message into its raw bytes
+func (sm StartMessage) MarshalMIDI() ([]byte, error) {
+	return []byte{
+		StartMessageStatus,
+	}, nil
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (sm *StartMessage) String() string {
+	return fmt.Sprintf(SystemMessageStringFormat, MessageVersion, sm.GetMessageName())
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a StartMessage struct pointer. Start messages are represented
+// by a single byte: 0xFA.
+//
+// Example: []byte{0xFA}
+//
+// The example forms a Start message.
+func (sm *StartMessage) UnmarshalMIDI(b []byte) error {
+	return unmarshalStatusOnlyMessage("start", StartMessageStatus, b)
+}
@@ -0,0 +1,41 @@
+package midiv1
+
+import "fmt"
+
+const (
+	// StopMessageStatus represents the status byte of a Stop message.
+	StopMessageStatus byte = 0xFC
+
+	// StopMessageLength represents the number of bytes in a full Stop message.
+	StopMessageLength int = 1
+)
+
+// StopMessage represents a Stop System Real-Time message, which stops playback without moving the current song position.
+type StopMessage struct{}
+
+// GetMessageName returns the name of this Stop message.
+func (sm *StopMessage) GetMessageName() string {
+	return "Stop"
+}
+
+// MarshalMIDI marshalls a StopMessage MIDI message into its raw bytes
+func (sm StopMessage) MarshalMIDI() ([]byte, error) {
+	return []byte{
+		StopMessageStatus,
+	}, nil
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (sm *StopMessage) String() string {
+	return fmt.Sprintf(SystemMessageStringFormat, MessageVersion, sm.GetMessageName())
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a StopMessage struct pointer. Stop messages are represented
+// by a single byte: 0xFC.
+//
+// Example: []byte{0xFC}
+//
+// The example forms a Stop message.
+func (sm *StopMessage) UnmarshalMIDI(b []byte) error {
+	return unmarshalStatusOnlyMessage("stop", StopMessageStatus, b)
+}
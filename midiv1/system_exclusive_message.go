@@ -0,0 +1,104 @@
+package midiv1
+
+import "fmt"
+
+const (
+	// SystemExclusiveMessageStatus represents the status byte that starts a System Exclusive message.
+	SystemExclusiveMessageStatus byte = 0xF0
+
+	// EndOfExclusiveStatus represents the End of Exclusive (EOX) status byte that ends a System Exclusive message.
+	EndOfExclusiveStatus byte = 0xF7
+
+	// SystemExclusiveMessageMinLength represents the number of bytes in the shortest System Exclusive message: the
+	// status byte, a one-byte manufacturer ID and the EOX byte.
+	SystemExclusiveMessageMinLength int = 3
+
+	// SystemExclusiveMessageStringFormat represents the printf-compatible format specifically for a System Exclusive message string.
+	SystemExclusiveMessageStringFormat string = "%s:%s:%s:% X"
+)
+
+// SystemExclusiveMessage represents a System Exclusive message, which carries manufacturer-specific data such as patch
+// dumps and parameter changes.
+type SystemExclusiveMessage struct {
+	// Manufacturer represents the manufacturer ID that the data is intended for.
+	Manufacturer ManufacturerID
+
+	// Data represents the data bytes of the message between the manufacturer ID and the EOX byte.
+	Data []byte
+}
+
+// GetMessageName returns the name of this System Exclusive message.
+func (sem *SystemExclusiveMessage) GetMessageName() string {
+	return "System Exclusive"
+}
+
+// MarshalMIDI marshalls a SystemExclusiveMessage MIDI message into its raw bytes
+func (sem SystemExclusiveMessage) MarshalMIDI() ([]byte, error) {
+	if err := sem.Manufacturer.validate(); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, ErrMarshallingMessage)
+	}
+	if err := validateSystemExclusiveData(sem.Data); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, ErrMarshallingMessage)
+	}
+
+	b := make([]byte, 0, SystemExclusiveMessageMinLength+2+len(sem.Data))
+	b = append(b, SystemExclusiveMessageStatus)
+	b = append(b, sem.Manufacturer.Bytes()...)
+	b = append(b, sem.Data...)
+	return append(b, EndOfExclusiveStatus), nil
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (sem *SystemExclusiveMessage) String() string {
+	return fmt.Sprintf(SystemExclusiveMessageStringFormat, MessageVersion, sem.GetMessageName(), sem.Manufacturer, sem.Data)
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a SystemExclusiveMessage struct pointer. System Exclusive messages are
+// represented by (left to right): 0xF0, one-byte or three-byte manufacturer ID, any number of data bytes, 0xF7.
+//
+// Example: []byte{0xF0, 0x41, 0x10, 0x42, 0x12, 0xF7}
+//
+// The example forms a System Exclusive message for Roland (0x41) with the data bytes 0x10, 0x42, 0x12.
+func (sem *SystemExclusiveMessage) UnmarshalMIDI(b []byte) error {
+	// check the number of bytes in the message
+	if len(b) < SystemExclusiveMessageMinLength {
+		return fmt.Errorf("system exclusive messages are made up of at least %d bytes, received %d byte(s): %w", SystemExclusiveMessageMinLength, len(b), ErrUnmarshallingMessage)
+	}
+
+	// make sure the message is framed by the System Exclusive and EOX status bytes
+	if b[0] != SystemExclusiveMessageStatus {
+		return fmt.Errorf("system exclusive messages must start with %#x, received %#x: %w", SystemExclusiveMessageStatus, b[0], ErrUnmarshallingMessage)
+	}
+	if b[len(b)-1] != EndOfExclusiveStatus {
+		return fmt.Errorf("system exclusive messages must end with %#x, received %#x: %w", EndOfExclusiveStatus, b[len(b)-1], ErrUnmarshallingMessage)
+	}
+
+	// form the manufacturer ID
+	body := b[1 : len(b)-1]
+	manufacturer, n, err := ParseManufacturerID(body)
+	if err != nil {
+		return fmt.Errorf("invalid manufacturer ID (%v): %w", err, ErrUnmarshallingMessage)
+	}
+
+	// make sure every remaining byte is a data byte
+	data := body[n:]
+	if err := validateSystemExclusiveData(data); err != nil {
+		return fmt.Errorf("%v: %w", err, ErrUnmarshallingMessage)
+	}
+
+	*sem = SystemExclusiveMessage{
+		Manufacturer: manufacturer,
+		Data:         append([]byte(nil), data...),
+	}
+	return nil
+}
+
+// validateSystemExclusiveData returns an error if any of the bytes has a status MSB.
+func validateSystemExclusiveData(data []byte) error {
+	for i, b := range data {
+		if ByteHasStatusMSB(b) {
+			return fmt.Errorf("system exclusive data bytes must not have a status MSB, received %#x at index %d", b, i)
+		}
+	}
+	return nil
+}
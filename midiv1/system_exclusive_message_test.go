@@ -0,0 +1,164 @@
+package midiv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func Test_SystemExclusiveMessage_GetMessageName(t *testing.T) {
+	t.Parallel()
+	message := SystemExclusiveMessage{}
+	expected := "System Exclusive"
+	if message.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.GetMessageName())
+	}
+}
+
+func Test_SystemExclusiveMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  SystemExclusiveMessage
+		expected []byte
+		err      error
+	}{
+		"data byte has a status MSB": {
+			message: SystemExclusiveMessage{
+				Manufacturer: RolandManufacturerID,
+				Data:         []byte{0x10, 0xF7, 0x12},
+			},
+			err: ErrMarshallingMessage,
+		},
+		"manufacturer ID has a status MSB": {
+			message: SystemExclusiveMessage{
+				Manufacturer: ManufacturerID{0x00, 0x80, 0x01},
+			},
+			err: ErrMarshallingMessage,
+		},
+		"manufacturer ID is unset": {
+			message: SystemExclusiveMessage{
+				Data: []byte{0x10, 0x42, 0x12},
+			},
+			err: ErrMarshallingMessage,
+		},
+		"one-byte manufacturer ID has trailing bytes": {
+			message: SystemExclusiveMessage{
+				Manufacturer: ManufacturerID{0x41, 0x01},
+			},
+			err: ErrMarshallingMessage,
+		},
+		"message with one-byte manufacturer ID marshalls into expected bytes": {
+			message: SystemExclusiveMessage{
+				Manufacturer: RolandManufacturerID,
+				Data:         []byte{0x10, 0x42, 0x12},
+			},
+			expected: []byte{0xF0, 0x41, 0x10, 0x42, 0x12, 0xF7},
+		},
+		"message with three-byte manufacturer ID marshalls into expected bytes": {
+			message: SystemExclusiveMessage{
+				Manufacturer: NovationManufacturerID,
+				Data:         []byte{0x01},
+			},
+			expected: []byte{0xF0, 0x00, 0x20, 0x29, 0x01, 0xF7},
+		},
+		"message without data marshalls into expected bytes": {
+			message: SystemExclusiveMessage{
+				Manufacturer: YamahaManufacturerID,
+			},
+			expected: []byte{0xF0, 0x43, 0xF7},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_SystemExclusiveMessage_String(t *testing.T) {
+	t.Parallel()
+	message := SystemExclusiveMessage{
+		Manufacturer: KorgManufacturerID,
+		Data:         []byte{0x30, 0x00},
+	}
+	expected := fmt.Sprintf("%s:%s:%s:%s", MessageVersion, "System Exclusive", "Korg", "30 00")
+	if message.String() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.String())
+	}
+}
+
+func Test_SystemExclusiveMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage SystemExclusiveMessage
+		err             error
+	}{
+		"byte slice is too short": {
+			b:   []byte{0xF0, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"first byte is not 0xF0": {
+			b:   []byte{0xF1, 0x41, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"last byte is not EOX": {
+			b:   []byte{0xF0, 0x41, 0x10},
+			err: ErrUnmarshallingMessage,
+		},
+		"three-byte manufacturer ID is cut short": {
+			b:   []byte{0xF0, 0x00, 0x20, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"manufacturer ID is reserved": {
+			b:   []byte{0xF0, 0x00, 0x00, 0x00, 0x01, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"data byte has a status MSB": {
+			b:   []byte{0xF0, 0x41, 0x10, 0x90, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes with one-byte manufacturer ID unmarshal into expected message": {
+			b: []byte{0xF0, 0x41, 0x10, 0x42, 0x12, 0xF7},
+			expectedMessage: SystemExclusiveMessage{
+				Manufacturer: RolandManufacturerID,
+				Data:         []byte{0x10, 0x42, 0x12},
+			},
+		},
+		"bytes with three-byte manufacturer ID unmarshal into expected message": {
+			b: []byte{0xF0, 0x00, 0x20, 0x3C, 0x01, 0x02, 0xF7},
+			expectedMessage: SystemExclusiveMessage{
+				Manufacturer: ElektronManufacturerID,
+				Data:         []byte{0x01, 0x02},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got SystemExclusiveMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package midiv1
+
+import "fmt"
+
+const (
+	// SystemResetMessageStatus represents the status byte of a System Reset message.
+	SystemResetMessageStatus byte = 0xFF
+
+	// SystemResetMessageLength represents the number of bytes in a full System Reset message.
+	SystemResetMessageLength int = 1
+)
+
+// SystemResetMessage represents a System Reset System Real-Time message, which asks every receiver to return to its power-up state.
+type SystemResetMessage struct{}
+
+// GetMessageName returns the name of this System Reset message.
+func (srm *SystemResetMessage) GetMessageName() string {
+	return "System Reset"
+}
+
+// MarshalMIDI marshalls a SystemResetMessage MIDI message into its raw bytes
+func (srm SystemResetMessage) MarshalMIDI() ([]byte, error) {
+	return []byte{
+		SystemResetMessageStatus,
+	}, nil
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (srm *SystemResetMessage) String() string {
+	return fmt.Sprintf(SystemMessageStringFormat, MessageVersion, srm.GetMessageName())
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a SystemResetMessage struct pointer. System Reset messages are represented
+// by a single byte: 0xFF.
+//
+// Example: []byte{0xFF}
+//
+// The example forms a System Reset message.
+func (srm *SystemResetMessage) UnmarshalMIDI(b []byte) error {
+	return unmarshalStatusOnlyMessage("system reset", SystemResetMessageStatus, b)
+}
@@ -0,0 +1,90 @@
+package midiv1
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidTimeCode represents an invalid SMPTE time code.
+	ErrInvalidTimeCode error = errors.New("invalid MIDI time code")
+)
+
+// TimeCodeRate represents the SMPTE frame rate carried within the hours byte of MIDI Time Code messages.
+type TimeCodeRate byte
+
+const (
+	// TimeCodeRate24 represents 24 frames per second (film).
+	TimeCodeRate24 TimeCodeRate = 0b00
+
+	// TimeCodeRate25 represents 25 frames per second (PAL video).
+	TimeCodeRate25 TimeCodeRate = 0b01
+
+	// TimeCodeRate2997DropFrame represents 29.97 frames per second with drop-frame counting (NTSC video).
+	TimeCodeRate2997DropFrame TimeCodeRate = 0b10
+
+	// TimeCodeRate30 represents 30 frames per second (non-drop).
+	TimeCodeRate30 TimeCodeRate = 0b11
+)
+
+// FramesPerSecond returns the number of frames counted in each second of time code. Drop-frame time code counts 30
+// frames per second even though it runs at 29.97 frames per second.
+func (r TimeCodeRate) FramesPerSecond() int {
+	switch r {
+	case TimeCodeRate24:
+		return 24
+	case TimeCodeRate25:
+		return 25
+	}
+	return 30
+}
+
+// IsDropFrame returns whether the rate skips frame numbers to stay in step with 29.97 frames per second.
+func (r TimeCodeRate) IsDropFrame() bool {
+	return r == TimeCodeRate2997DropFrame
+}
+
+// String returns the human-readable representation of the rate.
+func (r TimeCodeRate) String() string {
+	switch r {
+	case TimeCodeRate24:
+		return "24"
+	case TimeCodeRate25:
+		return "25"
+	case TimeCodeRate2997DropFrame:
+		return "29.97DF"
+	case TimeCodeRate30:
+		return "30"
+	}
+	return fmt.Sprintf("%#x", byte(r))
+}
+
+// validateTimeCode returns an error if the time code is out of range at the supplied rate, including drop-frame frame
+// numbers that are skipped.
+func validateTimeCode(rate TimeCodeRate, hours, minutes, seconds, frames int) error {
+	switch {
+	case rate > TimeCodeRate30:
+		return fmt.Errorf("time code rates must be between 0 and 3, inclusive, received %d: %w", rate, ErrInvalidTimeCode)
+	case hours < 0 || hours > 23:
+		return fmt.Errorf("time code hours must be between 0 and 23, inclusive, received %d: %w", hours, ErrInvalidTimeCode)
+	case minutes < 0 || minutes > 59:
+		return fmt.Errorf("time code minutes must be between 0 and 59, inclusive, received %d: %w", minutes, ErrInvalidTimeCode)
+	case seconds < 0 || seconds > 59:
+		return fmt.Errorf("time code seconds must be between 0 and 59, inclusive, received %d: %w", seconds, ErrInvalidTimeCode)
+	case frames < 0 || frames >= rate.FramesPerSecond():
+		return fmt.Errorf("time code frames must be between 0 and %d, inclusive, received %d: %w", rate.FramesPerSecond()-1, frames, ErrInvalidTimeCode)
+	case rate.IsDropFrame() && seconds == 0 && frames < 2 && minutes%10 != 0:
+		return fmt.Errorf("drop-frame time code skips frames 0 and 1 at the start of minute %d: %w", minutes, ErrInvalidTimeCode)
+	}
+	return nil
+}
+
+// makeTimeCodeHoursByte returns the hours byte of an MTC message, which carries the rate in bits 5 and 6.
+func makeTimeCodeHoursByte(rate TimeCodeRate, hours int) byte {
+	return byte(rate)<<5 | byte(hours)&0x1F
+}
+
+// parseTimeCodeHoursByte returns the rate and hours carried within the hours byte of an MTC message.
+func parseTimeCodeHoursByte(b byte) (TimeCodeRate, int) {
+	return TimeCodeRate(b>>5) & 0b11, int(b & 0x1F)
+}
@@ -0,0 +1,84 @@
+package midiv1
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_TimeCodeRate_FramesPerSecond(t *testing.T) {
+	t.Parallel()
+	tests := map[TimeCodeRate]struct {
+		expectedFramesPerSecond int
+		expectedDropFrame       bool
+		expectedString          string
+	}{
+		TimeCodeRate24:            {expectedFramesPerSecond: 24, expectedString: "24"},
+		TimeCodeRate25:            {expectedFramesPerSecond: 25, expectedString: "25"},
+		TimeCodeRate2997DropFrame: {expectedFramesPerSecond: 30, expectedDropFrame: true, expectedString: "29.97DF"},
+		TimeCodeRate30:            {expectedFramesPerSecond: 30, expectedString: "30"},
+	}
+
+	for rate, test := range tests {
+		t.Run(test.expectedString, func(t *testing.T) {
+			if got := rate.FramesPerSecond(); got != test.expectedFramesPerSecond {
+				t.Fatalf("expected %d, got %d", test.expectedFramesPerSecond, got)
+			}
+			if got := rate.IsDropFrame(); got != test.expectedDropFrame {
+				t.Fatalf("expected %t, got %t", test.expectedDropFrame, got)
+			}
+			if got := rate.String(); got != test.expectedString {
+				t.Fatalf("expected %s, got %s", test.expectedString, got)
+			}
+		})
+	}
+}
+
+func Test_validateTimeCode(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		rate                            TimeCodeRate
+		hours, minutes, seconds, frames int
+		err                             error
+	}{
+		"hours out of range": {
+			rate:  TimeCodeRate30,
+			hours: 24,
+			err:   ErrInvalidTimeCode,
+		},
+		"frames out of range for rate": {
+			rate:   TimeCodeRate25,
+			frames: 25,
+			err:    ErrInvalidTimeCode,
+		},
+		"drop-frame skips frames at the start of a minute": {
+			rate:    TimeCodeRate2997DropFrame,
+			minutes: 1,
+			frames:  1,
+			err:     ErrInvalidTimeCode,
+		},
+		"drop-frame keeps frames at the start of every tenth minute": {
+			rate:    TimeCodeRate2997DropFrame,
+			minutes: 10,
+			frames:  0,
+		},
+		"valid time code": {
+			rate:    TimeCodeRate24,
+			hours:   23,
+			minutes: 59,
+			seconds: 59,
+			frames:  23,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateTimeCode(test.rate, test.hours, test.minutes, test.seconds, test.frames)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+		})
+	}
+}
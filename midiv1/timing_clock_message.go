@@ -0,0 +1,41 @@
+package midiv1
+
+import "fmt"
+
+const (
+	// TimingClockMessageStatus represents the status byte of a Timing Clock message.
+	TimingClockMessageStatus byte = 0xF8
+
+	// TimingClockMessageLength represents the number of bytes in a full Timing Clock message.
+	TimingClockMessageLength int = 1
+)
+
+// TimingClockMessage represents a Timing Clock System Real-Time message, which is sent 24 times per quarter note to keep devices in tempo.
+type TimingClockMessage struct{}
+
+// GetMessageName returns the name of this Timing Clock message.
+func (tcm *TimingClockMessage) GetMessageName() string {
+	return "Timing Clock"
+}
+
+// MarshalMIDI marshalls a TimingClockMessage MIDI message into its raw bytes
+func (tcm TimingClockMessage) MarshalMIDI() ([]byte, error) {
+	return []byte{
+		TimingClockMessageStatus,
+	}, nil
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (tcm *TimingClockMessage) String() string {
+	return fmt.Sprintf(SystemMessageStringFormat, MessageVersion, tcm.GetMessageName())
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a TimingClockMessage struct pointer. Timing Clock messages are represented
+// by a single byte: 0xF8.
+//
+// Example: []byte{0xF8}
+//
+// The example forms a Timing Clock message.
+func (tcm *TimingClockMessage) UnmarshalMIDI(b []byte) error {
+	return unmarshalStatusOnlyMessage("timing clock", TimingClockMessageStatus, b)
+}
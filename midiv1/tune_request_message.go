@@ -0,0 +1,57 @@
+package midiv1
+
+import "fmt"
+
+const (
+	// TuneRequestMessageStatus represents the status byte of a Tune Request message.
+	TuneRequestMessageStatus byte = 0xF6
+
+	// TuneRequestMessageLength represents the number of bytes in a full Tune Request message.
+	TuneRequestMessageLength int = 1
+
+	// SystemMessageStringFormat represents the printf-compatible format for the string representation of a System
+	// message that carries no data.
+	SystemMessageStringFormat string = "%s:%s"
+)
+
+// TuneRequestMessage represents a Tune Request System Common message, which asks analog synthesizers to tune their oscillators.
+type TuneRequestMessage struct{}
+
+// GetMessageName returns the name of this Tune Request message.
+func (trm *TuneRequestMessage) GetMessageName() string {
+	return "Tune Request"
+}
+
+// MarshalMIDI marshalls a TuneRequestMessage MIDI message into its raw bytes
+func (trm TuneRequestMessage) MarshalMIDI() ([]byte, error) {
+	return []byte{
+		TuneRequestMessageStatus,
+	}, nil
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (trm *TuneRequestMessage) String() string {
+	return fmt.Sprintf(SystemMessageStringFormat, MessageVersion, trm.GetMessageName())
+}
+
+// UnmarshalMIDI unmarshalls raw bytes into a TuneRequestMessage struct pointer. Tune Request messages are represented
+// by a single byte: 0xF6.
+//
+// Example: []byte{0xF6}
+//
+// The example forms a Tune Request message.
+func (trm *TuneRequestMessage) UnmarshalMIDI(b []byte) error {
+	return unmarshalStatusOnlyMessage("tune request", TuneRequestMessageStatus, b)
+}
+
+// unmarshalStatusOnlyMessage validates the raw bytes of a System message that is made up of a single status byte. The
+// name is used to describe the message in any returned errors.
+func unmarshalStatusOnlyMessage(name string, status byte, b []byte) error {
+	if len(b) != 1 {
+		return fmt.Errorf("%s messages are made up of 1 byte, received %d byte(s): %w", name, len(b), ErrUnmarshallingMessage)
+	}
+	if b[0] != status {
+		return fmt.Errorf("%s messages must have status byte %#x, received %#x: %w", name, status, b[0], ErrUnmarshallingMessage)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package midiv1
+
+const (
+	// SampleDumpHeaderSubID represents the sub-ID #1 of a Sample Dump Header message.
+	SampleDumpHeaderSubID byte = 0x01
+
+	// SampleDumpDataPacketSubID represents the sub-ID #1 of a Sample Dump Data Packet message.
+	SampleDumpDataPacketSubID byte = 0x02
+
+	// SampleDumpRequestSubID represents the sub-ID #1 of a Sample Dump Request message.
+	SampleDumpRequestSubID byte = 0x03
+
+	// GeneralInformationSubID represents the sub-ID #1 of the General Information messages, such as Identity Request.
+	GeneralInformationSubID byte = 0x06
+
+	// IdentityRequestSubID represents the sub-ID #2 of an Identity Request message.
+	IdentityRequestSubID byte = 0x01
+
+	// IdentityReplySubID represents the sub-ID #2 of an Identity Reply message.
+	IdentityReplySubID byte = 0x02
+
+	// GeneralMIDISubID represents the sub-ID #1 of the General MIDI messages.
+	GeneralMIDISubID byte = 0x09
+
+	// GeneralMIDISystemOnSubID represents the sub-ID #2 of a General MIDI System On message.
+	GeneralMIDISystemOnSubID byte = 0x01
+
+	// GeneralMIDISystemOffSubID represents the sub-ID #2 of a General MIDI System Off message.
+	GeneralMIDISystemOffSubID byte = 0x02
+
+	// WaitSubID represents the sub-ID #1 of a Wait handshaking message.
+	WaitSubID byte = 0x7C
+
+	// CancelSubID represents the sub-ID #1 of a Cancel handshaking message.
+	CancelSubID byte = 0x7D
+
+	// NAKSubID represents the sub-ID #1 of a NAK (not acknowledged) handshaking message.
+	NAKSubID byte = 0x7E
+
+	// ACKSubID represents the sub-ID #1 of an ACK (acknowledged) handshaking message.
+	ACKSubID byte = 0x7F
+)
+
+// newUniversalNonRealTimeMessage returns an empty Universal Non-Real-Time message for the sub-IDs, or nil if the
+// sub-IDs do not map to a supported message type. Some messages use the position of sub-ID #2 for their data.
+func newUniversalNonRealTimeMessage(subID1 byte, subID2 byte) unmarshalerMessage {
+	switch subID1 {
+	case SampleDumpHeaderSubID:
+		return &SampleDumpHeaderMessage{}
+	case SampleDumpDataPacketSubID:
+		return &SampleDumpDataPacketMessage{}
+	case SampleDumpRequestSubID:
+		return &SampleDumpRequestMessage{}
+	case GeneralInformationSubID:
+		switch subID2 {
+		case IdentityRequestSubID:
+			return &IdentityRequestMessage{}
+		case IdentityReplySubID:
+			return &IdentityReplyMessage{}
+		}
+	case GeneralMIDISubID:
+		switch subID2 {
+		case GeneralMIDISystemOnSubID:
+			return &GeneralMIDISystemOnMessage{}
+		case GeneralMIDISystemOffSubID:
+			return &GeneralMIDISystemOffMessage{}
+		}
+	case WaitSubID:
+		return &WaitMessage{}
+	case CancelSubID:
+		return &CancelMessage{}
+	case NAKSubID:
+		return &NAKMessage{}
+	case ACKSubID:
+		return &ACKMessage{}
+	}
+	return nil
+}
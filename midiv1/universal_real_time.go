@@ -0,0 +1,66 @@
+package midiv1
+
+const (
+	// MIDITimeCodeSubID represents the sub-ID #1 of the MIDI Time Code messages.
+	MIDITimeCodeSubID byte = 0x01
+
+	// MTCFullMessageSubID represents the sub-ID #2 of an MTC Full Message.
+	MTCFullMessageSubID byte = 0x01
+
+	// NotationInformationSubID represents the sub-ID #1 of the Notation Information messages.
+	NotationInformationSubID byte = 0x03
+
+	// BarMarkerSubID represents the sub-ID #2 of a Bar Marker message.
+	BarMarkerSubID byte = 0x01
+
+	// TimeSignatureImmediateSubID represents the sub-ID #2 of a Time Signature message that takes effect immediately.
+	TimeSignatureImmediateSubID byte = 0x02
+
+	// TimeSignatureDelayedSubID represents the sub-ID #2 of a Time Signature message that takes effect at the next bar line.
+	TimeSignatureDelayedSubID byte = 0x42
+
+	// DeviceControlSubID represents the sub-ID #1 of the Device Control messages.
+	DeviceControlSubID byte = 0x04
+
+	// MasterVolumeSubID represents the sub-ID #2 of a Master Volume message.
+	MasterVolumeSubID byte = 0x01
+
+	// MasterBalanceSubID represents the sub-ID #2 of a Master Balance message.
+	MasterBalanceSubID byte = 0x02
+
+	// MasterFineTuningSubID represents the sub-ID #2 of a Master Fine Tuning message.
+	MasterFineTuningSubID byte = 0x03
+
+	// MasterCoarseTuningSubID represents the sub-ID #2 of a Master Coarse Tuning message.
+	MasterCoarseTuningSubID byte = 0x04
+)
+
+// newUniversalRealTimeMessage returns an empty Universal Real-Time message for the sub-IDs, or nil if the sub-IDs do
+// not map to a supported message type.
+func newUniversalRealTimeMessage(subID1 byte, subID2 byte) unmarshalerMessage {
+	switch subID1 {
+	case MIDITimeCodeSubID:
+		if subID2 == MTCFullMessageSubID {
+			return &MTCFullMessage{}
+		}
+	case NotationInformationSubID:
+		switch subID2 {
+		case BarMarkerSubID:
+			return &BarMarkerMessage{}
+		case TimeSignatureImmediateSubID, TimeSignatureDelayedSubID:
+			return &TimeSignatureNotationMessage{}
+		}
+	case DeviceControlSubID:
+		switch subID2 {
+		case MasterVolumeSubID:
+			return &MasterVolumeMessage{}
+		case MasterBalanceSubID:
+			return &MasterBalanceMessage{}
+		case MasterFineTuningSubID:
+			return &MasterFineTuningMessage{}
+		case MasterCoarseTuningSubID:
+			return &MasterCoarseTuningMessage{}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package midiv1
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidDeviceID represents an invalid Universal System Exclusive device ID.
+	ErrInvalidDeviceID error = errors.New("invalid MIDI device ID")
+)
+
+const (
+	// UniversalMessageStringFormat represents the printf-compatible format for the string representation of a Universal
+	// System Exclusive message that only carries a device ID.
+	UniversalMessageStringFormat string = "%s:%s:%d"
+
+	// universalMessagePrefixLength represents the number of bytes before the sub-IDs of a Universal System Exclusive
+	// message: the status byte, the manufacturer ID and the device ID.
+	universalMessagePrefixLength int = 3
+)
+
+// DeviceID represents the device ID (also known as the channel) of a Universal System Exclusive message, which selects
+// the device that should respond to the message.
+type DeviceID byte
+
+const (
+	// MinDeviceID is the lowest device ID available.
+	MinDeviceID DeviceID = 0x00
+
+	// MaxDeviceID is the highest device ID available.
+	MaxDeviceID DeviceID = 0x7F
+
+	// AllCallDeviceID is the device ID that every device responds to.
+	AllCallDeviceID DeviceID = 0x7F
+)
+
+// NewDeviceID returns a DeviceID based on the integer argument.
+func NewDeviceID(id int) (DeviceID, error) {
+	if id < int(MinDeviceID) || id > int(MaxDeviceID) {
+		return MinDeviceID, fmt.Errorf("valid device IDs are between %d and %d, inclusive: %w", MinDeviceID, MaxDeviceID, ErrInvalidDeviceID)
+	}
+	return DeviceID(id), nil
+}
+
+// NewDeviceIDFromByte returns a DeviceID based on the byte argument.
+func NewDeviceIDFromByte(id byte) (DeviceID, error) {
+	if id > byte(MaxDeviceID) {
+		return MinDeviceID, fmt.Errorf("valid device IDs are between %d and %d, inclusive: %w", MinDeviceID, MaxDeviceID, ErrInvalidDeviceID)
+	}
+	return DeviceID(id), nil
+}
+
+// IsAllCall returns whether the device ID is the all-call device ID, which every device responds to.
+func (id DeviceID) IsAllCall() bool {
+	return id == AllCallDeviceID
+}
+
+// unmarshalSystemExclusiveMessage unmarshalls the raw bytes of a System Exclusive message. Universal System Exclusive
+// messages with a supported sub-ID are returned as their own type, along with any error from decoding them, while
+// anything else is returned as a *SystemExclusiveMessage.
+func unmarshalSystemExclusiveMessage(b []byte) (Message, error) {
+	var message unmarshalerMessage
+	if len(b) > universalMessagePrefixLength+1 {
+		subID1 := b[universalMessagePrefixLength]
+		subID2 := b[universalMessagePrefixLength+1]
+		switch (ManufacturerID{b[1]}) {
+		case UniversalNonRealTimeManufacturerID:
+			message = newUniversalNonRealTimeMessage(subID1, subID2)
+		case UniversalRealTimeManufacturerID:
+			message = newUniversalRealTimeMessage(subID1, subID2)
+		}
+	}
+	if message == nil {
+		message = &SystemExclusiveMessage{}
+	}
+	if err := message.UnmarshalMIDI(b); err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+// marshalUniversalMessage marshalls a Universal System Exclusive message with the supplied manufacturer ID, device ID,
+// sub-IDs and data into its raw bytes.
+func marshalUniversalMessage(manufacturer ManufacturerID, deviceID DeviceID, subIDs []byte, data []byte) ([]byte, error) {
+	if deviceID > MaxDeviceID {
+		return nil, fmt.Errorf("valid device IDs are between %d and %d, inclusive, received %d: %w", MinDeviceID, MaxDeviceID, deviceID, ErrMarshallingMessage)
+	}
+	body := make([]byte, 0, 1+len(subIDs)+len(data))
+	body = append(body, byte(deviceID))
+	body = append(body, subIDs...)
+	body = append(body, data...)
+	return SystemExclusiveMessage{
+		Manufacturer: manufacturer,
+		Data:         body,
+	}.MarshalMIDI()
+}
+
+// unmarshalUniversalMessage validates the raw bytes of a Universal System Exclusive message and returns its device ID
+// along with the data that follows its sub-IDs. The name is used to describe the message in any returned errors, and a
+// length of -1 allows data of any length.
+func unmarshalUniversalMessage(name string, manufacturer ManufacturerID, subIDs []byte, length int, b []byte) (DeviceID, []byte, error) {
+	var message SystemExclusiveMessage
+	if err := message.UnmarshalMIDI(b); err != nil {
+		return 0, nil, err
+	}
+	if message.Manufacturer != manufacturer {
+		return 0, nil, fmt.Errorf("%s messages must have manufacturer ID % X, received % X: %w", name, manufacturer.Bytes(), message.Manufacturer.Bytes(), ErrUnmarshallingMessage)
+	}
+	if len(message.Data) < 1+len(subIDs) {
+		return 0, nil, fmt.Errorf("%s messages must contain a device ID and %d sub-ID(s): %w", name, len(subIDs), ErrUnmarshallingMessage)
+	}
+	for i, subID := range subIDs {
+		if message.Data[1+i] != subID {
+			return 0, nil, fmt.Errorf("%s messages must have sub-ID #%d %#x, received %#x: %w", name, i+1, subID, message.Data[1+i], ErrUnmarshallingMessage)
+		}
+	}
+	data := message.Data[1+len(subIDs):]
+	if length >= 0 && len(data) != length {
+		return 0, nil, fmt.Errorf("%s messages are made up of %d data bytes after their sub-IDs, received %d byte(s): %w", name, length, len(data), ErrUnmarshallingMessage)
+	}
+	return DeviceID(message.Data[0]), data, nil
+}
+
+// appendSevenBitValue appends the value as n data bytes of 7 bits each, least significant byte first, as used by the
+// multi-byte fields of Universal System Exclusive messages.
+func appendSevenBitValue(b []byte, value uint32, n int) []byte {
+	for i := 0; i < n; i++ {
+		b = append(b, byte(value>>(7*i))&0x7F)
+	}
+	return b
+}
+
+// parseSevenBitValue returns the value of data bytes of 7 bits each, least significant byte first.
+func parseSevenBitValue(b []byte) uint32 {
+	var value uint32
+	for i := len(b) - 1; i >= 0; i-- {
+		value = value<<7 | uint32(b[i]&0x7F)
+	}
+	return value
+}
@@ -0,0 +1,121 @@
+package midiv1
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_NewDeviceID(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		id       int
+		expected DeviceID
+		err      error
+	}{
+		"device ID is negative": {
+			id:  -1,
+			err: ErrInvalidDeviceID,
+		},
+		"device ID is too high": {
+			id:  0x80,
+			err: ErrInvalidDeviceID,
+		},
+		"all-call device ID": {
+			id:       0x7F,
+			expected: AllCallDeviceID,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewDeviceID(test.id)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if got != test.expected {
+				t.Fatalf("expected %d, got %d", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_DeviceID_IsAllCall(t *testing.T) {
+	t.Parallel()
+	if !AllCallDeviceID.IsAllCall() {
+		t.Fatalf("expected all-call device ID to be all-call")
+	}
+	if DeviceID(0x10).IsAllCall() {
+		t.Fatalf("expected device ID 0x10 not to be all-call")
+	}
+}
+
+func Test_unmarshalSystemExclusiveMessage(t *testing.T) {
+	t.Parallel()
+	badChecksum := sampleDumpDataPacketTestBytes()
+	badChecksum[len(badChecksum)-2] = 0x00
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage Message
+		err             error
+	}{
+		"manufacturer-specific message": {
+			b:               []byte{0xF0, 0x41, 0x10, 0x42, 0x12, 0xF7},
+			expectedMessage: &SystemExclusiveMessage{Manufacturer: RolandManufacturerID, Data: []byte{0x10, 0x42, 0x12}},
+		},
+		"supported universal non-real-time message": {
+			b:               []byte{0xF0, 0x7E, 0x7F, 0x09, 0x01, 0xF7},
+			expectedMessage: &GeneralMIDISystemOnMessage{DeviceID: AllCallDeviceID},
+		},
+		"unsupported universal non-real-time sub-ID": {
+			b:               []byte{0xF0, 0x7E, 0x7F, 0x08, 0x01, 0xF7},
+			expectedMessage: &SystemExclusiveMessage{Manufacturer: UniversalNonRealTimeManufacturerID, Data: []byte{0x7F, 0x08, 0x01}},
+		},
+		"malformed universal non-real-time message returns its error": {
+			b:   []byte{0xF0, 0x7E, 0x7F, 0x09, 0x01, 0x00, 0xF7},
+			err: ErrUnmarshallingMessage,
+		},
+		"sample dump data packet with a bad checksum returns its error": {
+			b:   badChecksum,
+			err: ErrInvalidChecksum,
+		},
+		"supported universal real-time message": {
+			b:               []byte{0xF0, 0x7F, 0x7F, 0x04, 0x01, 0x00, 0x40, 0xF7},
+			expectedMessage: &MasterVolumeMessage{DeviceID: AllCallDeviceID, Volume: CenterFourteenBitValue},
+		},
+		"unsupported universal real-time sub-ID": {
+			b:               []byte{0xF0, 0x7F, 0x7F, 0x06, 0x01, 0xF7},
+			expectedMessage: &SystemExclusiveMessage{Manufacturer: UniversalRealTimeManufacturerID, Data: []byte{0x7F, 0x06, 0x01}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := Unmarshal(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if test.err == nil && !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
+
+func Test_sevenBitValue(t *testing.T) {
+	t.Parallel()
+	b := appendSevenBitValue(nil, 22675, 3)
+	expected := []byte{0x13, 0x31, 0x01}
+	if !reflect.DeepEqual(expected, b) {
+		t.Fatalf("expected %#v, got %#v", expected, b)
+	}
+	if got := parseSevenBitValue(b); got != 22675 {
+		t.Fatalf("expected 22675, got %d", got)
+	}
+}
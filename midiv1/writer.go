@@ -0,0 +1,152 @@
+package midiv1
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+// Writer writes MIDI 1.0 messages to a raw byte stream, such as a serial port or a file.
+//
+// By default, a Channel Voice or Channel Mode message whose status byte matches the previously written status byte is
+// written with its MarshalRunningStatusMIDI method, and every other message is written with its MarshalMIDI method.
+// System Exclusive and System Common messages cancel running status, while System Real-Time messages leave it intact.
+type Writer struct {
+	// w is the underlying byte destination
+	w io.Writer
+
+	// now returns the current time and exists so tests can control the refresh interval
+	now func() time.Time
+
+	// runningStatusEnabled represents whether running status compression is used at all
+	runningStatusEnabled bool
+
+	// refreshCount is the number of messages after which the status byte is repeated in full, or 0 to never force it
+	refreshCount int
+
+	// refreshInterval is the amount of time after which the status byte is repeated in full, or 0 to never force it
+	refreshInterval time.Duration
+
+	// resetOnSystemCommon represents whether System Exclusive and System Common messages cancel running status
+	resetOnSystemCommon bool
+
+	// runningStatus is the last status byte written in full, or 0 when running status is not available
+	runningStatus byte
+
+	// sinceRefresh is the number of messages written since the status byte was last written in full
+	sinceRefresh int
+
+	// lastRefresh is the time the status byte was last written in full
+	lastRefresh time.Time
+}
+
+// WriterOption configures a Writer.
+type WriterOption func(*Writer)
+
+// WithoutRunningStatus turns off running status compression so every message is written with its full status byte.
+func WithoutRunningStatus() WriterOption {
+	return func(w *Writer) {
+		w.runningStatusEnabled = false
+	}
+}
+
+// WithStatusRefreshCount forces the status byte to be written in full once every count messages, so receivers that
+// missed the original status byte can recover. A count of 0 or less never forces it.
+func WithStatusRefreshCount(count int) WriterOption {
+	return func(w *Writer) {
+		w.refreshCount = count
+	}
+}
+
+// WithStatusRefreshInterval forces the status byte to be written in full once the interval has passed since it was last
+// written in full. An interval of 0 or less never forces it.
+func WithStatusRefreshInterval(interval time.Duration) WriterOption {
+	return func(w *Writer) {
+		w.refreshInterval = interval
+	}
+}
+
+// WithSystemCommonReset sets whether System Exclusive and System Common messages cancel running status. The MIDI 1.0
+// specification requires this, so it is enabled by default.
+func WithSystemCommonReset(reset bool) WriterOption {
+	return func(w *Writer) {
+		w.resetOnSystemCommon = reset
+	}
+}
+
+// NewWriter returns a new Writer that writes MIDI messages to w, configured by any supplied options.
+func NewWriter(w io.Writer, options ...WriterOption) *Writer {
+	writer := &Writer{
+		w:                    w,
+		now:                  time.Now,
+		runningStatusEnabled: true,
+		resetOnSystemCommon:  true,
+	}
+	for _, option := range options {
+		option(writer)
+	}
+	return writer
+}
+
+// ResetRunningStatus forgets the last status byte so the next channel message is written with its full status byte.
+func (w *Writer) ResetRunningStatus() {
+	w.runningStatus = 0
+	w.sinceRefresh = 0
+}
+
+// WriteMessage marshalls the message and writes it to the stream, using running status when it applies.
+func (w *Writer) WriteMessage(m MessageMarshaler) error {
+	b, err := m.MarshalMIDI()
+	if err != nil {
+		return err
+	}
+	if len(b) == 0 || !ByteHasStatusMSB(b[0]) {
+		return fmt.Errorf("messages must start with a status byte: %w", ErrMarshallingMessage)
+	}
+	status := b[0]
+
+	// System messages are always written in full
+	if ParseStatusFromStatusByte(status) == SystemMessageStatusNibble {
+		if _, err := w.w.Write(b); err != nil {
+			return err
+		}
+		if !IsRealTimeStatus(status) && w.resetOnSystemCommon {
+			w.ResetRunningStatus()
+		}
+		return nil
+	}
+
+	// channel messages that share the previous status byte drop it when running status applies
+	if w.runningStatusEnabled && status == w.runningStatus && !w.refreshDue() {
+		if rsm, ok := m.(RunningStatusMessageMarshaler); ok {
+			rb, err := rsm.MarshalRunningStatusMIDI()
+			if err != nil {
+				return err
+			}
+			if _, err := w.w.Write(rb); err != nil {
+				return err
+			}
+			w.sinceRefresh++
+			return nil
+		}
+	}
+
+	if _, err := w.w.Write(b); err != nil {
+		return err
+	}
+	w.runningStatus = status
+	w.sinceRefresh = 1
+	w.lastRefresh = w.now()
+	return nil
+}
+
+// refreshDue returns whether the status byte must be written in full because of the refresh count or interval.
+func (w *Writer) refreshDue() bool {
+	if w.refreshCount > 0 && w.sinceRefresh >= w.refreshCount {
+		return true
+	}
+	if w.refreshInterval > 0 && w.now().Sub(w.lastRefresh) >= w.refreshInterval {
+		return true
+	}
+	return false
+}
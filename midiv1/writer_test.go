@@ -0,0 +1,135 @@
+package midiv1
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+)
+
+func Test_Writer_WriteMessage(t *testing.T) {
+	t.Parallel()
+	noteOn := NoteOnMessage{Channel: 1, Note: 64, Velocity: 32}
+	noteOnBytes := []byte{0b10010001, 0b01000000, 0b00100000}
+	noteOnRunningStatusBytes := []byte{0b01000000, 0b00100000}
+
+	tests := map[string]struct {
+		options  []WriterOption
+		messages []MessageMarshaler
+		expected [][]byte
+	}{
+		"matching status bytes use running status": {
+			messages: []MessageMarshaler{noteOn, noteOn, noteOn},
+			expected: [][]byte{noteOnBytes, noteOnRunningStatusBytes, noteOnRunningStatusBytes},
+		},
+		"different channels use the full status byte": {
+			messages: []MessageMarshaler{noteOn, NoteOnMessage{Channel: 2, Note: 64, Velocity: 32}},
+			expected: [][]byte{noteOnBytes, {0b10010010, 0b01000000, 0b00100000}},
+		},
+		"channel mode messages share running status with control change messages": {
+			messages: []MessageMarshaler{
+				ControlChangeMessage{Channel: 1, Controller: ChannelVolumeMSBController, Value: 100},
+				AllNotesOffMessage{Channel: 1},
+			},
+			expected: [][]byte{{0b10110001, 0b00000111, 0b01100100}, {0b01111011, 0b00000000}},
+		},
+		"running status can be turned off": {
+			options:  []WriterOption{WithoutRunningStatus()},
+			messages: []MessageMarshaler{noteOn, noteOn},
+			expected: [][]byte{noteOnBytes, noteOnBytes},
+		},
+		"status byte is refreshed every count messages": {
+			options:  []WriterOption{WithStatusRefreshCount(2)},
+			messages: []MessageMarshaler{noteOn, noteOn, noteOn, noteOn},
+			expected: [][]byte{noteOnBytes, noteOnRunningStatusBytes, noteOnBytes, noteOnRunningStatusBytes},
+		},
+		"system common messages cancel running status": {
+			messages: []MessageMarshaler{noteOn, RawMessage{Bytes: []byte{0xF6}}, noteOn},
+			expected: [][]byte{noteOnBytes, {0xF6}, noteOnBytes},
+		},
+		"system common reset can be turned off": {
+			options:  []WriterOption{WithSystemCommonReset(false)},
+			messages: []MessageMarshaler{noteOn, RawMessage{Bytes: []byte{0xF6}}, noteOn},
+			expected: [][]byte{noteOnBytes, {0xF6}, noteOnRunningStatusBytes},
+		},
+		"real-time messages keep running status": {
+			messages: []MessageMarshaler{noteOn, TimingClockMessage{}, noteOn},
+			expected: [][]byte{noteOnBytes, {0xF8}, noteOnRunningStatusBytes},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			writer := NewWriter(&buf, test.options...)
+			for _, message := range test.messages {
+				if err := writer.WriteMessage(message); err != nil {
+					t.Fatalf("expected nil error, got %v", err)
+				}
+			}
+			expected := bytes.Join(test.expected, nil)
+			if !bytes.Equal(expected, buf.Bytes()) {
+				t.Fatalf("expected %#v, got %#v", expected, buf.Bytes())
+			}
+		})
+	}
+}
+
+func Test_Writer_WriteMessage_RefreshInterval(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	now := time.Unix(0, 0)
+	writer := NewWriter(&buf, WithStatusRefreshInterval(100*time.Millisecond))
+	writer.now = func() time.Time {
+		return now
+	}
+
+	noteOn := NoteOnMessage{Channel: 1, Note: 64, Velocity: 32}
+	for _, elapsed := range []time.Duration{0, 50 * time.Millisecond, 50 * time.Millisecond, 10 * time.Millisecond} {
+		now = now.Add(elapsed)
+		if err := writer.WriteMessage(noteOn); err != nil {
+			t.Fatalf("expected nil error, got %v", err)
+		}
+	}
+
+	// the third message arrives 100ms after the first and repeats the status byte
+	expected := []byte{
+		0b10010001, 0b01000000, 0b00100000,
+		0b01000000, 0b00100000,
+		0b10010001, 0b01000000, 0b00100000,
+		0b01000000, 0b00100000,
+	}
+	if !bytes.Equal(expected, buf.Bytes()) {
+		t.Fatalf("expected %#v, got %#v", expected, buf.Bytes())
+	}
+}
+
+func Test_Writer_WriteMessage_Errors(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message MessageMarshaler
+		err     error
+	}{
+		"message fails to marshal": {
+			message: MonoModeOnMessage{Channels: 17},
+			err:     ErrMarshallingMessage,
+		},
+		"message does not start with a status byte": {
+			message: RawMessage{},
+			err:     ErrMarshallingMessage,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := NewWriter(&buf).WriteMessage(test.message)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if buf.Len() != 0 {
+				t.Fatalf("expected no bytes written, got %#v", buf.Bytes())
+			}
+		})
+	}
+}
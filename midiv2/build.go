@@ -0,0 +1,7 @@
+package midiv2
+
+// MessageBuilder represents MIDI 2.0 message data that can be both marshalled and unmarshalled.
+type MessageBuilder interface {
+	MessageMarshaler
+	MessageUnmarshaler
+}
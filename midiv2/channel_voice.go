@@ -0,0 +1,130 @@
+package midiv2
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+const (
+	// RegisteredPerNoteControllerMessageStatus represents the status nibble of the Registered Per-Note Controller message.
+	RegisteredPerNoteControllerMessageStatus byte = 0x0
+
+	// AssignablePerNoteControllerMessageStatus represents the status nibble of the Assignable Per-Note Controller message.
+	AssignablePerNoteControllerMessageStatus byte = 0x1
+
+	// RegisteredControllerMessageStatus represents the status nibble of the Registered Controller (RPN) message.
+	RegisteredControllerMessageStatus byte = 0x2
+
+	// AssignableControllerMessageStatus represents the status nibble of the Assignable Controller (NRPN) message.
+	AssignableControllerMessageStatus byte = 0x3
+
+	// RelativeRegisteredControllerMessageStatus represents the status nibble of the Relative Registered Controller message.
+	RelativeRegisteredControllerMessageStatus byte = 0x4
+
+	// RelativeAssignableControllerMessageStatus represents the status nibble of the Relative Assignable Controller message.
+	RelativeAssignableControllerMessageStatus byte = 0x5
+
+	// PerNotePitchBendMessageStatus represents the status nibble of the Per-Note Pitch Bend message.
+	PerNotePitchBendMessageStatus byte = 0x6
+
+	// NoteOffMessageStatus represents the status nibble of the Note-Off message.
+	NoteOffMessageStatus byte = 0x8
+
+	// NoteOnMessageStatus represents the status nibble of the Note-On message.
+	NoteOnMessageStatus byte = 0x9
+
+	// PolyPressureMessageStatus represents the status nibble of the Poly Pressure message.
+	PolyPressureMessageStatus byte = 0xA
+
+	// ControlChangeMessageStatus represents the status nibble of the Control Change message.
+	ControlChangeMessageStatus byte = 0xB
+
+	// ProgramChangeMessageStatus represents the status nibble of the Program Change message.
+	ProgramChangeMessageStatus byte = 0xC
+
+	// ChannelPressureMessageStatus represents the status nibble of the Channel Pressure message.
+	ChannelPressureMessageStatus byte = 0xD
+
+	// PitchBendMessageStatus represents the status nibble of the Pitch Bend message.
+	PitchBendMessageStatus byte = 0xE
+
+	// PerNoteManagementMessageStatus represents the status nibble of the Per-Note Management message.
+	PerNoteManagementMessageStatus byte = 0xF
+
+	// ChannelVoiceMessageStringFormat represents the printf-compatible format for the string representation of a MIDI
+	// 2.0 Channel Voice message: version, name, group, channel, index and value.
+	ChannelVoiceMessageStringFormat string = "%s:%s:%d:%d:%d:%d"
+
+	// maxSevenBitValue represents the highest value of the 7-bit fields of a MIDI 2.0 Channel Voice message.
+	maxSevenBitValue byte = 0x7F
+)
+
+// channelVoiceFields holds the fields shared by every MIDI 2.0 Channel Voice message packet: the group, the status and
+// channel nibbles, two index bytes and a 32-bit data word.
+type channelVoiceFields struct {
+	group   Group
+	status  byte
+	channel midiv1.Channel
+	index1  byte
+	index2  byte
+	data    uint32
+}
+
+// marshal returns the packet of the MIDI 2.0 Channel Voice message. The name is used to describe the message in any
+// returned errors.
+func (cvf channelVoiceFields) marshal(name string) ([]byte, error) {
+	if err := checkGroup(name, cvf.group); err != nil {
+		return nil, err
+	}
+	if cvf.channel > midiv1.MaxChannel {
+		return nil, fmt.Errorf("%s messages must have a channel between %d and %d, inclusive, received %d: %w", name, midiv1.MinChannel, midiv1.MaxChannel, cvf.channel, ErrMarshallingMessage)
+	}
+	b := []byte{
+		makeTypeAndGroupByte(MIDI2ChannelVoiceMessageType, cvf.group),
+		cvf.status<<4 | byte(cvf.channel),
+		cvf.index1,
+		cvf.index2,
+		0, 0, 0, 0,
+	}
+	binary.BigEndian.PutUint32(b[4:], cvf.data)
+	return b, nil
+}
+
+// unmarshalChannelVoice returns the fields of a MIDI 2.0 Channel Voice message packet with the supplied status. The name
+// is used to describe the message in any returned errors.
+func unmarshalChannelVoice(name string, status byte, b []byte) (channelVoiceFields, error) {
+	if err := checkPacket(name, MIDI2ChannelVoiceMessageType, b); err != nil {
+		return channelVoiceFields{}, err
+	}
+	if b[1]>>4 != status {
+		return channelVoiceFields{}, fmt.Errorf("%s messages must have status %#x, received %#x: %w", name, status, b[1]>>4, ErrUnmarshallingMessage)
+	}
+	return channelVoiceFields{
+		group:   ParseGroupFromByte(b[0]),
+		status:  status,
+		channel: midiv1.Channel(b[1] & byte(midiv1.MaxChannel)),
+		index1:  b[2],
+		index2:  b[3],
+		data:    binary.BigEndian.Uint32(b[4:]),
+	}, nil
+}
+
+// checkSevenBit returns an error if a 7-bit field is out of range. The name is used to describe the message and the
+// field in any returned errors.
+func checkSevenBit(name string, field string, value int) error {
+	if value < 0 || value > int(maxSevenBitValue) {
+		return fmt.Errorf("%s message %s must be between 0 and %d, inclusive, received %d: %w", name, field, maxSevenBitValue, value, ErrMarshallingMessage)
+	}
+	return nil
+}
+
+// parseSevenBit returns a 7-bit field of a packet, or an error if its top bit is set. The name is used to describe the
+// message and the field in any returned errors.
+func parseSevenBit(name string, field string, b byte) (byte, error) {
+	if b > maxSevenBitValue {
+		return 0, fmt.Errorf("%s message %s must be between 0 and %d, inclusive, received %d: %w", name, field, maxSevenBitValue, b, ErrUnmarshallingMessage)
+	}
+	return b, nil
+}
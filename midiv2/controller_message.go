@@ -0,0 +1,539 @@
+package midiv2
+
+import (
+	"fmt"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+const (
+	// CenterPitchBend represents the 32-bit pitch bend value with no bend.
+	CenterPitchBend uint32 = 0x80000000
+
+	// ControllerMessageStringFormat represents the printf-compatible format specifically for a Registered or Assignable
+	// Controller message string: version, name, group, channel, bank, index and value.
+	ControllerMessageStringFormat string = "%s:%s:%d:%d:%d:%d:%d"
+
+	// ProgramChangeMessageStringFormat represents the printf-compatible format specifically for a MIDI 2.0 Program
+	// Change message string: version, name, group, channel, program, bank valid, bank MSB and bank LSB.
+	ProgramChangeMessageStringFormat string = "%s:%s:%d:%d:%d:%t:%d:%d"
+
+	// ChannelMessageStringFormat represents the printf-compatible format specifically for a MIDI 2.0 Channel Voice
+	// message string without an index: version, name, group, channel and value.
+	ChannelMessageStringFormat string = "%s:%s:%d:%d:%d"
+
+	// programChangeBankValidFlag represents the Bank Valid flag of a Program Change message.
+	programChangeBankValidFlag byte = 0b1
+)
+
+// ControlChangeMessage represents a MIDI 2.0 Control Change message, which sets one of the 128 MIDI 1.0 controllers
+// with a 32-bit value.
+type ControlChangeMessage struct {
+	// Group represents the group where this message will be sent.
+	Group Group
+
+	// Channel represents the MIDI channel where this message will be sent.
+	Channel midiv1.Channel
+
+	// Index represents the controller.
+	Index midiv1.Controller
+
+	// Value represents the 32-bit controller value.
+	Value uint32
+}
+
+// GetMessageName returns the name of this Control Change message.
+func (ccm *ControlChangeMessage) GetMessageName() string {
+	return "Control Change"
+}
+
+// MarshalMIDI marshalls a ControlChangeMessage MIDI 2.0 message into the raw bytes of its Universal MIDI Packet.
+func (ccm ControlChangeMessage) MarshalMIDI() ([]byte, error) {
+	if err := checkSevenBit("control change", "index", int(ccm.Index)); err != nil {
+		return nil, err
+	}
+	return channelVoiceFields{
+		group:   ccm.Group,
+		status:  ControlChangeMessageStatus,
+		channel: ccm.Channel,
+		index1:  byte(ccm.Index),
+		data:    ccm.Value,
+	}.marshal("control change")
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (ccm *ControlChangeMessage) String() string {
+	return fmt.Sprintf(ChannelVoiceMessageStringFormat, MessageVersion, ccm.GetMessageName(), ccm.Group, ccm.Channel, ccm.Index, ccm.Value)
+}
+
+// UnmarshalMIDI unmarshalls the raw bytes of a Universal MIDI Packet into a ControlChangeMessage struct pointer.
+//
+// Example: []byte{0x40, 0xB0, 0x07, 0x00, 0xFF, 0xFF, 0xFF, 0xFF}
+//
+// The example forms a Control Change message for group 1 (index 0), channel 1 (index 0), controller 7 (channel
+// volume), value 0xFFFFFFFF.
+func (ccm *ControlChangeMessage) UnmarshalMIDI(b []byte) error {
+	cvf, err := unmarshalChannelVoice("control change", ControlChangeMessageStatus, b)
+	if err != nil {
+		return err
+	}
+	index, err := parseSevenBit("control change", "index", cvf.index1)
+	if err != nil {
+		return err
+	}
+	*ccm = ControlChangeMessage{
+		Group:   cvf.group,
+		Channel: cvf.channel,
+		Index:   midiv1.Controller(index),
+		Value:   cvf.data,
+	}
+	return nil
+}
+
+// RegisteredControllerMessage represents a MIDI 2.0 Registered Controller message, which sets a Registered Parameter
+// Number (RPN) in a single message with a 32-bit value.
+type RegisteredControllerMessage struct {
+	// Group represents the group where this message will be sent.
+	Group Group
+
+	// Channel represents the MIDI channel where this message will be sent.
+	Channel midiv1.Channel
+
+	// Bank represents the 7-bit bank of the controller, the MSB of the MIDI 1.0 RPN.
+	Bank byte
+
+	// Index represents the 7-bit index of the controller, the LSB of the MIDI 1.0 RPN.
+	Index byte
+
+	// Value represents the 32-bit controller value.
+	Value uint32
+}
+
+// GetMessageName returns the name of this Registered Controller message.
+func (rcm *RegisteredControllerMessage) GetMessageName() string {
+	return "Registered Controller"
+}
+
+// MarshalMIDI marshalls a RegisteredControllerMessage MIDI 2.0 message into the raw bytes of its Universal MIDI Packet.
+func (rcm RegisteredControllerMessage) MarshalMIDI() ([]byte, error) {
+	return marshalControllerMessage("registered controller", RegisteredControllerMessageStatus, rcm.Group, rcm.Channel, rcm.Bank, rcm.Index, rcm.Value)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (rcm *RegisteredControllerMessage) String() string {
+	return fmt.Sprintf(ControllerMessageStringFormat, MessageVersion, rcm.GetMessageName(), rcm.Group, rcm.Channel, rcm.Bank, rcm.Index, rcm.Value)
+}
+
+// UnmarshalMIDI unmarshalls the raw bytes of a Universal MIDI Packet into a RegisteredControllerMessage struct pointer.
+//
+// Example: []byte{0x40, 0x20, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00}
+//
+// The example forms a Registered Controller message for group 1 (index 0), channel 1 (index 0), bank 0, index 0
+// (pitch bend sensitivity), value 0x04000000.
+func (rcm *RegisteredControllerMessage) UnmarshalMIDI(b []byte) error {
+	cvf, err := unmarshalControllerMessage("registered controller", RegisteredControllerMessageStatus, b)
+	if err != nil {
+		return err
+	}
+	*rcm = RegisteredControllerMessage{
+		Group:   cvf.group,
+		Channel: cvf.channel,
+		Bank:    cvf.index1,
+		Index:   cvf.index2,
+		Value:   cvf.data,
+	}
+	return nil
+}
+
+// AssignableControllerMessage represents a MIDI 2.0 Assignable Controller message, which sets a Non-Registered
+// Parameter Number (NRPN) in a single message with a 32-bit value.
+type AssignableControllerMessage struct {
+	// Group represents the group where this message will be sent.
+	Group Group
+
+	// Channel represents the MIDI channel where this message will be sent.
+	Channel midiv1.Channel
+
+	// Bank represents the 7-bit bank of the controller, the MSB of the MIDI 1.0 NRPN.
+	Bank byte
+
+	// Index represents the 7-bit index of the controller, the LSB of the MIDI 1.0 NRPN.
+	Index byte
+
+	// Value represents the 32-bit controller value.
+	Value uint32
+}
+
+// GetMessageName returns the name of this Assignable Controller message.
+func (acm *AssignableControllerMessage) GetMessageName() string {
+	return "Assignable Controller"
+}
+
+// MarshalMIDI marshalls an AssignableControllerMessage MIDI 2.0 message into the raw bytes of its Universal MIDI Packet.
+func (acm AssignableControllerMessage) MarshalMIDI() ([]byte, error) {
+	return marshalControllerMessage("assignable controller", AssignableControllerMessageStatus, acm.Group, acm.Channel, acm.Bank, acm.Index, acm.Value)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (acm *AssignableControllerMessage) String() string {
+	return fmt.Sprintf(ControllerMessageStringFormat, MessageVersion, acm.GetMessageName(), acm.Group, acm.Channel, acm.Bank, acm.Index, acm.Value)
+}
+
+// UnmarshalMIDI unmarshalls the raw bytes of a Universal MIDI Packet into an AssignableControllerMessage struct pointer.
+//
+// Example: []byte{0x40, 0x31, 0x01, 0x08, 0x80, 0x00, 0x00, 0x00}
+//
+// The example forms an Assignable Controller message for group 1 (index 0), channel 2 (index 1), bank 1, index 8,
+// value 0x80000000.
+func (acm *AssignableControllerMessage) UnmarshalMIDI(b []byte) error {
+	cvf, err := unmarshalControllerMessage("assignable controller", AssignableControllerMessageStatus, b)
+	if err != nil {
+		return err
+	}
+	*acm = AssignableControllerMessage{
+		Group:   cvf.group,
+		Channel: cvf.channel,
+		Bank:    cvf.index1,
+		Index:   cvf.index2,
+		Value:   cvf.data,
+	}
+	return nil
+}
+
+// RelativeRegisteredControllerMessage represents a MIDI 2.0 Relative Registered Controller message, which changes a
+// Registered Parameter Number (RPN) by a signed amount.
+type RelativeRegisteredControllerMessage struct {
+	// Group represents the group where this message will be sent.
+	Group Group
+
+	// Channel represents the MIDI channel where this message will be sent.
+	Channel midiv1.Channel
+
+	// Bank represents the 7-bit bank of the controller, the MSB of the MIDI 1.0 RPN.
+	Bank byte
+
+	// Index represents the 7-bit index of the controller, the LSB of the MIDI 1.0 RPN.
+	Index byte
+
+	// Value represents the signed 32-bit change to the controller value.
+	Value int32
+}
+
+// GetMessageName returns the name of this Relative Registered Controller message.
+func (rrcm *RelativeRegisteredControllerMessage) GetMessageName() string {
+	return "Relative Registered Controller"
+}
+
+// MarshalMIDI marshalls a RelativeRegisteredControllerMessage MIDI 2.0 message into the raw bytes of its Universal MIDI
+// Packet.
+func (rrcm RelativeRegisteredControllerMessage) MarshalMIDI() ([]byte, error) {
+	return marshalControllerMessage("relative registered controller", RelativeRegisteredControllerMessageStatus, rrcm.Group, rrcm.Channel, rrcm.Bank, rrcm.Index, uint32(rrcm.Value))
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (rrcm *RelativeRegisteredControllerMessage) String() string {
+	return fmt.Sprintf(ControllerMessageStringFormat, MessageVersion, rrcm.GetMessageName(), rrcm.Group, rrcm.Channel, rrcm.Bank, rrcm.Index, rrcm.Value)
+}
+
+// UnmarshalMIDI unmarshalls the raw bytes of a Universal MIDI Packet into a RelativeRegisteredControllerMessage struct
+// pointer.
+//
+// Example: []byte{0x40, 0x40, 0x00, 0x01, 0xFF, 0xFF, 0xFF, 0xFF}
+//
+// The example forms a Relative Registered Controller message for group 1 (index 0), channel 1 (index 0), bank 0,
+// index 1 (fine tuning), change -1.
+func (rrcm *RelativeRegisteredControllerMessage) UnmarshalMIDI(b []byte) error {
+	cvf, err := unmarshalControllerMessage("relative registered controller", RelativeRegisteredControllerMessageStatus, b)
+	if err != nil {
+		return err
+	}
+	*rrcm = RelativeRegisteredControllerMessage{
+		Group:   cvf.group,
+		Channel: cvf.channel,
+		Bank:    cvf.index1,
+		Index:   cvf.index2,
+		Value:   int32(cvf.data),
+	}
+	return nil
+}
+
+// RelativeAssignableControllerMessage represents a MIDI 2.0 Relative Assignable Controller message, which changes a
+// Non-Registered Parameter Number (NRPN) by a signed amount.
+type RelativeAssignableControllerMessage struct {
+	// Group represents the group where this message will be sent.
+	Group Group
+
+	// Channel represents the MIDI channel where this message will be sent.
+	Channel midiv1.Channel
+
+	// Bank represents the 7-bit bank of the controller, the MSB of the MIDI 1.0 NRPN.
+	Bank byte
+
+	// Index represents the 7-bit index of the controller, the LSB of the MIDI 1.0 NRPN.
+	Index byte
+
+	// Value represents the signed 32-bit change to the controller value.
+	Value int32
+}
+
+// GetMessageName returns the name of this Relative Assignable Controller message.
+func (racm *RelativeAssignableControllerMessage) GetMessageName() string {
+	return "Relative Assignable Controller"
+}
+
+// MarshalMIDI marshalls a RelativeAssignableControllerMessage MIDI 2.0 message into the raw bytes of its Universal MIDI
+// Packet.
+func (racm RelativeAssignableControllerMessage) MarshalMIDI() ([]byte, error) {
+	return marshalControllerMessage("relative assignable controller", RelativeAssignableControllerMessageStatus, racm.Group, racm.Channel, racm.Bank, racm.Index, uint32(racm.Value))
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (racm *RelativeAssignableControllerMessage) String() string {
+	return fmt.Sprintf(ControllerMessageStringFormat, MessageVersion, racm.GetMessageName(), racm.Group, racm.Channel, racm.Bank, racm.Index, racm.Value)
+}
+
+// UnmarshalMIDI unmarshalls the raw bytes of a Universal MIDI Packet into a RelativeAssignableControllerMessage struct
+// pointer.
+//
+// Example: []byte{0x40, 0x50, 0x01, 0x08, 0x00, 0x00, 0x00, 0x10}
+//
+// The example forms a Relative Assignable Controller message for group 1 (index 0), channel 1 (index 0), bank 1,
+// index 8, change +16.
+func (racm *RelativeAssignableControllerMessage) UnmarshalMIDI(b []byte) error {
+	cvf, err := unmarshalControllerMessage("relative assignable controller", RelativeAssignableControllerMessageStatus, b)
+	if err != nil {
+		return err
+	}
+	*racm = RelativeAssignableControllerMessage{
+		Group:   cvf.group,
+		Channel: cvf.channel,
+		Bank:    cvf.index1,
+		Index:   cvf.index2,
+		Value:   int32(cvf.data),
+	}
+	return nil
+}
+
+// ProgramChangeMessage represents a MIDI 2.0 Program Change message, which can select a bank in the same message.
+type ProgramChangeMessage struct {
+	// Group represents the group where this message will be sent.
+	Group Group
+
+	// Channel represents the MIDI channel where this message will be sent.
+	Channel midiv1.Channel
+
+	// Program represents the 7-bit program number.
+	Program byte
+
+	// BankValid represents whether the bank is selected along with the program.
+	BankValid bool
+
+	// BankMSB represents the 7-bit bank select MSB, used when BankValid is set.
+	BankMSB byte
+
+	// BankLSB represents the 7-bit bank select LSB, used when BankValid is set.
+	BankLSB byte
+}
+
+// GetMessageName returns the name of this Program Change message.
+func (pcm *ProgramChangeMessage) GetMessageName() string {
+	return "Program Change"
+}
+
+// MarshalMIDI marshalls a ProgramChangeMessage MIDI 2.0 message into the raw bytes of its Universal MIDI Packet.
+func (pcm ProgramChangeMessage) MarshalMIDI() ([]byte, error) {
+	if err := checkSevenBit("program change", "program", int(pcm.Program)); err != nil {
+		return nil, err
+	}
+	if err := checkSevenBit("program change", "bank MSB", int(pcm.BankMSB)); err != nil {
+		return nil, err
+	}
+	if err := checkSevenBit("program change", "bank LSB", int(pcm.BankLSB)); err != nil {
+		return nil, err
+	}
+	var flags byte
+	if pcm.BankValid {
+		flags |= programChangeBankValidFlag
+	}
+	return channelVoiceFields{
+		group:   pcm.Group,
+		status:  ProgramChangeMessageStatus,
+		channel: pcm.Channel,
+		index2:  flags,
+		data:    uint32(pcm.Program)<<24 | uint32(pcm.BankMSB)<<8 | uint32(pcm.BankLSB),
+	}.marshal("program change")
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (pcm *ProgramChangeMessage) String() string {
+	return fmt.Sprintf(ProgramChangeMessageStringFormat, MessageVersion, pcm.GetMessageName(), pcm.Group, pcm.Channel, pcm.Program, pcm.BankValid, pcm.BankMSB, pcm.BankLSB)
+}
+
+// UnmarshalMIDI unmarshalls the raw bytes of a Universal MIDI Packet into a ProgramChangeMessage struct pointer.
+//
+// Example: []byte{0x40, 0xC0, 0x00, 0x01, 0x05, 0x00, 0x01, 0x02}
+//
+// The example forms a Program Change message for group 1 (index 0), channel 1 (index 0), program 5, bank MSB 1 and
+// bank LSB 2.
+func (pcm *ProgramChangeMessage) UnmarshalMIDI(b []byte) error {
+	cvf, err := unmarshalChannelVoice("program change", ProgramChangeMessageStatus, b)
+	if err != nil {
+		return err
+	}
+	program, err := parseSevenBit("program change", "program", byte(cvf.data>>24))
+	if err != nil {
+		return err
+	}
+	bankMSB, err := parseSevenBit("program change", "bank MSB", byte(cvf.data>>8))
+	if err != nil {
+		return err
+	}
+	bankLSB, err := parseSevenBit("program change", "bank LSB", byte(cvf.data))
+	if err != nil {
+		return err
+	}
+	*pcm = ProgramChangeMessage{
+		Group:     cvf.group,
+		Channel:   cvf.channel,
+		Program:   program,
+		BankValid: cvf.index2&programChangeBankValidFlag != 0,
+		BankMSB:   bankMSB,
+		BankLSB:   bankLSB,
+	}
+	return nil
+}
+
+// ChannelPressureMessage represents a MIDI 2.0 Channel Pressure message, also known as Aftertouch.
+type ChannelPressureMessage struct {
+	// Group represents the group where this message will be sent.
+	Group Group
+
+	// Channel represents the MIDI channel where this message will be sent.
+	Channel midiv1.Channel
+
+	// Pressure represents the 32-bit pressure value.
+	Pressure uint32
+}
+
+// GetMessageName returns the name of this Channel Pressure message.
+func (cpm *ChannelPressureMessage) GetMessageName() string {
+	return "Channel Pressure"
+}
+
+// MarshalMIDI marshalls a ChannelPressureMessage MIDI 2.0 message into the raw bytes of its Universal MIDI Packet.
+func (cpm ChannelPressureMessage) MarshalMIDI() ([]byte, error) {
+	return channelVoiceFields{
+		group:   cpm.Group,
+		status:  ChannelPressureMessageStatus,
+		channel: cpm.Channel,
+		data:    cpm.Pressure,
+	}.marshal("channel pressure")
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (cpm *ChannelPressureMessage) String() string {
+	return fmt.Sprintf(ChannelMessageStringFormat, MessageVersion, cpm.GetMessageName(), cpm.Group, cpm.Channel, cpm.Pressure)
+}
+
+// UnmarshalMIDI unmarshalls the raw bytes of a Universal MIDI Packet into a ChannelPressureMessage struct pointer.
+//
+// Example: []byte{0x40, 0xD0, 0x00, 0x00, 0x40, 0x00, 0x00, 0x00}
+//
+// The example forms a Channel Pressure message for group 1 (index 0), channel 1 (index 0), pressure 0x40000000.
+func (cpm *ChannelPressureMessage) UnmarshalMIDI(b []byte) error {
+	cvf, err := unmarshalChannelVoice("channel pressure", ChannelPressureMessageStatus, b)
+	if err != nil {
+		return err
+	}
+	*cpm = ChannelPressureMessage{
+		Group:    cvf.group,
+		Channel:  cvf.channel,
+		Pressure: cvf.data,
+	}
+	return nil
+}
+
+// PitchBendMessage represents a MIDI 2.0 Pitch Bend message, which bends the pitch of every note on the channel.
+type PitchBendMessage struct {
+	// Group represents the group where this message will be sent.
+	Group Group
+
+	// Channel represents the MIDI channel where this message will be sent.
+	Channel midiv1.Channel
+
+	// Value represents the 32-bit pitch bend value, where CenterPitchBend is no bend.
+	Value uint32
+}
+
+// GetMessageName returns the name of this Pitch Bend message.
+func (pbm *PitchBendMessage) GetMessageName() string {
+	return "Pitch Bend"
+}
+
+// MarshalMIDI marshalls a PitchBendMessage MIDI 2.0 message into the raw bytes of its Universal MIDI Packet.
+func (pbm PitchBendMessage) MarshalMIDI() ([]byte, error) {
+	return channelVoiceFields{
+		group:   pbm.Group,
+		status:  PitchBendMessageStatus,
+		channel: pbm.Channel,
+		data:    pbm.Value,
+	}.marshal("pitch bend")
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (pbm *PitchBendMessage) String() string {
+	return fmt.Sprintf(ChannelMessageStringFormat, MessageVersion, pbm.GetMessageName(), pbm.Group, pbm.Channel, pbm.Value)
+}
+
+// UnmarshalMIDI unmarshalls the raw bytes of a Universal MIDI Packet into a PitchBendMessage struct pointer.
+//
+// Example: []byte{0x40, 0xE0, 0x00, 0x00, 0x80, 0x00, 0x00, 0x00}
+//
+// The example forms a Pitch Bend message for group 1 (index 0), channel 1 (index 0) and no bend.
+func (pbm *PitchBendMessage) UnmarshalMIDI(b []byte) error {
+	cvf, err := unmarshalChannelVoice("pitch bend", PitchBendMessageStatus, b)
+	if err != nil {
+		return err
+	}
+	*pbm = PitchBendMessage{
+		Group:   cvf.group,
+		Channel: cvf.channel,
+		Value:   cvf.data,
+	}
+	return nil
+}
+
+// marshalControllerMessage returns the packet of a MIDI 2.0 Registered, Assignable or Relative Controller message. The
+// name is used to describe the message in any returned errors.
+func marshalControllerMessage(name string, status byte, group Group, channel midiv1.Channel, bank byte, index byte, data uint32) ([]byte, error) {
+	if err := checkSevenBit(name, "bank", int(bank)); err != nil {
+		return nil, err
+	}
+	if err := checkSevenBit(name, "index", int(index)); err != nil {
+		return nil, err
+	}
+	return channelVoiceFields{
+		group:   group,
+		status:  status,
+		channel: channel,
+		index1:  bank,
+		index2:  index,
+		data:    data,
+	}.marshal(name)
+}
+
+// unmarshalControllerMessage returns the fields of a MIDI 2.0 Registered, Assignable or Relative Controller message
+// packet. The name is used to describe the message in any returned errors.
+func unmarshalControllerMessage(name string, status byte, b []byte) (channelVoiceFields, error) {
+	cvf, err := unmarshalChannelVoice(name, status, b)
+	if err != nil {
+		return channelVoiceFields{}, err
+	}
+	if _, err := parseSevenBit(name, "bank", cvf.index1); err != nil {
+		return channelVoiceFields{}, err
+	}
+	if _, err := parseSevenBit(name, "index", cvf.index2); err != nil {
+		return channelVoiceFields{}, err
+	}
+	return cvf, nil
+}
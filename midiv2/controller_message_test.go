@@ -0,0 +1,173 @@
+package midiv2
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func Test_ControllerMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  MessageMarshaler
+		expected []byte
+		err      error
+	}{
+		"control change message with an invalid index returns error": {
+			message: ControlChangeMessage{Index: 128},
+			err:     ErrMarshallingMessage,
+		},
+		"control change message marshalls into expected bytes": {
+			message:  ControlChangeMessage{Index: 7, Value: 0xFFFFFFFF},
+			expected: []byte{0x40, 0xB0, 0x07, 0x00, 0xFF, 0xFF, 0xFF, 0xFF},
+		},
+		"registered controller message with an invalid bank returns error": {
+			message: RegisteredControllerMessage{Bank: 128},
+			err:     ErrMarshallingMessage,
+		},
+		"registered controller message marshalls into expected bytes": {
+			message:  RegisteredControllerMessage{Value: 0x04000000},
+			expected: []byte{0x40, 0x20, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00},
+		},
+		"assignable controller message marshalls into expected bytes": {
+			message:  AssignableControllerMessage{Channel: 1, Bank: 1, Index: 8, Value: 0x80000000},
+			expected: []byte{0x40, 0x31, 0x01, 0x08, 0x80, 0x00, 0x00, 0x00},
+		},
+		"relative registered controller message marshalls into expected bytes": {
+			message:  RelativeRegisteredControllerMessage{Index: 1, Value: -1},
+			expected: []byte{0x40, 0x40, 0x00, 0x01, 0xFF, 0xFF, 0xFF, 0xFF},
+		},
+		"relative assignable controller message marshalls into expected bytes": {
+			message:  RelativeAssignableControllerMessage{Bank: 1, Index: 8, Value: 16},
+			expected: []byte{0x40, 0x50, 0x01, 0x08, 0x00, 0x00, 0x00, 0x10},
+		},
+		"program change message with an invalid program returns error": {
+			message: ProgramChangeMessage{Program: 128},
+			err:     ErrMarshallingMessage,
+		},
+		"program change message marshalls into expected bytes": {
+			message:  ProgramChangeMessage{Program: 5, BankValid: true, BankMSB: 1, BankLSB: 2},
+			expected: []byte{0x40, 0xC0, 0x00, 0x01, 0x05, 0x00, 0x01, 0x02},
+		},
+		"channel pressure message marshalls into expected bytes": {
+			message:  ChannelPressureMessage{Pressure: 0x40000000},
+			expected: []byte{0x40, 0xD0, 0x00, 0x00, 0x40, 0x00, 0x00, 0x00},
+		},
+		"pitch bend message marshalls into expected bytes": {
+			message:  PitchBendMessage{Group: 15, Channel: 15, Value: CenterPitchBend},
+			expected: []byte{0x4F, 0xEF, 0x00, 0x00, 0x80, 0x00, 0x00, 0x00},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_ProgramChangeMessage_String(t *testing.T) {
+	t.Parallel()
+	message := ProgramChangeMessage{Program: 5, BankValid: true, BankMSB: 1, BankLSB: 2}
+	expected := fmt.Sprintf("%s:%s:%d:%d:%d:%t:%d:%d", MessageVersion, "Program Change", 0, 0, 5, true, 1, 2)
+	if message.String() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.String())
+	}
+}
+
+func Test_RelativeRegisteredControllerMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage RelativeRegisteredControllerMessage
+		err             error
+	}{
+		"packet is a registered controller message": {
+			b:   []byte{0x40, 0x20, 0x00, 0x01, 0xFF, 0xFF, 0xFF, 0xFF},
+			err: ErrUnmarshallingMessage,
+		},
+		"index is not 7-bit": {
+			b:   []byte{0x40, 0x40, 0x00, 0x81, 0xFF, 0xFF, 0xFF, 0xFF},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b:               []byte{0x43, 0x42, 0x00, 0x01, 0xFF, 0xFF, 0xFF, 0xFF},
+			expectedMessage: RelativeRegisteredControllerMessage{Group: 3, Channel: 2, Index: 1, Value: -1},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got RelativeRegisteredControllerMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil {
+				if err == nil {
+					t.Fatalf("expected non-nil %v error, got nil error", test.err)
+				}
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
+
+func Test_ProgramChangeMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage ProgramChangeMessage
+		err             error
+	}{
+		"program is not 7-bit": {
+			b:   []byte{0x40, 0xC0, 0x00, 0x01, 0x85, 0x00, 0x01, 0x02},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes without a valid bank unmarshal into expected message": {
+			b:               []byte{0x40, 0xC0, 0x00, 0x00, 0x05, 0x00, 0x00, 0x00},
+			expectedMessage: ProgramChangeMessage{Program: 5},
+		},
+		"bytes unmarshal into expected message": {
+			b:               []byte{0x40, 0xC0, 0x00, 0x01, 0x05, 0x00, 0x01, 0x02},
+			expectedMessage: ProgramChangeMessage{Program: 5, BankValid: true, BankMSB: 1, BankLSB: 2},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got ProgramChangeMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil {
+				if err == nil {
+					t.Fatalf("expected non-nil %v error, got nil error", test.err)
+				}
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
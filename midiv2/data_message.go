@@ -0,0 +1,317 @@
+package midiv2
+
+import (
+	"fmt"
+)
+
+// SystemExclusiveStatus represents the position of a Data 64 or Data 128 packet within a System Exclusive message.
+type SystemExclusiveStatus byte
+
+const (
+	// CompleteSystemExclusiveStatus represents a System Exclusive message carried whole in a single packet.
+	CompleteSystemExclusiveStatus SystemExclusiveStatus = 0x0
+
+	// StartSystemExclusiveStatus represents the first packet of a System Exclusive message.
+	StartSystemExclusiveStatus SystemExclusiveStatus = 0x1
+
+	// ContinueSystemExclusiveStatus represents a packet in the middle of a System Exclusive message.
+	ContinueSystemExclusiveStatus SystemExclusiveStatus = 0x2
+
+	// EndSystemExclusiveStatus represents the last packet of a System Exclusive message.
+	EndSystemExclusiveStatus SystemExclusiveStatus = 0x3
+)
+
+const (
+	// MaxSystemExclusive7DataLength represents the number of data bytes that fit in a single System Exclusive (7-bit)
+	// packet.
+	MaxSystemExclusive7DataLength int = 6
+
+	// MaxSystemExclusive8DataLength represents the number of data bytes that fit in a single System Exclusive 8 packet,
+	// after the stream ID.
+	MaxSystemExclusive8DataLength int = 13
+
+	// SystemExclusiveMessageStringFormat represents the printf-compatible format specifically for a System Exclusive
+	// packet string: version, name, group, status and data.
+	SystemExclusiveMessageStringFormat string = "%s:%s:%d:%d:% X"
+
+	// SystemExclusive8MessageStringFormat represents the printf-compatible format specifically for a System Exclusive 8
+	// packet string: version, name, group, status, stream ID and data.
+	SystemExclusive8MessageStringFormat string = "%s:%s:%d:%d:%d:% X"
+)
+
+// SystemExclusive7Message represents a single Data 64 packet of a System Exclusive (7-bit) message. The packets of a
+// message carry its data bytes without the 0xF0 and 0xF7 bytes used by MIDI 1.0.
+type SystemExclusive7Message struct {
+	// Group represents the group where this message will be sent.
+	Group Group
+
+	// Status represents the position of this packet within the System Exclusive message.
+	Status SystemExclusiveStatus
+
+	// Data represents up to six 7-bit data bytes.
+	Data []byte
+}
+
+// NewSystemExclusive7Messages returns the System Exclusive (7-bit) packets that carry the data bytes of a System
+// Exclusive message, without its 0xF0 and 0xF7 bytes.
+func NewSystemExclusive7Messages(group Group, data []byte) []SystemExclusive7Message {
+	var messages []SystemExclusive7Message
+	for _, chunk := range splitSystemExclusiveData(data, MaxSystemExclusive7DataLength) {
+		messages = append(messages, SystemExclusive7Message{
+			Group:  group,
+			Status: chunk.status,
+			Data:   chunk.data,
+		})
+	}
+	return messages
+}
+
+// AssembleSystemExclusive7 returns the data bytes of a System Exclusive message carried by its System Exclusive (7-bit)
+// packets, or an error if the packets do not form a single whole message.
+func AssembleSystemExclusive7(messages []SystemExclusive7Message) ([]byte, error) {
+	statuses := make([]SystemExclusiveStatus, len(messages))
+	var data []byte
+	for i, message := range messages {
+		statuses[i] = message.Status
+		data = append(data, message.Data...)
+	}
+	if err := checkSystemExclusiveStatuses("system exclusive 7", statuses); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// GetMessageName returns the name of this System Exclusive (7-bit) message.
+func (se7m *SystemExclusive7Message) GetMessageName() string {
+	return "System Exclusive 7"
+}
+
+// MarshalMIDI marshalls a SystemExclusive7Message MIDI 2.0 message into the raw bytes of its Universal MIDI Packet.
+func (se7m SystemExclusive7Message) MarshalMIDI() ([]byte, error) {
+	if err := checkSystemExclusive("system exclusive 7", se7m.Group, se7m.Status, len(se7m.Data), MaxSystemExclusive7DataLength); err != nil {
+		return nil, err
+	}
+	for i, db := range se7m.Data {
+		if db > maxSevenBitValue {
+			return nil, fmt.Errorf("system exclusive 7 messages must only carry 7-bit data bytes, received %#x at index %d: %w", db, i, ErrMarshallingMessage)
+		}
+	}
+	b := make([]byte, Data64MessageType.Length())
+	b[0] = makeTypeAndGroupByte(Data64MessageType, se7m.Group)
+	b[1] = byte(se7m.Status)<<4 | byte(len(se7m.Data))
+	copy(b[2:], se7m.Data)
+	return b, nil
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (se7m *SystemExclusive7Message) String() string {
+	return fmt.Sprintf(SystemExclusiveMessageStringFormat, MessageVersion, se7m.GetMessageName(), se7m.Group, se7m.Status, se7m.Data)
+}
+
+// UnmarshalMIDI unmarshalls the raw bytes of a Universal MIDI Packet into a SystemExclusive7Message struct pointer.
+//
+// Example: []byte{0x30, 0x04, 0x7E, 0x7F, 0x06, 0x01, 0x00, 0x00}
+//
+// The example forms a complete System Exclusive (7-bit) message for group 1 (index 0) carrying a Universal
+// Non-Real-Time Identity Request.
+func (se7m *SystemExclusive7Message) UnmarshalMIDI(b []byte) error {
+	group, status, data, err := unmarshalSystemExclusive("system exclusive 7", Data64MessageType, MaxSystemExclusive7DataLength, b)
+	if err != nil {
+		return err
+	}
+	for i, db := range data {
+		if db > maxSevenBitValue {
+			return fmt.Errorf("system exclusive 7 messages must only carry 7-bit data bytes, received %#x at index %d: %w", db, i, ErrUnmarshallingMessage)
+		}
+	}
+	*se7m = SystemExclusive7Message{
+		Group:  group,
+		Status: status,
+		Data:   data,
+	}
+	return nil
+}
+
+// SystemExclusive8Message represents a single Data 128 packet of a System Exclusive 8 message, which carries 8-bit data
+// bytes. Packets with the same stream ID belong to the same message.
+type SystemExclusive8Message struct {
+	// Group represents the group where this message will be sent.
+	Group Group
+
+	// Status represents the position of this packet within the System Exclusive 8 message.
+	Status SystemExclusiveStatus
+
+	// StreamID represents the stream that this packet belongs to, so several messages can be sent at once.
+	StreamID byte
+
+	// Data represents up to 13 data bytes.
+	Data []byte
+}
+
+// NewSystemExclusive8Messages returns the System Exclusive 8 packets that carry the data bytes of a System Exclusive 8
+// message on the supplied stream.
+func NewSystemExclusive8Messages(group Group, streamID byte, data []byte) []SystemExclusive8Message {
+	var messages []SystemExclusive8Message
+	for _, chunk := range splitSystemExclusiveData(data, MaxSystemExclusive8DataLength) {
+		messages = append(messages, SystemExclusive8Message{
+			Group:    group,
+			Status:   chunk.status,
+			StreamID: streamID,
+			Data:     chunk.data,
+		})
+	}
+	return messages
+}
+
+// AssembleSystemExclusive8 returns the data bytes of a System Exclusive 8 message carried by its packets, or an error if
+// the packets do not form a single whole message on a single stream.
+func AssembleSystemExclusive8(messages []SystemExclusive8Message) ([]byte, error) {
+	statuses := make([]SystemExclusiveStatus, len(messages))
+	var data []byte
+	for i, message := range messages {
+		if message.StreamID != messages[0].StreamID {
+			return nil, fmt.Errorf("system exclusive 8 packets must share a stream ID, received %d and %d: %w", messages[0].StreamID, message.StreamID, ErrUnmarshallingMessage)
+		}
+		statuses[i] = message.Status
+		data = append(data, message.Data...)
+	}
+	if err := checkSystemExclusiveStatuses("system exclusive 8", statuses); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// GetMessageName returns the name of this System Exclusive 8 message.
+func (se8m *SystemExclusive8Message) GetMessageName() string {
+	return "System Exclusive 8"
+}
+
+// MarshalMIDI marshalls a SystemExclusive8Message MIDI 2.0 message into the raw bytes of its Universal MIDI Packet.
+func (se8m SystemExclusive8Message) MarshalMIDI() ([]byte, error) {
+	if err := checkSystemExclusive("system exclusive 8", se8m.Group, se8m.Status, len(se8m.Data), MaxSystemExclusive8DataLength); err != nil {
+		return nil, err
+	}
+	b := make([]byte, Data128MessageType.Length())
+	b[0] = makeTypeAndGroupByte(Data128MessageType, se8m.Group)
+	// the byte count includes the stream ID
+	b[1] = byte(se8m.Status)<<4 | byte(len(se8m.Data)+1)
+	b[2] = se8m.StreamID
+	copy(b[3:], se8m.Data)
+	return b, nil
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (se8m *SystemExclusive8Message) String() string {
+	return fmt.Sprintf(SystemExclusive8MessageStringFormat, MessageVersion, se8m.GetMessageName(), se8m.Group, se8m.Status, se8m.StreamID, se8m.Data)
+}
+
+// UnmarshalMIDI unmarshalls the raw bytes of a Universal MIDI Packet into a SystemExclusive8Message struct pointer.
+//
+// Example: []byte{0x50, 0x04, 0x02, 0xFF, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}
+//
+// The example forms a complete System Exclusive 8 message for group 1 (index 0) on stream 2 carrying the data bytes
+// 0xFF, 0x80 and 0x00.
+func (se8m *SystemExclusive8Message) UnmarshalMIDI(b []byte) error {
+	// the stream ID is read as the first data byte and split off below
+	group, status, data, err := unmarshalSystemExclusive("system exclusive 8", Data128MessageType, MaxSystemExclusive8DataLength+1, b)
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return fmt.Errorf("system exclusive 8 messages must carry a stream ID: %w", ErrUnmarshallingMessage)
+	}
+	*se8m = SystemExclusive8Message{
+		Group:    group,
+		Status:   status,
+		StreamID: data[0],
+		Data:     data[1:],
+	}
+	return nil
+}
+
+// systemExclusiveChunk holds the data bytes of a single System Exclusive packet and its position within the message.
+type systemExclusiveChunk struct {
+	status SystemExclusiveStatus
+	data   []byte
+}
+
+// splitSystemExclusiveData returns the data bytes of a System Exclusive message split into packets of up to the
+// supplied size.
+func splitSystemExclusiveData(data []byte, size int) []systemExclusiveChunk {
+	if len(data) <= size {
+		return []systemExclusiveChunk{{status: CompleteSystemExclusiveStatus, data: append([]byte(nil), data...)}}
+	}
+	var chunks []systemExclusiveChunk
+	for pos := 0; pos < len(data); pos += size {
+		end := pos + size
+		if end > len(data) {
+			end = len(data)
+		}
+		status := ContinueSystemExclusiveStatus
+		if pos == 0 {
+			status = StartSystemExclusiveStatus
+		} else if end == len(data) {
+			status = EndSystemExclusiveStatus
+		}
+		chunks = append(chunks, systemExclusiveChunk{status: status, data: append([]byte(nil), data[pos:end]...)})
+	}
+	return chunks
+}
+
+// checkSystemExclusive returns an error if a System Exclusive packet cannot be marshalled. The name is used to describe
+// the message in any returned errors.
+func checkSystemExclusive(name string, group Group, status SystemExclusiveStatus, length int, maxLength int) error {
+	if err := checkGroup(name, group); err != nil {
+		return err
+	}
+	if status > EndSystemExclusiveStatus {
+		return fmt.Errorf("%s messages must have a status between %d and %d, inclusive, received %d: %w", name, CompleteSystemExclusiveStatus, EndSystemExclusiveStatus, status, ErrMarshallingMessage)
+	}
+	if length > maxLength {
+		return fmt.Errorf("%s messages carry at most %d data bytes, received %d: %w", name, maxLength, length, ErrMarshallingMessage)
+	}
+	return nil
+}
+
+// checkSystemExclusiveStatuses returns an error if the statuses of a run of System Exclusive packets do not form a
+// single whole message. The name is used to describe the message in any returned errors.
+func checkSystemExclusiveStatuses(name string, statuses []SystemExclusiveStatus) error {
+	if len(statuses) == 0 {
+		return fmt.Errorf("%s messages must be made up of at least one packet: %w", name, ErrUnmarshallingMessage)
+	}
+	if len(statuses) == 1 {
+		if statuses[0] != CompleteSystemExclusiveStatus {
+			return fmt.Errorf("%s messages made up of a single packet must have status %d, received %d: %w", name, CompleteSystemExclusiveStatus, statuses[0], ErrUnmarshallingMessage)
+		}
+		return nil
+	}
+	for i, status := range statuses {
+		expected := ContinueSystemExclusiveStatus
+		if i == 0 {
+			expected = StartSystemExclusiveStatus
+		} else if i == len(statuses)-1 {
+			expected = EndSystemExclusiveStatus
+		}
+		if status != expected {
+			return fmt.Errorf("%s packet %d must have status %d, received %d: %w", name, i, expected, status, ErrUnmarshallingMessage)
+		}
+	}
+	return nil
+}
+
+// unmarshalSystemExclusive returns the group, status and data bytes of a System Exclusive packet of the message type.
+// The name is used to describe the message in any returned errors.
+func unmarshalSystemExclusive(name string, messageType MessageType, maxLength int, b []byte) (Group, SystemExclusiveStatus, []byte, error) {
+	if err := checkPacket(name, messageType, b); err != nil {
+		return MinGroup, 0, nil, err
+	}
+	status := SystemExclusiveStatus(b[1] >> 4)
+	if status > EndSystemExclusiveStatus {
+		return MinGroup, 0, nil, fmt.Errorf("%s messages must have a status between %d and %d, inclusive, received %d: %w", name, CompleteSystemExclusiveStatus, EndSystemExclusiveStatus, status, ErrUnmarshallingMessage)
+	}
+	length := int(b[1] & 0x0F)
+	if length > maxLength {
+		return MinGroup, 0, nil, fmt.Errorf("%s messages carry at most %d bytes, received a count of %d: %w", name, maxLength, length, ErrUnmarshallingMessage)
+	}
+	return ParseGroupFromByte(b[0]), status, append([]byte(nil), b[2:2+length]...), nil
+}
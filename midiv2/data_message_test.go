@@ -0,0 +1,243 @@
+package midiv2
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_NewSystemExclusive7Messages(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		data     []byte
+		expected []SystemExclusive7Message
+	}{
+		"short data fits in a complete packet": {
+			data: []byte{0x7E, 0x7F, 0x06, 0x01},
+			expected: []SystemExclusive7Message{
+				{Status: CompleteSystemExclusiveStatus, Data: []byte{0x7E, 0x7F, 0x06, 0x01}},
+			},
+		},
+		"long data is split into start, continue and end packets": {
+			data: []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13},
+			expected: []SystemExclusive7Message{
+				{Status: StartSystemExclusiveStatus, Data: []byte{1, 2, 3, 4, 5, 6}},
+				{Status: ContinueSystemExclusiveStatus, Data: []byte{7, 8, 9, 10, 11, 12}},
+				{Status: EndSystemExclusiveStatus, Data: []byte{13}},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := NewSystemExclusive7Messages(MinGroup, test.data)
+			if !reflect.DeepEqual(test.expected, got) {
+				t.Fatalf("expected %+v, got %+v", test.expected, got)
+			}
+			assembled, err := AssembleSystemExclusive7(got)
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if !bytes.Equal(test.data, assembled) {
+				t.Fatalf("expected %#v, got %#v", test.data, assembled)
+			}
+		})
+	}
+}
+
+func Test_AssembleSystemExclusive7(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		messages []SystemExclusive7Message
+		err      error
+	}{
+		"no packets return error": {
+			err: ErrUnmarshallingMessage,
+		},
+		"single start packet returns error": {
+			messages: []SystemExclusive7Message{{Status: StartSystemExclusiveStatus}},
+			err:      ErrUnmarshallingMessage,
+		},
+		"packets without an end return error": {
+			messages: []SystemExclusive7Message{{Status: StartSystemExclusiveStatus}, {Status: ContinueSystemExclusiveStatus}},
+			err:      ErrUnmarshallingMessage,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := AssembleSystemExclusive7(test.messages); !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func Test_SystemExclusive7Message_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  SystemExclusive7Message
+		expected []byte
+		err      error
+	}{
+		"too many data bytes return error": {
+			message: SystemExclusive7Message{Data: []byte{1, 2, 3, 4, 5, 6, 7}},
+			err:     ErrMarshallingMessage,
+		},
+		"8-bit data bytes return error": {
+			message: SystemExclusive7Message{Data: []byte{0x80}},
+			err:     ErrMarshallingMessage,
+		},
+		"invalid status returns error": {
+			message: SystemExclusive7Message{Status: 4},
+			err:     ErrMarshallingMessage,
+		},
+		"message marshalls into expected bytes": {
+			message:  SystemExclusive7Message{Group: 1, Status: EndSystemExclusiveStatus, Data: []byte{0x7E, 0x7F, 0x06}},
+			expected: []byte{0x31, 0x33, 0x7E, 0x7F, 0x06, 0x00, 0x00, 0x00},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_SystemExclusive7Message_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage SystemExclusive7Message
+		err             error
+	}{
+		"byte count is too high": {
+			b:   []byte{0x30, 0x07, 0x7E, 0x7F, 0x06, 0x01, 0x00, 0x00},
+			err: ErrUnmarshallingMessage,
+		},
+		"data bytes are not 7-bit": {
+			b:   []byte{0x30, 0x01, 0xFE, 0x00, 0x00, 0x00, 0x00, 0x00},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b:               []byte{0x30, 0x04, 0x7E, 0x7F, 0x06, 0x01, 0x00, 0x00},
+			expectedMessage: SystemExclusive7Message{Status: CompleteSystemExclusiveStatus, Data: []byte{0x7E, 0x7F, 0x06, 0x01}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got SystemExclusive7Message
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil {
+				if err == nil {
+					t.Fatalf("expected non-nil %v error, got nil error", test.err)
+				}
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
+
+func Test_NewSystemExclusive8Messages(t *testing.T) {
+	t.Parallel()
+	data := make([]byte, 20)
+	for i := range data {
+		data[i] = byte(0xE0 + i)
+	}
+	got := NewSystemExclusive8Messages(2, 5, data)
+	expected := []SystemExclusive8Message{
+		{Group: 2, Status: StartSystemExclusiveStatus, StreamID: 5, Data: data[:13]},
+		{Group: 2, Status: EndSystemExclusiveStatus, StreamID: 5, Data: data[13:]},
+	}
+	if !reflect.DeepEqual(expected, got) {
+		t.Fatalf("expected %+v, got %+v", expected, got)
+	}
+	assembled, err := AssembleSystemExclusive8(got)
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if !bytes.Equal(data, assembled) {
+		t.Fatalf("expected %#v, got %#v", data, assembled)
+	}
+
+	got[1].StreamID = 6
+	if _, err := AssembleSystemExclusive8(got); !errors.Is(err, ErrUnmarshallingMessage) {
+		t.Fatalf("expected %v error, got %v", ErrUnmarshallingMessage, err)
+	}
+}
+
+func Test_SystemExclusive8Message_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	message := SystemExclusive8Message{StreamID: 2, Data: []byte{0xFF, 0x80, 0x00}}
+	expected := []byte{0x50, 0x04, 0x02, 0xFF, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}
+	got, err := message.MarshalMIDI()
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if !bytes.Equal(expected, got) {
+		t.Fatalf("expected %#v, got %#v", expected, got)
+	}
+}
+
+func Test_SystemExclusive8Message_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage SystemExclusive8Message
+		err             error
+	}{
+		"packet without a stream ID": {
+			b:   []byte{0x50, 0x00, 0x02, 0xFF, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+			err: ErrUnmarshallingMessage,
+		},
+		"byte count is too high": {
+			b:   []byte{0x50, 0x0F, 0x02, 0xFF, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b:               []byte{0x50, 0x04, 0x02, 0xFF, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+			expectedMessage: SystemExclusive8Message{StreamID: 2, Data: []byte{0xFF, 0x80, 0x00}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got SystemExclusive8Message
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil {
+				if err == nil {
+					t.Fatalf("expected non-nil %v error, got nil error", test.err)
+				}
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
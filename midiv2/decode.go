@@ -0,0 +1,143 @@
+package midiv2
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrUnmarshallingMessage represents an error unmarshalling a MIDI 2.0 message.
+	ErrUnmarshallingMessage error = errors.New("error unmarshalling MIDI 2.0 message")
+
+	// ErrUnsupportedMessage represents a Universal MIDI Packet that does not map to a supported MIDI 2.0 message type.
+	ErrUnsupportedMessage error = fmt.Errorf("unsupported MIDI 2.0 message: %w", ErrUnmarshallingMessage)
+)
+
+// MessageUnmarshaler represents MIDI 2.0 message data that can be unmarshalled.
+type MessageUnmarshaler interface {
+	// UnmarshalMIDI unmarshalls the raw bytes of a Universal MIDI Packet into a MIDI 2.0 message.
+	UnmarshalMIDI(b []byte) error
+}
+
+// unmarshalerMessage represents a MIDI 2.0 message that can unmarshal itself.
+type unmarshalerMessage interface {
+	Message
+	MessageUnmarshaler
+}
+
+// PacketLength returns the number of bytes in the Universal MIDI Packet that begins with the supplied byte.
+func PacketLength(b byte) int {
+	return ParseMessageTypeFromByte(b).Length()
+}
+
+// Unmarshal unmarshalls the raw bytes of a single Universal MIDI Packet into the MIDI 2.0 message type identified by its
+// message type and status. MIDI 1.0 Channel Voice and System packets are unmarshalled into a *MIDI1ChannelVoiceMessage
+// or a *SystemMessage that carries the matching midiv1 message.
+//
+// Example: []byte{0x40, 0x91, 0x3C, 0x00, 0x80, 0x00, 0x00, 0x00}
+//
+// The example returns a *NoteOnMessage for group 1 (index 0), channel 2 (index 1), note number 60, velocity 0x8000.
+func Unmarshal(b []byte) (Message, error) {
+	if len(b) == 0 {
+		return nil, fmt.Errorf("universal MIDI packets must contain at least one word: %w", ErrUnmarshallingMessage)
+	}
+	messageType := ParseMessageTypeFromByte(b[0])
+	if len(b) != messageType.Length() {
+		return nil, fmt.Errorf("universal MIDI packets with message type %#x are made up of %d bytes, received %d byte(s): %w", messageType, messageType.Length(), len(b), ErrUnmarshallingMessage)
+	}
+
+	message, err := newMessage(messageType, b[1]>>4)
+	if err != nil {
+		return nil, err
+	}
+	if err := message.UnmarshalMIDI(b); err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+// UnmarshalPackets unmarshalls the raw bytes of a stream of Universal MIDI Packets into their MIDI 2.0 messages, in order.
+func UnmarshalPackets(b []byte) ([]Message, error) {
+	var messages []Message
+	for pos := 0; pos < len(b); {
+		length := PacketLength(b[pos])
+		if pos+length > len(b) {
+			return nil, fmt.Errorf("packet %d is made up of %d bytes, received %d byte(s): %w", len(messages), length, len(b)-pos, ErrUnmarshallingMessage)
+		}
+		message, err := Unmarshal(b[pos : pos+length])
+		if err != nil {
+			return nil, fmt.Errorf("packet %d: %w", len(messages), err)
+		}
+		messages = append(messages, message)
+		pos += length
+	}
+	return messages, nil
+}
+
+// newMessage returns an empty message of the type identified by the message type and the status nibble of a packet, or
+// an error if the type is not supported.
+func newMessage(messageType MessageType, status byte) (unmarshalerMessage, error) {
+	switch messageType {
+	case UtilityMessageType:
+		switch status {
+		case NoOpMessageStatus:
+			return &NoOpMessage{}, nil
+		case JRClockMessageStatus:
+			return &JRClockMessage{}, nil
+		case JRTimestampMessageStatus:
+			return &JRTimestampMessage{}, nil
+		}
+	case SystemMessageType:
+		return &SystemMessage{}, nil
+	case MIDI1ChannelVoiceMessageType:
+		return &MIDI1ChannelVoiceMessage{}, nil
+	case Data64MessageType:
+		if status <= byte(EndSystemExclusiveStatus) {
+			return &SystemExclusive7Message{}, nil
+		}
+	case MIDI2ChannelVoiceMessageType:
+		return newChannelVoiceMessage(status)
+	case Data128MessageType:
+		if status <= byte(EndSystemExclusiveStatus) {
+			return &SystemExclusive8Message{}, nil
+		}
+	}
+	return nil, fmt.Errorf("no supported message for message type %#x and status %#x: %w", messageType, status, ErrUnsupportedMessage)
+}
+
+// newChannelVoiceMessage returns an empty MIDI 2.0 Channel Voice message of the type identified by the status nibble.
+func newChannelVoiceMessage(status byte) (unmarshalerMessage, error) {
+	switch status {
+	case RegisteredPerNoteControllerMessageStatus:
+		return &RegisteredPerNoteControllerMessage{}, nil
+	case AssignablePerNoteControllerMessageStatus:
+		return &AssignablePerNoteControllerMessage{}, nil
+	case RegisteredControllerMessageStatus:
+		return &RegisteredControllerMessage{}, nil
+	case AssignableControllerMessageStatus:
+		return &AssignableControllerMessage{}, nil
+	case RelativeRegisteredControllerMessageStatus:
+		return &RelativeRegisteredControllerMessage{}, nil
+	case RelativeAssignableControllerMessageStatus:
+		return &RelativeAssignableControllerMessage{}, nil
+	case PerNotePitchBendMessageStatus:
+		return &PerNotePitchBendMessage{}, nil
+	case NoteOffMessageStatus:
+		return &NoteOffMessage{}, nil
+	case NoteOnMessageStatus:
+		return &NoteOnMessage{}, nil
+	case PolyPressureMessageStatus:
+		return &PolyPressureMessage{}, nil
+	case ControlChangeMessageStatus:
+		return &ControlChangeMessage{}, nil
+	case ProgramChangeMessageStatus:
+		return &ProgramChangeMessage{}, nil
+	case ChannelPressureMessageStatus:
+		return &ChannelPressureMessage{}, nil
+	case PitchBendMessageStatus:
+		return &PitchBendMessage{}, nil
+	case PerNoteManagementMessageStatus:
+		return &PerNoteManagementMessage{}, nil
+	}
+	return nil, fmt.Errorf("no supported MIDI 2.0 channel voice message for status %#x: %w", status, ErrUnsupportedMessage)
+}
@@ -0,0 +1,153 @@
+package midiv2
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+func Test_Unmarshal(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b        []byte
+		expected Message
+		err      error
+	}{
+		"empty byte slice returns error": {
+			b:   []byte{},
+			err: ErrUnmarshallingMessage,
+		},
+		"byte slice shorter than the packet returns error": {
+			b:   []byte{0x40, 0x91, 0x3C, 0x00},
+			err: ErrUnmarshallingMessage,
+		},
+		"unsupported utility status returns error": {
+			b:   []byte{0x00, 0x30, 0x00, 0x00},
+			err: ErrUnsupportedMessage,
+		},
+		"unsupported message type returns error": {
+			b:   []byte{0xD0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+			err: ErrUnsupportedMessage,
+		},
+		"unsupported data 64 status returns error": {
+			b:   []byte{0x30, 0x40, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+			err: ErrUnsupportedMessage,
+		},
+		"jr timestamp packet returns message": {
+			b:        []byte{0x00, 0x20, 0x00, 0x64},
+			expected: &JRTimestampMessage{SenderClockTimestamp: 100},
+		},
+		"midi 1.0 channel voice packet returns message": {
+			b:        []byte{0x21, 0x91, 0x3C, 0x64},
+			expected: &MIDI1ChannelVoiceMessage{Group: 1, Message: &midiv1.NoteOnMessage{Channel: 1, Note: 60, Velocity: 100}},
+		},
+		"midi 2.0 note-on packet returns message": {
+			b:        []byte{0x40, 0x91, 0x3C, 0x00, 0x80, 0x00, 0x00, 0x00},
+			expected: &NoteOnMessage{Channel: 1, Note: 60, Velocity: 0x8000},
+		},
+		"midi 2.0 pitch bend packet returns message": {
+			b:        []byte{0x40, 0xE0, 0x00, 0x00, 0x80, 0x00, 0x00, 0x00},
+			expected: &PitchBendMessage{Value: CenterPitchBend},
+		},
+		"data 64 packet returns message": {
+			b:        []byte{0x30, 0x02, 0x7E, 0x7F, 0x00, 0x00, 0x00, 0x00},
+			expected: &SystemExclusive7Message{Data: []byte{0x7E, 0x7F}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := Unmarshal(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil {
+				if err == nil {
+					t.Fatalf("expected non-nil %v error, got nil error", test.err)
+				}
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+			}
+			if !reflect.DeepEqual(test.expected, got) {
+				t.Fatalf("expected %+v, got %+v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_UnmarshalPackets(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b        []byte
+		expected []Message
+		err      error
+	}{
+		"truncated packet returns error": {
+			b:   []byte{0x00, 0x20, 0x00, 0x64, 0x40, 0x91, 0x3C, 0x00},
+			err: ErrUnmarshallingMessage,
+		},
+		"stream returns messages in order": {
+			b: []byte{
+				0x00, 0x20, 0x00, 0x64,
+				0x40, 0x91, 0x3C, 0x00, 0x80, 0x00, 0x00, 0x00,
+				0x10, 0xF8, 0x00, 0x00,
+			},
+			expected: []Message{
+				&JRTimestampMessage{SenderClockTimestamp: 100},
+				&NoteOnMessage{Channel: 1, Note: 60, Velocity: 0x8000},
+				&SystemMessage{Message: &midiv1.TimingClockMessage{}},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := UnmarshalPackets(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expected, got) {
+				t.Fatalf("expected %+v, got %+v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_MessageBuilder_RoundTrip(t *testing.T) {
+	t.Parallel()
+	messages := map[string]MessageBuilder{
+		"noop":                           &NoOpMessage{},
+		"jr clock":                       &JRClockMessage{SenderClockTime: 1234},
+		"system":                         &SystemMessage{Group: 4, Message: &midiv1.SongSelectMessage{Song: 9}},
+		"note-off":                       &NoteOffMessage{Group: 5, Channel: 6, Note: 70, Velocity: 0x1234},
+		"registered per-note controller": &RegisteredPerNoteControllerMessage{Note: 1, Index: 2, Value: 3},
+		"control change":                 &ControlChangeMessage{Channel: 9, Index: 64, Value: 0x7FFFFFFF},
+		"assignable controller":          &AssignableControllerMessage{Bank: 127, Index: 127, Value: 1},
+		"relative assignable controller": &RelativeAssignableControllerMessage{Value: -100},
+		"channel pressure":               &ChannelPressureMessage{Group: 8, Pressure: 42},
+		"per-note pitch bend":            &PerNotePitchBendMessage{Note: 127, Value: 0},
+		"system exclusive 8":             &SystemExclusive8Message{Status: ContinueSystemExclusiveStatus, StreamID: 255, Data: []byte{0xF0, 0xF7}},
+	}
+
+	for name, message := range messages {
+		t.Run(name, func(t *testing.T) {
+			b, err := message.MarshalMIDI()
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			got, err := Unmarshal(b)
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if !reflect.DeepEqual(message, got) {
+				t.Fatalf("expected %+v, got %+v", message, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package midiv2
+
+import "errors"
+
+var (
+	// ErrMarshallingMessage represents an error marshalling a MIDI 2.0 message.
+	ErrMarshallingMessage error = errors.New("error marshalling MIDI 2.0 message")
+)
+
+// MessageMarshaler represents MIDI 2.0 message data that can be marshalled.
+type MessageMarshaler interface {
+	// MarshalMIDI marshalls a MIDI 2.0 message into the raw bytes of its Universal MIDI Packet.
+	MarshalMIDI() ([]byte, error)
+}
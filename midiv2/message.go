@@ -0,0 +1,156 @@
+// Package midiv2 marshals and unmarshals MIDI 2.0 Universal MIDI Packets (UMP), as defined by the Universal MIDI Packet
+// (UMP) Format and MIDI 2.0 Protocol specification.
+//
+// A Universal MIDI Packet is made up of one, two, three or four 32-bit words, sent most-significant byte first. The
+// top four bits of the first word are the message type, which sets the size of the packet, and most message types
+// carry a group in the next four bits that selects one of 16 sets of 16 channels.
+package midiv2
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Message represents a MIDI 2.0 Universal MIDI Packet.
+type Message interface {
+	// GetMessageName returns the human-readable name of the MIDI 2.0 message.
+	GetMessageName() string
+}
+
+const (
+	// MessageVersion represents the MIDI version string for messages.
+	MessageVersion string = "MIDI 2.0"
+
+	// WordLength represents the number of bytes in a single 32-bit word of a Universal MIDI Packet.
+	WordLength int = 4
+)
+
+// MessageType represents the top four bits of a Universal MIDI Packet, which identify the kind of message and the
+// number of words in the packet.
+type MessageType byte
+
+const (
+	// UtilityMessageType represents the 32-bit Utility messages, such as NOOP and the Jitter Reduction messages.
+	UtilityMessageType MessageType = 0x0
+
+	// SystemMessageType represents the 32-bit System Real-Time and System Common messages.
+	SystemMessageType MessageType = 0x1
+
+	// MIDI1ChannelVoiceMessageType represents the 32-bit MIDI 1.0 Channel Voice messages.
+	MIDI1ChannelVoiceMessageType MessageType = 0x2
+
+	// Data64MessageType represents the 64-bit Data messages, which carry System Exclusive (7-bit) data.
+	Data64MessageType MessageType = 0x3
+
+	// MIDI2ChannelVoiceMessageType represents the 64-bit MIDI 2.0 Channel Voice messages.
+	MIDI2ChannelVoiceMessageType MessageType = 0x4
+
+	// Data128MessageType represents the 128-bit Data messages, which carry System Exclusive 8 and Mixed Data Set data.
+	Data128MessageType MessageType = 0x5
+
+	// FlexDataMessageType represents the 128-bit Flex Data messages.
+	FlexDataMessageType MessageType = 0xD
+
+	// UMPStreamMessageType represents the 128-bit UMP Stream messages.
+	UMPStreamMessageType MessageType = 0xF
+
+	// MaxMessageType represents the highest message type.
+	MaxMessageType MessageType = 0xF
+)
+
+// messageTypeWords holds the number of words in a packet of each message type, including the reserved message types.
+var messageTypeWords = [MaxMessageType + 1]int{1, 1, 1, 2, 2, 4, 1, 1, 2, 2, 2, 3, 3, 4, 4, 4}
+
+// Words returns the number of 32-bit words in a packet of the message type.
+func (mt MessageType) Words() int {
+	return messageTypeWords[mt&MaxMessageType]
+}
+
+// Length returns the number of bytes in a packet of the message type.
+func (mt MessageType) Length() int {
+	return mt.Words() * WordLength
+}
+
+// ParseMessageTypeFromByte returns the MessageType in the top four bits of the first byte of a packet.
+func ParseMessageTypeFromByte(b byte) MessageType {
+	return MessageType(b >> 4)
+}
+
+// Group represents one of the 16 groups of a Universal MIDI Packet stream, each of which carries 16 channels.
+//
+// Example: 0b00000010 (index 2 is group 3)
+type Group byte
+
+var (
+	// ErrInvalidGroup represents an invalid UMP group.
+	ErrInvalidGroup error = errors.New("invalid UMP group")
+)
+
+const (
+	// MinGroup is the lowest group available (index 0 is group 1).
+	MinGroup Group = 0x0
+
+	// MaxGroup is the highest group available (index 15 is group 16).
+	MaxGroup Group = 0xF
+)
+
+// NewGroup returns a Group based on the integer argument.
+func NewGroup(group int) (Group, error) {
+	if group < int(MinGroup) || group > int(MaxGroup) {
+		return MinGroup, fmt.Errorf("valid groups are between %d and %d, inclusive: %w", MinGroup, MaxGroup, ErrInvalidGroup)
+	}
+	return Group(group), nil
+}
+
+// ParseGroupFromByte returns the Group in the low four bits of the first byte of a packet.
+func ParseGroupFromByte(b byte) Group {
+	return Group(b & byte(MaxGroup))
+}
+
+// makeTypeAndGroupByte returns the first byte of a packet from its message type and group.
+func makeTypeAndGroupByte(messageType MessageType, group Group) byte {
+	return byte(messageType)<<4 | byte(group&MaxGroup)
+}
+
+// BytesToWords returns the 32-bit words carried by the raw bytes of one or more packets, or an error if the bytes do not
+// make up whole words.
+func BytesToWords(b []byte) ([]uint32, error) {
+	if len(b)%WordLength != 0 {
+		return nil, fmt.Errorf("universal MIDI packets are made up of whole %d-byte words, received %d byte(s): %w", WordLength, len(b), ErrUnmarshallingMessage)
+	}
+	words := make([]uint32, 0, len(b)/WordLength)
+	for i := 0; i < len(b); i += WordLength {
+		words = append(words, binary.BigEndian.Uint32(b[i:]))
+	}
+	return words, nil
+}
+
+// WordsToBytes returns the raw bytes of one or more packets from their 32-bit words.
+func WordsToBytes(words ...uint32) []byte {
+	b := make([]byte, len(words)*WordLength)
+	for i, word := range words {
+		binary.BigEndian.PutUint32(b[i*WordLength:], word)
+	}
+	return b
+}
+
+// checkPacket returns an error if the raw bytes are not a single packet of the supplied message type. The name is used
+// to describe the message in any returned errors.
+func checkPacket(name string, messageType MessageType, b []byte) error {
+	if len(b) != messageType.Length() {
+		return fmt.Errorf("%s messages are made up of %d bytes, received %d byte(s): %w", name, messageType.Length(), len(b), ErrUnmarshallingMessage)
+	}
+	if got := ParseMessageTypeFromByte(b[0]); got != messageType {
+		return fmt.Errorf("%s messages must have message type %#x, received %#x: %w", name, messageType, got, ErrUnmarshallingMessage)
+	}
+	return nil
+}
+
+// checkGroup returns an error if the group is out of range. The name is used to describe the message in any returned errors.
+func checkGroup(name string, group Group) error {
+	if group > MaxGroup {
+		return fmt.Errorf("%s messages must have a group between %d and %d, inclusive, received %d: %w", name, MinGroup, MaxGroup, group, ErrMarshallingMessage)
+	}
+	return nil
+}
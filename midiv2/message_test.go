@@ -0,0 +1,145 @@
+package midiv2
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_MessageType_Length(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		messageType MessageType
+		expected    int
+	}{
+		"utility packets are one word": {
+			messageType: UtilityMessageType,
+			expected:    4,
+		},
+		"midi 1.0 channel voice packets are one word": {
+			messageType: MIDI1ChannelVoiceMessageType,
+			expected:    4,
+		},
+		"data 64 packets are two words": {
+			messageType: Data64MessageType,
+			expected:    8,
+		},
+		"midi 2.0 channel voice packets are two words": {
+			messageType: MIDI2ChannelVoiceMessageType,
+			expected:    8,
+		},
+		"reserved 0xB packets are three words": {
+			messageType: 0xB,
+			expected:    12,
+		},
+		"data 128 packets are four words": {
+			messageType: Data128MessageType,
+			expected:    16,
+		},
+		"ump stream packets are four words": {
+			messageType: UMPStreamMessageType,
+			expected:    16,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := test.messageType.Length(); got != test.expected {
+				t.Fatalf("expected %d, got %d", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_ParseMessageTypeFromByte(t *testing.T) {
+	t.Parallel()
+	if got := ParseMessageTypeFromByte(0x4A); got != MIDI2ChannelVoiceMessageType {
+		t.Fatalf("expected %#x, got %#x", MIDI2ChannelVoiceMessageType, got)
+	}
+}
+
+func Test_NewGroup(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		group    int
+		expected Group
+		err      error
+	}{
+		"negative group returns error": {
+			group: -1,
+			err:   ErrInvalidGroup,
+		},
+		"group above the highest returns error": {
+			group: 16,
+			err:   ErrInvalidGroup,
+		},
+		"highest group returns group": {
+			group:    15,
+			expected: MaxGroup,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewGroup(test.group)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if got != test.expected {
+				t.Fatalf("expected %d, got %d", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_ParseGroupFromByte(t *testing.T) {
+	t.Parallel()
+	if got := ParseGroupFromByte(0x4A); got != 0xA {
+		t.Fatalf("expected %d, got %d", 0xA, got)
+	}
+}
+
+func Test_BytesToWords(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b        []byte
+		expected []uint32
+		err      error
+	}{
+		"bytes that are not whole words return error": {
+			b:   []byte{0x40, 0x90, 0x3C},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes return expected words": {
+			b:        []byte{0x40, 0x90, 0x3C, 0x00, 0x80, 0x00, 0x00, 0x00},
+			expected: []uint32{0x40903C00, 0x80000000},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := BytesToWords(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_WordsToBytes(t *testing.T) {
+	t.Parallel()
+	expected := []byte{0x40, 0x90, 0x3C, 0x00, 0x80, 0x00, 0x00, 0x00}
+	if got := WordsToBytes(0x40903C00, 0x80000000); !bytes.Equal(expected, got) {
+		t.Fatalf("expected %#v, got %#v", expected, got)
+	}
+}
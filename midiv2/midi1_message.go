@@ -0,0 +1,161 @@
+package midiv2
+
+import (
+	"fmt"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+const (
+	// MIDI1MessageStringFormat represents the printf-compatible format specifically for a message string of a MIDI 1.0
+	// message carried in a Universal MIDI Packet.
+	MIDI1MessageStringFormat string = "%s:%s:%d:%v"
+
+	// maxMIDI1MessageLength represents the number of MIDI 1.0 bytes that fit in a 32-bit packet after the first byte.
+	maxMIDI1MessageLength int = 3
+)
+
+// MIDI1ChannelVoiceMessage represents a MIDI 1.0 Channel Voice or Channel Mode message carried in a 32-bit Universal
+// MIDI Packet, as sent to devices that use the MIDI 1.0 Protocol.
+type MIDI1ChannelVoiceMessage struct {
+	// Group represents the group where this message will be sent.
+	Group Group
+
+	// Message represents the MIDI 1.0 Channel Voice or Channel Mode message, such as a *midiv1.NoteOnMessage. It must
+	// also implement midiv1.MessageMarshaler to be marshalled.
+	Message midiv1.Message
+}
+
+// GetMessageName returns the name of this MIDI 1.0 Channel Voice message.
+func (m1cvm *MIDI1ChannelVoiceMessage) GetMessageName() string {
+	return "MIDI 1.0 Channel Voice"
+}
+
+// MarshalMIDI marshalls a MIDI1ChannelVoiceMessage MIDI 2.0 message into the raw bytes of its Universal MIDI Packet.
+func (m1cvm MIDI1ChannelVoiceMessage) MarshalMIDI() ([]byte, error) {
+	return marshalMIDI1Message("midi 1.0 channel voice", MIDI1ChannelVoiceMessageType, m1cvm.Group, m1cvm.Message)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (m1cvm *MIDI1ChannelVoiceMessage) String() string {
+	return fmt.Sprintf(MIDI1MessageStringFormat, MessageVersion, m1cvm.GetMessageName(), m1cvm.Group, m1cvm.Message)
+}
+
+// UnmarshalMIDI unmarshalls the raw bytes of a Universal MIDI Packet into a MIDI1ChannelVoiceMessage struct pointer.
+// The MIDI 1.0 message is unmarshalled with midiv1.Unmarshal.
+//
+// Example: []byte{0x21, 0x91, 0x3C, 0x64}
+//
+// The example forms a MIDI 1.0 Channel Voice message for group 2 (index 1) carrying a Note-On message for channel 2
+// (index 1), note number 60, velocity value 100.
+func (m1cvm *MIDI1ChannelVoiceMessage) UnmarshalMIDI(b []byte) error {
+	group, message, err := unmarshalMIDI1Message("midi 1.0 channel voice", MIDI1ChannelVoiceMessageType, b)
+	if err != nil {
+		return err
+	}
+	*m1cvm = MIDI1ChannelVoiceMessage{
+		Group:   group,
+		Message: message,
+	}
+	return nil
+}
+
+// SystemMessage represents a MIDI 1.0 System Common or System Real-Time message carried in a 32-bit Universal MIDI
+// Packet. System Exclusive messages are carried by SystemExclusive7Message instead.
+type SystemMessage struct {
+	// Group represents the group where this message will be sent.
+	Group Group
+
+	// Message represents the MIDI 1.0 System Common or System Real-Time message, such as a *midiv1.TimingClockMessage.
+	// It must also implement midiv1.MessageMarshaler to be marshalled.
+	Message midiv1.Message
+}
+
+// GetMessageName returns the name of this System message.
+func (sm *SystemMessage) GetMessageName() string {
+	return "System"
+}
+
+// MarshalMIDI marshalls a SystemMessage MIDI 2.0 message into the raw bytes of its Universal MIDI Packet.
+func (sm SystemMessage) MarshalMIDI() ([]byte, error) {
+	return marshalMIDI1Message("system", SystemMessageType, sm.Group, sm.Message)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (sm *SystemMessage) String() string {
+	return fmt.Sprintf(MIDI1MessageStringFormat, MessageVersion, sm.GetMessageName(), sm.Group, sm.Message)
+}
+
+// UnmarshalMIDI unmarshalls the raw bytes of a Universal MIDI Packet into a SystemMessage struct pointer. The MIDI 1.0
+// message is unmarshalled with midiv1.Unmarshal.
+//
+// Example: []byte{0x10, 0xF8, 0x00, 0x00}
+//
+// The example forms a System message for group 1 (index 0) carrying a Timing Clock message.
+func (sm *SystemMessage) UnmarshalMIDI(b []byte) error {
+	group, message, err := unmarshalMIDI1Message("system", SystemMessageType, b)
+	if err != nil {
+		return err
+	}
+	*sm = SystemMessage{
+		Group:   group,
+		Message: message,
+	}
+	return nil
+}
+
+// isMIDI1StatusForType returns whether the MIDI 1.0 status byte can be carried by a packet of the message type.
+func isMIDI1StatusForType(messageType MessageType, status byte) bool {
+	if !midiv1.ByteHasStatusMSB(status) {
+		return false
+	}
+	isSystem := midiv1.ParseStatusFromStatusByte(status) == midiv1.SystemMessageStatusNibble
+	if messageType == SystemMessageType {
+		return isSystem && status != midiv1.SystemExclusiveMessageStatus && status != midiv1.EndOfExclusiveStatus
+	}
+	return !isSystem
+}
+
+// marshalMIDI1Message returns the packet of the message type that carries the MIDI 1.0 message. The name is used to
+// describe the message in any returned errors.
+func marshalMIDI1Message(name string, messageType MessageType, group Group, message midiv1.Message) ([]byte, error) {
+	if err := checkGroup(name, group); err != nil {
+		return nil, err
+	}
+	marshaler, ok := message.(midiv1.MessageMarshaler)
+	if !ok {
+		return nil, fmt.Errorf("%s messages must carry a MIDI 1.0 message that can be marshalled, received %T: %w", name, message, ErrMarshallingMessage)
+	}
+	mb, err := marshaler.MarshalMIDI()
+	if err != nil {
+		return nil, fmt.Errorf("%s messages could not marshal the MIDI 1.0 message (%v): %w", name, err, ErrMarshallingMessage)
+	}
+	if len(mb) == 0 || len(mb) > maxMIDI1MessageLength || !isMIDI1StatusForType(messageType, mb[0]) {
+		return nil, fmt.Errorf("%s messages cannot carry the MIDI 1.0 message % X: %w", name, mb, ErrMarshallingMessage)
+	}
+
+	b := make([]byte, WordLength)
+	b[0] = makeTypeAndGroupByte(messageType, group)
+	copy(b[1:], mb)
+	return b, nil
+}
+
+// unmarshalMIDI1Message returns the group and the MIDI 1.0 message carried by a packet of the message type. The name is
+// used to describe the message in any returned errors.
+func unmarshalMIDI1Message(name string, messageType MessageType, b []byte) (Group, midiv1.Message, error) {
+	if err := checkPacket(name, messageType, b); err != nil {
+		return MinGroup, nil, err
+	}
+	if !isMIDI1StatusForType(messageType, b[1]) {
+		return MinGroup, nil, fmt.Errorf("%s messages cannot carry MIDI 1.0 status byte %#x: %w", name, b[1], ErrUnmarshallingMessage)
+	}
+	length, err := midiv1.MessageLength(b[1])
+	if err != nil {
+		return MinGroup, nil, fmt.Errorf("%s messages could not size the MIDI 1.0 message (%v): %w", name, err, ErrUnmarshallingMessage)
+	}
+	message, err := midiv1.Unmarshal(b[1 : 1+length])
+	if err != nil {
+		return MinGroup, nil, fmt.Errorf("%s messages could not unmarshal the MIDI 1.0 message (%v): %w", name, err, ErrUnmarshallingMessage)
+	}
+	return ParseGroupFromByte(b[0]), message, nil
+}
@@ -0,0 +1,185 @@
+package midiv2
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+func Test_MIDI1ChannelVoiceMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  MIDI1ChannelVoiceMessage
+		expected []byte
+		err      error
+	}{
+		"message without a marshaler returns error": {
+			message: MIDI1ChannelVoiceMessage{Message: nil},
+			err:     ErrMarshallingMessage,
+		},
+		"system message returns error": {
+			message: MIDI1ChannelVoiceMessage{Message: &midiv1.TimingClockMessage{}},
+			err:     ErrMarshallingMessage,
+		},
+		"group above the highest returns error": {
+			message: MIDI1ChannelVoiceMessage{Group: 16, Message: &midiv1.NoteOnMessage{Channel: 1, Note: 60, Velocity: 100}},
+			err:     ErrMarshallingMessage,
+		},
+		"message marshalls into expected bytes": {
+			message:  MIDI1ChannelVoiceMessage{Group: 1, Message: &midiv1.NoteOnMessage{Channel: 1, Note: 60, Velocity: 100}},
+			expected: []byte{0x21, 0x91, 0x3C, 0x64},
+		},
+		"two-byte message marshalls into expected bytes": {
+			message:  MIDI1ChannelVoiceMessage{Message: &midiv1.ProgramChangeMessage{Channel: 2, Program: 5}},
+			expected: []byte{0x20, 0xC2, 0x05, 0x00},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_MIDI1ChannelVoiceMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage MIDI1ChannelVoiceMessage
+		err             error
+	}{
+		"byte slice is not proper length": {
+			b:   []byte{0x21, 0x91, 0x3C, 0x64, 0x00},
+			err: ErrUnmarshallingMessage,
+		},
+		"packet carries a system message": {
+			b:   []byte{0x20, 0xF8, 0x00, 0x00},
+			err: ErrUnmarshallingMessage,
+		},
+		"packet carries a data byte": {
+			b:   []byte{0x20, 0x3C, 0x00, 0x00},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b: []byte{0x21, 0x91, 0x3C, 0x64},
+			expectedMessage: MIDI1ChannelVoiceMessage{
+				Group:   1,
+				Message: &midiv1.NoteOnMessage{Channel: 1, Note: 60, Velocity: 100},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got MIDI1ChannelVoiceMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil {
+				if err == nil {
+					t.Fatalf("expected non-nil %v error, got nil error", test.err)
+				}
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
+
+func Test_SystemMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  SystemMessage
+		expected []byte
+		err      error
+	}{
+		"channel voice message returns error": {
+			message: SystemMessage{Message: &midiv1.NoteOnMessage{Channel: 1, Note: 60, Velocity: 100}},
+			err:     ErrMarshallingMessage,
+		},
+		"message marshalls into expected bytes": {
+			message:  SystemMessage{Message: &midiv1.TimingClockMessage{}},
+			expected: []byte{0x10, 0xF8, 0x00, 0x00},
+		},
+		"song select message marshalls into expected bytes": {
+			message:  SystemMessage{Group: 3, Message: &midiv1.SongSelectMessage{Song: 4}},
+			expected: []byte{0x13, 0xF3, 0x04, 0x00},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_SystemMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage SystemMessage
+		err             error
+	}{
+		"packet carries a system exclusive status": {
+			b:   []byte{0x10, 0xF0, 0x00, 0x00},
+			err: ErrUnmarshallingMessage,
+		},
+		"packet carries a channel voice message": {
+			b:   []byte{0x10, 0x91, 0x3C, 0x64},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b:               []byte{0x10, 0xF8, 0x00, 0x00},
+			expectedMessage: SystemMessage{Message: &midiv1.TimingClockMessage{}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got SystemMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil {
+				if err == nil {
+					t.Fatalf("expected non-nil %v error, got nil error", test.err)
+				}
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
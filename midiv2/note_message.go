@@ -0,0 +1,473 @@
+package midiv2
+
+import (
+	"fmt"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+// AttributeType represents the kind of attribute data carried by a MIDI 2.0 Note-On or Note-Off message.
+type AttributeType byte
+
+const (
+	// NoAttributeType represents a note message without attribute data.
+	NoAttributeType AttributeType = 0x00
+
+	// ManufacturerSpecificAttributeType represents attribute data defined by a manufacturer.
+	ManufacturerSpecificAttributeType AttributeType = 0x01
+
+	// ProfileSpecificAttributeType represents attribute data defined by a MIDI-CI Profile.
+	ProfileSpecificAttributeType AttributeType = 0x02
+
+	// PitchAttributeType represents a Pitch 7.9 attribute: a 7-bit note number and a 9-bit fraction of a semitone.
+	PitchAttributeType AttributeType = 0x03
+)
+
+const (
+	// NoteMessageStringFormat represents the printf-compatible format specifically for a MIDI 2.0 Note-On or Note-Off
+	// message string: version, name, group, channel, note, velocity, attribute type and attribute.
+	NoteMessageStringFormat string = "%s:%s:%d:%d:%d:%d:%d:%d"
+
+	// PerNoteManagementMessageStringFormat represents the printf-compatible format specifically for a Per-Note
+	// Management message string: version, name, group, channel, note, detach and reset.
+	PerNoteManagementMessageStringFormat string = "%s:%s:%d:%d:%d:%t:%t"
+
+	// PerNoteControllerMessageStringFormat represents the printf-compatible format specifically for a per-note
+	// controller message string: version, name, group, channel, note, controller index and value.
+	PerNoteControllerMessageStringFormat string = "%s:%s:%d:%d:%d:%d:%d"
+
+	// perNoteManagementDetachFlag represents the Detach Per-Note Controllers flag of a Per-Note Management message.
+	perNoteManagementDetachFlag byte = 0b10
+
+	// perNoteManagementResetFlag represents the Reset (Set to Default) Per-Note Controllers flag of a Per-Note
+	// Management message.
+	perNoteManagementResetFlag byte = 0b01
+)
+
+// NoteOffMessage represents a MIDI 2.0 Note-Off message.
+type NoteOffMessage struct {
+	// Group represents the group where this message will be sent.
+	Group Group
+
+	// Channel represents the MIDI channel where this message will be sent.
+	Channel midiv1.Channel
+
+	// Note represents the MIDI note number.
+	Note midiv1.Note
+
+	// Velocity represents the 16-bit release velocity.
+	Velocity uint16
+
+	// AttributeType represents the kind of attribute data carried by Attribute.
+	AttributeType AttributeType
+
+	// Attribute represents the 16-bit attribute data.
+	Attribute uint16
+}
+
+// GetMessageName returns the name of this Note-Off message.
+func (nom *NoteOffMessage) GetMessageName() string {
+	return "Note-Off"
+}
+
+// MarshalMIDI marshalls a NoteOffMessage MIDI 2.0 message into the raw bytes of its Universal MIDI Packet.
+func (nom NoteOffMessage) MarshalMIDI() ([]byte, error) {
+	return marshalNoteMessage("note-off", NoteOffMessageStatus, nom.Group, nom.Channel, nom.Note, nom.Velocity, nom.AttributeType, nom.Attribute)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (nom *NoteOffMessage) String() string {
+	return fmt.Sprintf(NoteMessageStringFormat, MessageVersion, nom.GetMessageName(), nom.Group, nom.Channel, nom.Note, nom.Velocity, nom.AttributeType, nom.Attribute)
+}
+
+// UnmarshalMIDI unmarshalls the raw bytes of a Universal MIDI Packet into a NoteOffMessage struct pointer.
+//
+// Example: []byte{0x40, 0x80, 0x3C, 0x00, 0x80, 0x00, 0x00, 0x00}
+//
+// The example forms a Note-Off message for group 1 (index 0), channel 1 (index 0), note number 60, velocity 0x8000
+// and no attribute.
+func (nom *NoteOffMessage) UnmarshalMIDI(b []byte) error {
+	cvf, note, err := unmarshalNoteMessage("note-off", NoteOffMessageStatus, b)
+	if err != nil {
+		return err
+	}
+	*nom = NoteOffMessage{
+		Group:         cvf.group,
+		Channel:       cvf.channel,
+		Note:          note,
+		Velocity:      uint16(cvf.data >> 16),
+		AttributeType: AttributeType(cvf.index2),
+		Attribute:     uint16(cvf.data),
+	}
+	return nil
+}
+
+// NoteOnMessage represents a MIDI 2.0 Note-On message. Unlike MIDI 1.0, a velocity of zero does not turn the note off.
+type NoteOnMessage struct {
+	// Group represents the group where this message will be sent.
+	Group Group
+
+	// Channel represents the MIDI channel where this message will be sent.
+	Channel midiv1.Channel
+
+	// Note represents the MIDI note number.
+	Note midiv1.Note
+
+	// Velocity represents the 16-bit attack velocity.
+	Velocity uint16
+
+	// AttributeType represents the kind of attribute data carried by Attribute.
+	AttributeType AttributeType
+
+	// Attribute represents the 16-bit attribute data.
+	Attribute uint16
+}
+
+// GetMessageName returns the name of this Note-On message.
+func (nom *NoteOnMessage) GetMessageName() string {
+	return "Note-On"
+}
+
+// MarshalMIDI marshalls a NoteOnMessage MIDI 2.0 message into the raw bytes of its Universal MIDI Packet.
+func (nom NoteOnMessage) MarshalMIDI() ([]byte, error) {
+	return marshalNoteMessage("note-on", NoteOnMessageStatus, nom.Group, nom.Channel, nom.Note, nom.Velocity, nom.AttributeType, nom.Attribute)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (nom *NoteOnMessage) String() string {
+	return fmt.Sprintf(NoteMessageStringFormat, MessageVersion, nom.GetMessageName(), nom.Group, nom.Channel, nom.Note, nom.Velocity, nom.AttributeType, nom.Attribute)
+}
+
+// UnmarshalMIDI unmarshalls the raw bytes of a Universal MIDI Packet into a NoteOnMessage struct pointer.
+//
+// Example: []byte{0x40, 0x91, 0x3C, 0x03, 0xC0, 0x00, 0x78, 0x80}
+//
+// The example forms a Note-On message for group 1 (index 0), channel 2 (index 1), note number 60, velocity 0xC000
+// and a pitch attribute of note 60 plus half a semitone.
+func (nom *NoteOnMessage) UnmarshalMIDI(b []byte) error {
+	cvf, note, err := unmarshalNoteMessage("note-on", NoteOnMessageStatus, b)
+	if err != nil {
+		return err
+	}
+	*nom = NoteOnMessage{
+		Group:         cvf.group,
+		Channel:       cvf.channel,
+		Note:          note,
+		Velocity:      uint16(cvf.data >> 16),
+		AttributeType: AttributeType(cvf.index2),
+		Attribute:     uint16(cvf.data),
+	}
+	return nil
+}
+
+// PolyPressureMessage represents a MIDI 2.0 Poly Pressure message, also known as Polyphonic Key Pressure.
+type PolyPressureMessage struct {
+	// Group represents the group where this message will be sent.
+	Group Group
+
+	// Channel represents the MIDI channel where this message will be sent.
+	Channel midiv1.Channel
+
+	// Note represents the MIDI note number.
+	Note midiv1.Note
+
+	// Pressure represents the 32-bit pressure value.
+	Pressure uint32
+}
+
+// GetMessageName returns the name of this Poly Pressure message.
+func (ppm *PolyPressureMessage) GetMessageName() string {
+	return "Poly Pressure"
+}
+
+// MarshalMIDI marshalls a PolyPressureMessage MIDI 2.0 message into the raw bytes of its Universal MIDI Packet.
+func (ppm PolyPressureMessage) MarshalMIDI() ([]byte, error) {
+	return marshalPerNoteMessage("poly pressure", PolyPressureMessageStatus, ppm.Group, ppm.Channel, ppm.Note, 0, ppm.Pressure)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (ppm *PolyPressureMessage) String() string {
+	return fmt.Sprintf(ChannelVoiceMessageStringFormat, MessageVersion, ppm.GetMessageName(), ppm.Group, ppm.Channel, ppm.Note, ppm.Pressure)
+}
+
+// UnmarshalMIDI unmarshalls the raw bytes of a Universal MIDI Packet into a PolyPressureMessage struct pointer.
+//
+// Example: []byte{0x41, 0xA0, 0x3C, 0x00, 0x80, 0x00, 0x00, 0x00}
+//
+// The example forms a Poly Pressure message for group 2 (index 1), channel 1 (index 0), note number 60, pressure
+// 0x80000000.
+func (ppm *PolyPressureMessage) UnmarshalMIDI(b []byte) error {
+	cvf, note, err := unmarshalNoteMessage("poly pressure", PolyPressureMessageStatus, b)
+	if err != nil {
+		return err
+	}
+	*ppm = PolyPressureMessage{
+		Group:    cvf.group,
+		Channel:  cvf.channel,
+		Note:     note,
+		Pressure: cvf.data,
+	}
+	return nil
+}
+
+// RegisteredPerNoteControllerMessage represents a MIDI 2.0 Registered Per-Note Controller message, which sets one of
+// the 256 controllers defined by the MIDI Association for a single note.
+type RegisteredPerNoteControllerMessage struct {
+	// Group represents the group where this message will be sent.
+	Group Group
+
+	// Channel represents the MIDI channel where this message will be sent.
+	Channel midiv1.Channel
+
+	// Note represents the MIDI note number.
+	Note midiv1.Note
+
+	// Index represents the registered per-note controller.
+	Index byte
+
+	// Value represents the 32-bit controller value.
+	Value uint32
+}
+
+// GetMessageName returns the name of this Registered Per-Note Controller message.
+func (rpncm *RegisteredPerNoteControllerMessage) GetMessageName() string {
+	return "Registered Per-Note Controller"
+}
+
+// MarshalMIDI marshalls a RegisteredPerNoteControllerMessage MIDI 2.0 message into the raw bytes of its Universal MIDI
+// Packet.
+func (rpncm RegisteredPerNoteControllerMessage) MarshalMIDI() ([]byte, error) {
+	return marshalPerNoteMessage("registered per-note controller", RegisteredPerNoteControllerMessageStatus, rpncm.Group, rpncm.Channel, rpncm.Note, rpncm.Index, rpncm.Value)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (rpncm *RegisteredPerNoteControllerMessage) String() string {
+	return fmt.Sprintf(PerNoteControllerMessageStringFormat, MessageVersion, rpncm.GetMessageName(), rpncm.Group, rpncm.Channel, rpncm.Note, rpncm.Index, rpncm.Value)
+}
+
+// UnmarshalMIDI unmarshalls the raw bytes of a Universal MIDI Packet into a RegisteredPerNoteControllerMessage struct
+// pointer.
+//
+// Example: []byte{0x40, 0x00, 0x3C, 0x03, 0x00, 0x00, 0x10, 0x00}
+//
+// The example forms a Registered Per-Note Controller message for group 1 (index 0), channel 1 (index 0), note number
+// 60, controller 3, value 0x1000.
+func (rpncm *RegisteredPerNoteControllerMessage) UnmarshalMIDI(b []byte) error {
+	cvf, note, err := unmarshalNoteMessage("registered per-note controller", RegisteredPerNoteControllerMessageStatus, b)
+	if err != nil {
+		return err
+	}
+	*rpncm = RegisteredPerNoteControllerMessage{
+		Group:   cvf.group,
+		Channel: cvf.channel,
+		Note:    note,
+		Index:   cvf.index2,
+		Value:   cvf.data,
+	}
+	return nil
+}
+
+// AssignablePerNoteControllerMessage represents a MIDI 2.0 Assignable Per-Note Controller message, which sets one of
+// the 256 controllers free for any use for a single note.
+type AssignablePerNoteControllerMessage struct {
+	// Group represents the group where this message will be sent.
+	Group Group
+
+	// Channel represents the MIDI channel where this message will be sent.
+	Channel midiv1.Channel
+
+	// Note represents the MIDI note number.
+	Note midiv1.Note
+
+	// Index represents the assignable per-note controller.
+	Index byte
+
+	// Value represents the 32-bit controller value.
+	Value uint32
+}
+
+// GetMessageName returns the name of this Assignable Per-Note Controller message.
+func (apncm *AssignablePerNoteControllerMessage) GetMessageName() string {
+	return "Assignable Per-Note Controller"
+}
+
+// MarshalMIDI marshalls an AssignablePerNoteControllerMessage MIDI 2.0 message into the raw bytes of its Universal MIDI
+// Packet.
+func (apncm AssignablePerNoteControllerMessage) MarshalMIDI() ([]byte, error) {
+	return marshalPerNoteMessage("assignable per-note controller", AssignablePerNoteControllerMessageStatus, apncm.Group, apncm.Channel, apncm.Note, apncm.Index, apncm.Value)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (apncm *AssignablePerNoteControllerMessage) String() string {
+	return fmt.Sprintf(PerNoteControllerMessageStringFormat, MessageVersion, apncm.GetMessageName(), apncm.Group, apncm.Channel, apncm.Note, apncm.Index, apncm.Value)
+}
+
+// UnmarshalMIDI unmarshalls the raw bytes of a Universal MIDI Packet into an AssignablePerNoteControllerMessage struct
+// pointer.
+//
+// Example: []byte{0x40, 0x10, 0x3C, 0x10, 0xFF, 0xFF, 0xFF, 0xFF}
+//
+// The example forms an Assignable Per-Note Controller message for group 1 (index 0), channel 1 (index 0), note number
+// 60, controller 16, value 0xFFFFFFFF.
+func (apncm *AssignablePerNoteControllerMessage) UnmarshalMIDI(b []byte) error {
+	cvf, note, err := unmarshalNoteMessage("assignable per-note controller", AssignablePerNoteControllerMessageStatus, b)
+	if err != nil {
+		return err
+	}
+	*apncm = AssignablePerNoteControllerMessage{
+		Group:   cvf.group,
+		Channel: cvf.channel,
+		Note:    note,
+		Index:   cvf.index2,
+		Value:   cvf.data,
+	}
+	return nil
+}
+
+// PerNotePitchBendMessage represents a MIDI 2.0 Per-Note Pitch Bend message, which bends the pitch of a single note.
+type PerNotePitchBendMessage struct {
+	// Group represents the group where this message will be sent.
+	Group Group
+
+	// Channel represents the MIDI channel where this message will be sent.
+	Channel midiv1.Channel
+
+	// Note represents the MIDI note number.
+	Note midiv1.Note
+
+	// Value represents the 32-bit pitch bend value, where CenterPitchBend is no bend.
+	Value uint32
+}
+
+// GetMessageName returns the name of this Per-Note Pitch Bend message.
+func (pnpbm *PerNotePitchBendMessage) GetMessageName() string {
+	return "Per-Note Pitch Bend"
+}
+
+// MarshalMIDI marshalls a PerNotePitchBendMessage MIDI 2.0 message into the raw bytes of its Universal MIDI Packet.
+func (pnpbm PerNotePitchBendMessage) MarshalMIDI() ([]byte, error) {
+	return marshalPerNoteMessage("per-note pitch bend", PerNotePitchBendMessageStatus, pnpbm.Group, pnpbm.Channel, pnpbm.Note, 0, pnpbm.Value)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (pnpbm *PerNotePitchBendMessage) String() string {
+	return fmt.Sprintf(ChannelVoiceMessageStringFormat, MessageVersion, pnpbm.GetMessageName(), pnpbm.Group, pnpbm.Channel, pnpbm.Note, pnpbm.Value)
+}
+
+// UnmarshalMIDI unmarshalls the raw bytes of a Universal MIDI Packet into a PerNotePitchBendMessage struct pointer.
+//
+// Example: []byte{0x40, 0x60, 0x3C, 0x00, 0x80, 0x00, 0x00, 0x00}
+//
+// The example forms a Per-Note Pitch Bend message for group 1 (index 0), channel 1 (index 0), note number 60 and no
+// bend.
+func (pnpbm *PerNotePitchBendMessage) UnmarshalMIDI(b []byte) error {
+	cvf, note, err := unmarshalNoteMessage("per-note pitch bend", PerNotePitchBendMessageStatus, b)
+	if err != nil {
+		return err
+	}
+	*pnpbm = PerNotePitchBendMessage{
+		Group:   cvf.group,
+		Channel: cvf.channel,
+		Note:    note,
+		Value:   cvf.data,
+	}
+	return nil
+}
+
+// PerNoteManagementMessage represents a MIDI 2.0 Per-Note Management message, which detaches or resets the per-note
+// controllers of a note.
+type PerNoteManagementMessage struct {
+	// Group represents the group where this message will be sent.
+	Group Group
+
+	// Channel represents the MIDI channel where this message will be sent.
+	Channel midiv1.Channel
+
+	// Note represents the MIDI note number.
+	Note midiv1.Note
+
+	// Detach represents whether the per-note controllers of previous notes with the same note number are detached.
+	Detach bool
+
+	// Reset represents whether the per-note controllers of the note are reset to their defaults.
+	Reset bool
+}
+
+// GetMessageName returns the name of this Per-Note Management message.
+func (pnmm *PerNoteManagementMessage) GetMessageName() string {
+	return "Per-Note Management"
+}
+
+// MarshalMIDI marshalls a PerNoteManagementMessage MIDI 2.0 message into the raw bytes of its Universal MIDI Packet.
+func (pnmm PerNoteManagementMessage) MarshalMIDI() ([]byte, error) {
+	var flags byte
+	if pnmm.Detach {
+		flags |= perNoteManagementDetachFlag
+	}
+	if pnmm.Reset {
+		flags |= perNoteManagementResetFlag
+	}
+	return marshalPerNoteMessage("per-note management", PerNoteManagementMessageStatus, pnmm.Group, pnmm.Channel, pnmm.Note, flags, 0)
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (pnmm *PerNoteManagementMessage) String() string {
+	return fmt.Sprintf(PerNoteManagementMessageStringFormat, MessageVersion, pnmm.GetMessageName(), pnmm.Group, pnmm.Channel, pnmm.Note, pnmm.Detach, pnmm.Reset)
+}
+
+// UnmarshalMIDI unmarshalls the raw bytes of a Universal MIDI Packet into a PerNoteManagementMessage struct pointer.
+//
+// Example: []byte{0x40, 0xF0, 0x3C, 0x03, 0x00, 0x00, 0x00, 0x00}
+//
+// The example forms a Per-Note Management message for group 1 (index 0), channel 1 (index 0), note number 60 that
+// both detaches and resets the per-note controllers.
+func (pnmm *PerNoteManagementMessage) UnmarshalMIDI(b []byte) error {
+	cvf, note, err := unmarshalNoteMessage("per-note management", PerNoteManagementMessageStatus, b)
+	if err != nil {
+		return err
+	}
+	*pnmm = PerNoteManagementMessage{
+		Group:   cvf.group,
+		Channel: cvf.channel,
+		Note:    note,
+		Detach:  cvf.index2&perNoteManagementDetachFlag != 0,
+		Reset:   cvf.index2&perNoteManagementResetFlag != 0,
+	}
+	return nil
+}
+
+// marshalNoteMessage returns the packet of a MIDI 2.0 Note-On or Note-Off message. The name is used to describe the
+// message in any returned errors.
+func marshalNoteMessage(name string, status byte, group Group, channel midiv1.Channel, note midiv1.Note, velocity uint16, attributeType AttributeType, attribute uint16) ([]byte, error) {
+	return marshalPerNoteMessage(name, status, group, channel, note, byte(attributeType), uint32(velocity)<<16|uint32(attribute))
+}
+
+// marshalPerNoteMessage returns the packet of a MIDI 2.0 Channel Voice message that addresses a single note. The name
+// is used to describe the message in any returned errors.
+func marshalPerNoteMessage(name string, status byte, group Group, channel midiv1.Channel, note midiv1.Note, index byte, data uint32) ([]byte, error) {
+	if err := checkSevenBit(name, "note", int(note)); err != nil {
+		return nil, err
+	}
+	return channelVoiceFields{
+		group:   group,
+		status:  status,
+		channel: channel,
+		index1:  byte(note),
+		index2:  index,
+		data:    data,
+	}.marshal(name)
+}
+
+// unmarshalNoteMessage returns the fields and the note of a MIDI 2.0 Channel Voice message packet that addresses a
+// single note. The name is used to describe the message in any returned errors.
+func unmarshalNoteMessage(name string, status byte, b []byte) (channelVoiceFields, midiv1.Note, error) {
+	cvf, err := unmarshalChannelVoice(name, status, b)
+	if err != nil {
+		return channelVoiceFields{}, midiv1.MinNote, err
+	}
+	note, err := parseSevenBit(name, "note", cvf.index1)
+	if err != nil {
+		return channelVoiceFields{}, midiv1.MinNote, err
+	}
+	return cvf, midiv1.Note(note), nil
+}
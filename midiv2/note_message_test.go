@@ -0,0 +1,189 @@
+package midiv2
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func Test_NoteOnMessage_GetMessageName(t *testing.T) {
+	t.Parallel()
+	message := NoteOnMessage{}
+	expected := "Note-On"
+	if message.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.GetMessageName())
+	}
+}
+
+func Test_NoteMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  MessageMarshaler
+		expected []byte
+		err      error
+	}{
+		"note-on message with an invalid note returns error": {
+			message: NoteOnMessage{Note: 128},
+			err:     ErrMarshallingMessage,
+		},
+		"note-on message with an invalid channel returns error": {
+			message: NoteOnMessage{Channel: 16, Note: 60},
+			err:     ErrMarshallingMessage,
+		},
+		"note-on message with an invalid group returns error": {
+			message: NoteOnMessage{Group: 16, Note: 60},
+			err:     ErrMarshallingMessage,
+		},
+		"note-on message marshalls into expected bytes": {
+			message: NoteOnMessage{
+				Channel:       1,
+				Note:          60,
+				Velocity:      0xC000,
+				AttributeType: PitchAttributeType,
+				Attribute:     0x7880,
+			},
+			expected: []byte{0x40, 0x91, 0x3C, 0x03, 0xC0, 0x00, 0x78, 0x80},
+		},
+		"note-off message marshalls into expected bytes": {
+			message:  NoteOffMessage{Group: 2, Note: 60, Velocity: 0x8000},
+			expected: []byte{0x42, 0x80, 0x3C, 0x00, 0x80, 0x00, 0x00, 0x00},
+		},
+		"poly pressure message marshalls into expected bytes": {
+			message:  PolyPressureMessage{Group: 1, Note: 60, Pressure: 0x80000000},
+			expected: []byte{0x41, 0xA0, 0x3C, 0x00, 0x80, 0x00, 0x00, 0x00},
+		},
+		"registered per-note controller message marshalls into expected bytes": {
+			message:  RegisteredPerNoteControllerMessage{Note: 60, Index: 3, Value: 0x1000},
+			expected: []byte{0x40, 0x00, 0x3C, 0x03, 0x00, 0x00, 0x10, 0x00},
+		},
+		"assignable per-note controller message marshalls into expected bytes": {
+			message:  AssignablePerNoteControllerMessage{Note: 60, Index: 16, Value: 0xFFFFFFFF},
+			expected: []byte{0x40, 0x10, 0x3C, 0x10, 0xFF, 0xFF, 0xFF, 0xFF},
+		},
+		"per-note pitch bend message marshalls into expected bytes": {
+			message:  PerNotePitchBendMessage{Note: 60, Value: CenterPitchBend},
+			expected: []byte{0x40, 0x60, 0x3C, 0x00, 0x80, 0x00, 0x00, 0x00},
+		},
+		"per-note management message marshalls into expected bytes": {
+			message:  PerNoteManagementMessage{Note: 60, Detach: true, Reset: true},
+			expected: []byte{0x40, 0xF0, 0x3C, 0x03, 0x00, 0x00, 0x00, 0x00},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_NoteOnMessage_String(t *testing.T) {
+	t.Parallel()
+	message := NoteOnMessage{
+		Group:    1,
+		Channel:  2,
+		Note:     60,
+		Velocity: 0x8000,
+	}
+	expected := fmt.Sprintf("%s:%s:%d:%d:%d:%d:%d:%d", MessageVersion, "Note-On", 1, 2, 60, 0x8000, 0, 0)
+	if message.String() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.String())
+	}
+}
+
+func Test_NoteOnMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage NoteOnMessage
+		err             error
+	}{
+		"byte slice is not proper length": {
+			b:   []byte{0x40, 0x91, 0x3C, 0x03},
+			err: ErrUnmarshallingMessage,
+		},
+		"packet is not a midi 2.0 channel voice message": {
+			b:   []byte{0x30, 0x91, 0x3C, 0x03, 0xC0, 0x00, 0x78, 0x80},
+			err: ErrUnmarshallingMessage,
+		},
+		"packet is a note-off message": {
+			b:   []byte{0x40, 0x81, 0x3C, 0x03, 0xC0, 0x00, 0x78, 0x80},
+			err: ErrUnmarshallingMessage,
+		},
+		"note is not 7-bit": {
+			b:   []byte{0x40, 0x91, 0xBC, 0x03, 0xC0, 0x00, 0x78, 0x80},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b: []byte{0x40, 0x91, 0x3C, 0x03, 0xC0, 0x00, 0x78, 0x80},
+			expectedMessage: NoteOnMessage{
+				Channel:       1,
+				Note:          60,
+				Velocity:      0xC000,
+				AttributeType: PitchAttributeType,
+				Attribute:     0x7880,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got NoteOnMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil {
+				if err == nil {
+					t.Fatalf("expected non-nil %v error, got nil error", test.err)
+				}
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
+
+func Test_PerNoteManagementMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage PerNoteManagementMessage
+	}{
+		"detach flag unmarshals": {
+			b:               []byte{0x40, 0xF0, 0x3C, 0x02, 0x00, 0x00, 0x00, 0x00},
+			expectedMessage: PerNoteManagementMessage{Note: 60, Detach: true},
+		},
+		"reset flag unmarshals": {
+			b:               []byte{0x40, 0xF0, 0x3C, 0x01, 0x00, 0x00, 0x00, 0x00},
+			expectedMessage: PerNoteManagementMessage{Note: 60, Reset: true},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got PerNoteManagementMessage
+			if err := (&got).UnmarshalMIDI(test.b); err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
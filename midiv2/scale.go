@@ -0,0 +1,44 @@
+package midiv2
+
+// ScaleUp returns the value scaled from the supplied number of source bits up to the number of destination bits with
+// the Min-Center-Max algorithm of the MIDI 2.0 specification. The lowest, center and highest source values map onto the
+// lowest, center and highest destination values, and values above the center fill the new low bits by repeating their
+// own low bits, so scaling back down with ScaleDown returns the original value.
+//
+// Example: ScaleUp(64, 7, 32) returns 0x80000000 and ScaleUp(127, 7, 32) returns 0xFFFFFFFF.
+func ScaleUp(value uint32, sourceBits uint, destinationBits uint) uint32 {
+	if sourceBits == 0 || destinationBits <= sourceBits {
+		return value
+	}
+	scaleBits := destinationBits - sourceBits
+	shifted := value << scaleBits
+	center := uint32(1) << (sourceBits - 1)
+	if value <= center {
+		return shifted
+	}
+
+	// repeat the bits below the source MSB through the new low bits
+	repeatBits := sourceBits - 1
+	repeatValue := value & (1<<repeatBits - 1)
+	if scaleBits > repeatBits {
+		repeatValue <<= scaleBits - repeatBits
+	} else {
+		repeatValue >>= repeatBits - scaleBits
+	}
+	for repeatValue != 0 {
+		shifted |= repeatValue
+		repeatValue >>= repeatBits
+	}
+	return shifted
+}
+
+// ScaleDown returns the value scaled from the supplied number of source bits down to the number of destination bits by
+// dropping its low bits, as the MIDI 2.0 specification recommends.
+//
+// Example: ScaleDown(0x80000000, 32, 7) returns 64.
+func ScaleDown(value uint32, sourceBits uint, destinationBits uint) uint32 {
+	if destinationBits >= sourceBits {
+		return value
+	}
+	return value >> (sourceBits - destinationBits)
+}
@@ -0,0 +1,87 @@
+package midiv2
+
+import "testing"
+
+func Test_ScaleUp(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		value           uint32
+		sourceBits      uint
+		destinationBits uint
+		expected        uint32
+	}{
+		"lowest 7-bit value scales to the lowest 32-bit value": {
+			value:           0,
+			sourceBits:      7,
+			destinationBits: 32,
+			expected:        0,
+		},
+		"center 7-bit value scales to the center 32-bit value": {
+			value:           64,
+			sourceBits:      7,
+			destinationBits: 32,
+			expected:        0x80000000,
+		},
+		"highest 7-bit value scales to the highest 32-bit value": {
+			value:           127,
+			sourceBits:      7,
+			destinationBits: 32,
+			expected:        0xFFFFFFFF,
+		},
+		"highest 7-bit value scales to the highest 16-bit value": {
+			value:           127,
+			sourceBits:      7,
+			destinationBits: 16,
+			expected:        0xFFFF,
+		},
+		"7-bit value above the center repeats its low bits": {
+			value:           100,
+			sourceBits:      7,
+			destinationBits: 16,
+			expected:        0xC924,
+		},
+		"center 14-bit value scales to the center 32-bit value": {
+			value:           0x2000,
+			sourceBits:      14,
+			destinationBits: 32,
+			expected:        0x80000000,
+		},
+		"highest 14-bit value scales to the highest 32-bit value": {
+			value:           0x3FFF,
+			sourceBits:      14,
+			destinationBits: 32,
+			expected:        0xFFFFFFFF,
+		},
+		"same number of bits returns the value": {
+			value:           100,
+			sourceBits:      7,
+			destinationBits: 7,
+			expected:        100,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := ScaleUp(test.value, test.sourceBits, test.destinationBits); got != test.expected {
+				t.Fatalf("expected %#x, got %#x", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_ScaleDown(t *testing.T) {
+	t.Parallel()
+	for value := uint32(0); value <= 127; value++ {
+		if got := ScaleDown(ScaleUp(value, 7, 32), 32, 7); got != value {
+			t.Fatalf("expected %d to scale up and back down to itself, got %d", value, got)
+		}
+		if got := ScaleDown(ScaleUp(value, 7, 16), 16, 7); got != value {
+			t.Fatalf("expected %d to scale up to 16 bits and back down to itself, got %d", value, got)
+		}
+	}
+	for value := uint32(0); value <= 0x3FFF; value++ {
+		if got := ScaleDown(ScaleUp(value, 14, 32), 32, 14); got != value {
+			t.Fatalf("expected %d to scale up and back down to itself, got %d", value, got)
+		}
+	}
+}
@@ -0,0 +1,398 @@
+package midiv2
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+var (
+	// ErrTranslatingMessage represents an error translating a message between MIDI 1.0 and MIDI 2.0.
+	ErrTranslatingMessage error = errors.New("error translating MIDI message")
+)
+
+const (
+	// NoteOnZeroVelocityNoteOffVelocity represents the 16-bit velocity of the Note-Off message that a MIDI 1.0 Note-On
+	// message with a velocity of 0 is translated into, the default MIDI 1.0 release velocity of 64 scaled up.
+	NoteOnZeroVelocityNoteOffVelocity uint16 = 0x8000
+
+	// RelativeParameterStep represents the signed 32-bit change of a Relative Registered or Assignable Controller message
+	// that a MIDI 1.0 Data Increment or Data Decrement step is translated into: a single step of a 14-bit value.
+	RelativeParameterStep int32 = 1 << 18
+)
+
+// Translator translates messages between the MIDI 1.0 byte stream of the midiv1 package and MIDI 2.0 Protocol
+// Universal MIDI Packets.
+//
+// Upgrading scales values up with ScaleUp, turns a Note-On with a velocity of 0 into a Note-Off, folds the Control
+// Change messages of Registered and Non-Registered Parameter Numbers into single Registered, Assignable and Relative
+// Controller messages, and splits System Exclusive messages into System Exclusive (7-bit) packets. As with the
+// midiv1.ParameterParser, a Data Entry MSB (6) is translated straight away with an LSB of 0 and a Data Entry LSB (38)
+// that follows it is translated again with the full value.
+//
+// Downgrading scales values down with ScaleDown and turns the controller messages back into Registered and
+// Non-Registered Parameter Number messages. Note attributes are dropped, a Note-On velocity that scales down to 0 is
+// sent as 1 so it does not turn the note off, and a Program Change with a valid bank is preceded by the Bank Select
+// Control Change messages. Messages without a MIDI 1.0 equivalent, such as per-note controllers and Utility messages,
+// translate into no messages.
+//
+// A Translator keeps track of parameter selections and unfinished System Exclusive messages, so it is not safe for
+// concurrent use.
+type Translator struct {
+	// group is the group of the upgraded messages
+	group Group
+
+	// perNotePitchBend represents whether a Per-Note Pitch Bend is downgraded into a Pitch Bend Change of its channel
+	perNotePitchBend bool
+
+	// parameters folds the Control Change messages of parameter numbers while upgrading
+	parameters *midiv1.ParameterParser
+
+	// systemExclusive holds the data of the System Exclusive message being downgraded in each group, or nil when no
+	// message has started
+	systemExclusive [MaxGroup + 1][]byte
+}
+
+// TranslatorOption configures a Translator.
+type TranslatorOption func(*Translator)
+
+// WithGroup sets the group of the messages upgraded by the translator. The default is group 1 (index 0).
+func WithGroup(group Group) TranslatorOption {
+	return func(t *Translator) {
+		t.group = group
+	}
+}
+
+// WithPerNotePitchBendAsPitchBend downgrades a Per-Note Pitch Bend message into a Pitch Bend Change message for its
+// whole channel, which suits senders that play a single note per channel, such as MPE controllers. By default Per-Note
+// Pitch Bend messages are dropped.
+func WithPerNotePitchBendAsPitchBend() TranslatorOption {
+	return func(t *Translator) {
+		t.perNotePitchBend = true
+	}
+}
+
+// NewTranslator returns a new Translator configured by any supplied options.
+func NewTranslator(options ...TranslatorOption) *Translator {
+	t := &Translator{
+		parameters: midiv1.NewParameterParser(),
+	}
+	for _, option := range options {
+		option(t)
+	}
+	return t
+}
+
+// Upgrade translates a MIDI 1.0 message into the MIDI 2.0 messages that carry it, which may be none while a parameter
+// number is still being selected.
+func (t *Translator) Upgrade(message midiv1.Message) ([]Message, error) {
+	if err := checkGroup("translated", t.group); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, ErrTranslatingMessage)
+	}
+
+	switch m := message.(type) {
+	case *midiv1.NoteOnMessage:
+		velocity, err := upgradeSevenBit("note-on velocity", int(m.Velocity), 16)
+		if err != nil {
+			return nil, err
+		}
+		if velocity == 0 {
+			return []Message{&NoteOffMessage{Group: t.group, Channel: m.Channel, Note: m.Note, Velocity: NoteOnZeroVelocityNoteOffVelocity}}, nil
+		}
+		return []Message{&NoteOnMessage{Group: t.group, Channel: m.Channel, Note: m.Note, Velocity: uint16(velocity)}}, nil
+	case *midiv1.NoteOffMessage:
+		velocity, err := upgradeSevenBit("note-off velocity", int(m.Velocity), 16)
+		if err != nil {
+			return nil, err
+		}
+		return []Message{&NoteOffMessage{Group: t.group, Channel: m.Channel, Note: m.Note, Velocity: uint16(velocity)}}, nil
+	case *midiv1.PolyphonicKeyPressureMessage:
+		pressure, err := upgradeSevenBit("polyphonic key pressure", int(m.Pressure), 32)
+		if err != nil {
+			return nil, err
+		}
+		return []Message{&PolyPressureMessage{Group: t.group, Channel: m.Channel, Note: m.Note, Pressure: pressure}}, nil
+	case *midiv1.ChannelPressureMessage:
+		pressure, err := upgradeSevenBit("channel pressure", int(m.Pressure), 32)
+		if err != nil {
+			return nil, err
+		}
+		return []Message{&ChannelPressureMessage{Group: t.group, Channel: m.Channel, Pressure: pressure}}, nil
+	case *midiv1.ProgramChangeMessage:
+		if m.Program < midiv1.MinProgram || m.Program > midiv1.MaxProgram {
+			return nil, fmt.Errorf("program must be between %d and %d, inclusive, received %d: %w", midiv1.MinProgram, midiv1.MaxProgram, m.Program, ErrTranslatingMessage)
+		}
+		return []Message{&ProgramChangeMessage{Group: t.group, Channel: m.Channel, Program: byte(m.Program)}}, nil
+	case *midiv1.PitchBendChangeMessage:
+		return []Message{&PitchBendMessage{Group: t.group, Channel: m.Channel, Value: ScaleUp(uint32(m.PitchBend.FourteenBitValue()), 14, 32)}}, nil
+	case *midiv1.ControlChangeMessage:
+		return t.upgradeControlChange(m)
+	case *midiv1.HighResolutionControlChangeMessage:
+		// both bytes of the pair fit in the 32-bit value of the MSB controller
+		return []Message{&ControlChangeMessage{Group: t.group, Channel: m.Channel, Index: m.Controller, Value: ScaleUp(uint32(m.Value), 14, 32)}}, nil
+	case *midiv1.RegisteredParameterNumberMessage:
+		return []Message{t.upgradeParameterChange(m.Channel, true, midiv1.FourteenBitValue(m.Parameter), m.Step, m.Value)}, nil
+	case *midiv1.NonRegisteredParameterNumberMessage:
+		return []Message{t.upgradeParameterChange(m.Channel, false, m.Parameter, m.Step, m.Value)}, nil
+	}
+
+	// channel mode, system and system exclusive messages are recognised by their bytes
+	marshaler, ok := message.(midiv1.MessageMarshaler)
+	if !ok {
+		return nil, fmt.Errorf("cannot upgrade MIDI 1.0 message %T: %w", message, ErrTranslatingMessage)
+	}
+	b, err := marshaler.MarshalMIDI()
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal MIDI 1.0 message %T (%v): %w", message, err, ErrTranslatingMessage)
+	}
+	switch {
+	case len(b) == 0:
+	case b[0] == midiv1.SystemExclusiveMessageStatus:
+		if len(b) < 2 || b[len(b)-1] != midiv1.EndOfExclusiveStatus {
+			break
+		}
+		var messages []Message
+		for _, packet := range NewSystemExclusive7Messages(t.group, b[1:len(b)-1]) {
+			packet := packet
+			messages = append(messages, &packet)
+		}
+		return messages, nil
+	case len(b) == midiv1.ControlChangeMessageLength && midiv1.ParseStatusFromStatusByte(b[0]) == midiv1.ControlChangeMessageStatusNibble:
+		// channel mode messages are Control Change messages for controllers 120 through 127
+		return t.upgradeControlChange(&midiv1.ControlChangeMessage{
+			Channel:    midiv1.Channel(b[0] & byte(midiv1.MaxChannel)),
+			Controller: midiv1.Controller(b[1]),
+			Value:      midiv1.ControllerValue(b[2]),
+		})
+	case isMIDI1StatusForType(SystemMessageType, b[0]):
+		return []Message{&SystemMessage{Group: t.group, Message: message}}, nil
+	}
+	return nil, fmt.Errorf("cannot upgrade MIDI 1.0 message %T: %w", message, ErrTranslatingMessage)
+}
+
+// Downgrade translates a MIDI 2.0 message into the MIDI 1.0 messages that carry it, which may be none when the message
+// has no MIDI 1.0 equivalent or is part of an unfinished System Exclusive message. MIDI 1.0 has no groups, so the
+// group of the message is dropped.
+func (t *Translator) Downgrade(message Message) ([]midiv1.Message, error) {
+	switch m := message.(type) {
+	case *MIDI1ChannelVoiceMessage:
+		return []midiv1.Message{m.Message}, nil
+	case *SystemMessage:
+		return []midiv1.Message{m.Message}, nil
+	case *NoteOnMessage:
+		velocity := ScaleDown(uint32(m.Velocity), 16, 7)
+		if velocity == 0 {
+			// a MIDI 2.0 Note-On always sounds, but a MIDI 1.0 Note-On with a velocity of 0 is a Note-Off
+			velocity = 1
+		}
+		return []midiv1.Message{&midiv1.NoteOnMessage{Channel: m.Channel, Note: m.Note, Velocity: midiv1.Velocity(velocity)}}, nil
+	case *NoteOffMessage:
+		return []midiv1.Message{&midiv1.NoteOffMessage{Channel: m.Channel, Note: m.Note, Velocity: midiv1.Velocity(ScaleDown(uint32(m.Velocity), 16, 7))}}, nil
+	case *PolyPressureMessage:
+		return []midiv1.Message{&midiv1.PolyphonicKeyPressureMessage{Channel: m.Channel, Note: m.Note, Pressure: midiv1.Pressure(ScaleDown(m.Pressure, 32, 7))}}, nil
+	case *ChannelPressureMessage:
+		return []midiv1.Message{&midiv1.ChannelPressureMessage{Channel: m.Channel, Pressure: midiv1.Pressure(ScaleDown(m.Pressure, 32, 7))}}, nil
+	case *PitchBendMessage:
+		return []midiv1.Message{downgradePitchBend(m.Channel, m.Value)}, nil
+	case *PerNotePitchBendMessage:
+		if !t.perNotePitchBend {
+			return nil, nil
+		}
+		return []midiv1.Message{downgradePitchBend(m.Channel, m.Value)}, nil
+	case *ControlChangeMessage:
+		ccm, err := downgradeControlChange(m.Channel, m.Index, byte(ScaleDown(m.Value, 32, 7)))
+		if err != nil {
+			return nil, err
+		}
+		return []midiv1.Message{ccm}, nil
+	case *ProgramChangeMessage:
+		return downgradeProgramChange(m)
+	case *RegisteredControllerMessage:
+		return []midiv1.Message{&midiv1.RegisteredParameterNumberMessage{
+			Channel:   m.Channel,
+			Parameter: midiv1.RegisteredParameter(midiv1.NewFourteenBitValueFromBytes(m.Bank, m.Index)),
+			Value:     midiv1.FourteenBitValue(ScaleDown(m.Value, 32, 14)),
+		}}, nil
+	case *AssignableControllerMessage:
+		return []midiv1.Message{&midiv1.NonRegisteredParameterNumberMessage{
+			Channel:   m.Channel,
+			Parameter: midiv1.NewFourteenBitValueFromBytes(m.Bank, m.Index),
+			Value:     midiv1.FourteenBitValue(ScaleDown(m.Value, 32, 14)),
+		}}, nil
+	case *RelativeRegisteredControllerMessage:
+		step, ok := downgradeRelativeValue(m.Value)
+		if !ok {
+			return nil, nil
+		}
+		return []midiv1.Message{&midiv1.RegisteredParameterNumberMessage{
+			Channel:   m.Channel,
+			Parameter: midiv1.RegisteredParameter(midiv1.NewFourteenBitValueFromBytes(m.Bank, m.Index)),
+			Step:      step,
+		}}, nil
+	case *RelativeAssignableControllerMessage:
+		step, ok := downgradeRelativeValue(m.Value)
+		if !ok {
+			return nil, nil
+		}
+		return []midiv1.Message{&midiv1.NonRegisteredParameterNumberMessage{
+			Channel:   m.Channel,
+			Parameter: midiv1.NewFourteenBitValueFromBytes(m.Bank, m.Index),
+			Step:      step,
+		}}, nil
+	case *SystemExclusive7Message:
+		return t.downgradeSystemExclusive(m)
+	case *NoOpMessage, *JRClockMessage, *JRTimestampMessage, *SystemExclusive8Message,
+		*RegisteredPerNoteControllerMessage, *AssignablePerNoteControllerMessage, *PerNoteManagementMessage:
+		// these messages have no MIDI 1.0 equivalent
+		return nil, nil
+	}
+	return nil, fmt.Errorf("cannot downgrade MIDI 2.0 message %T: %w", message, ErrTranslatingMessage)
+}
+
+// upgradeControlChange translates a MIDI 1.0 Control Change message, folding the controllers of parameter numbers.
+func (t *Translator) upgradeControlChange(ccm *midiv1.ControlChangeMessage) ([]Message, error) {
+	value, err := upgradeSevenBit("control change value", int(ccm.Value), 32)
+	if err != nil {
+		return nil, err
+	}
+	if ccm.Controller < midiv1.MinController || ccm.Controller > midiv1.MaxController {
+		return nil, fmt.Errorf("controller must be between %d and %d, inclusive, received %d: %w", midiv1.MinController, midiv1.MaxController, ccm.Controller, ErrTranslatingMessage)
+	}
+
+	change, ok := t.parameters.Parse(ccm)
+	if ok {
+		return []Message{t.upgradeParameterChange(change.Channel, change.Registered, change.Parameter, change.Step, change.Value)}, nil
+	}
+	if isParameterNumberController(ccm.Controller) {
+		return nil, nil
+	}
+	return []Message{&ControlChangeMessage{Group: t.group, Channel: ccm.Channel, Index: ccm.Controller, Value: value}}, nil
+}
+
+// upgradeParameterChange returns the MIDI 2.0 controller message of a change to a parameter number.
+func (t *Translator) upgradeParameterChange(channel midiv1.Channel, registered bool, parameter midiv1.FourteenBitValue, step midiv1.ParameterStep, value midiv1.FourteenBitValue) Message {
+	bank, index := parameter.GetMSB(), parameter.GetLSB()
+	if step == midiv1.SetParameterStep {
+		if registered {
+			return &RegisteredControllerMessage{Group: t.group, Channel: channel, Bank: bank, Index: index, Value: ScaleUp(uint32(value), 14, 32)}
+		}
+		return &AssignableControllerMessage{Group: t.group, Channel: channel, Bank: bank, Index: index, Value: ScaleUp(uint32(value), 14, 32)}
+	}
+
+	change := RelativeParameterStep
+	if step == midiv1.DecrementParameterStep {
+		change = -change
+	}
+	if registered {
+		return &RelativeRegisteredControllerMessage{Group: t.group, Channel: channel, Bank: bank, Index: index, Value: change}
+	}
+	return &RelativeAssignableControllerMessage{Group: t.group, Channel: channel, Bank: bank, Index: index, Value: change}
+}
+
+// downgradeSystemExclusive collects the data of a System Exclusive (7-bit) packet and returns the MIDI 1.0 System
+// Exclusive message once its last packet arrives.
+func (t *Translator) downgradeSystemExclusive(se7m *SystemExclusive7Message) ([]midiv1.Message, error) {
+	if se7m.Group > MaxGroup {
+		return nil, fmt.Errorf("system exclusive 7 packets must have a group between %d and %d, inclusive, received %d: %w", MinGroup, MaxGroup, se7m.Group, ErrTranslatingMessage)
+	}
+	pending := t.systemExclusive[se7m.Group]
+	switch se7m.Status {
+	case CompleteSystemExclusiveStatus, StartSystemExclusiveStatus:
+		if pending != nil {
+			t.systemExclusive[se7m.Group] = nil
+			return nil, fmt.Errorf("system exclusive 7 packet in group %d started a new message before the last one ended: %w", se7m.Group, ErrTranslatingMessage)
+		}
+		if se7m.Status == StartSystemExclusiveStatus {
+			t.systemExclusive[se7m.Group] = append([]byte{midiv1.SystemExclusiveMessageStatus}, se7m.Data...)
+			return nil, nil
+		}
+		pending = []byte{midiv1.SystemExclusiveMessageStatus}
+	case ContinueSystemExclusiveStatus, EndSystemExclusiveStatus:
+		if pending == nil {
+			return nil, fmt.Errorf("system exclusive 7 packet in group %d continued a message that has not started: %w", se7m.Group, ErrTranslatingMessage)
+		}
+		if se7m.Status == ContinueSystemExclusiveStatus {
+			t.systemExclusive[se7m.Group] = append(pending, se7m.Data...)
+			return nil, nil
+		}
+		t.systemExclusive[se7m.Group] = nil
+	default:
+		return nil, fmt.Errorf("system exclusive 7 packets must have a status between %d and %d, inclusive, received %d: %w", CompleteSystemExclusiveStatus, EndSystemExclusiveStatus, se7m.Status, ErrTranslatingMessage)
+	}
+
+	b := append(append(pending, se7m.Data...), midiv1.EndOfExclusiveStatus)
+	message, err := midiv1.Unmarshal(b)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal the MIDI 1.0 system exclusive message (%v): %w", err, ErrTranslatingMessage)
+	}
+	return []midiv1.Message{message}, nil
+}
+
+// upgradeSevenBit returns a 7-bit MIDI 1.0 value scaled up to the number of destination bits, or an error if the value
+// is out of range. The name is used to describe the value in any returned errors.
+func upgradeSevenBit(name string, value int, destinationBits uint) (uint32, error) {
+	if value < 0 || value > int(maxSevenBitValue) {
+		return 0, fmt.Errorf("%s must be between 0 and %d, inclusive, received %d: %w", name, maxSevenBitValue, value, ErrTranslatingMessage)
+	}
+	return ScaleUp(uint32(value), 7, destinationBits), nil
+}
+
+// isParameterNumberController returns whether the controller selects or enters data for a parameter number, and so is
+// folded into the MIDI 2.0 controller messages rather than translated on its own.
+func isParameterNumberController(controller midiv1.Controller) bool {
+	switch controller {
+	case midiv1.DataEntryMSBController, midiv1.DataEntryLSBController,
+		midiv1.DataIncrementController, midiv1.DataDecrementController,
+		midiv1.NonRegisteredParameterNumberLSBController, midiv1.NonRegisteredParameterNumberMSBController,
+		midiv1.RegisteredParameterNumberLSBController, midiv1.RegisteredParameterNumberMSBController:
+		return true
+	}
+	return false
+}
+
+// downgradePitchBend returns the MIDI 1.0 Pitch Bend Change message of a 32-bit pitch bend value.
+func downgradePitchBend(channel midiv1.Channel, value uint32) midiv1.Message {
+	return &midiv1.PitchBendChangeMessage{
+		Channel:   channel,
+		PitchBend: midiv1.NewPitchBendFromFourteenBitValue(midiv1.FourteenBitValue(ScaleDown(value, 32, 14))),
+	}
+}
+
+// downgradeControlChange returns the MIDI 1.0 message of a 7-bit controller value. Controllers 120 through 127 are
+// returned as their channel mode messages where the value allows it.
+func downgradeControlChange(channel midiv1.Channel, controller midiv1.Controller, value byte) (midiv1.Message, error) {
+	if controller < midiv1.MinController || controller > midiv1.MaxController {
+		return nil, fmt.Errorf("controller must be between %d and %d, inclusive, received %d: %w", midiv1.MinController, midiv1.MaxController, controller, ErrTranslatingMessage)
+	}
+	b := []byte{midiv1.MakeStatusByte(midiv1.ControlChangeMessageStatusNibble, channel), byte(controller), value}
+	if message, err := midiv1.Unmarshal(b); err == nil {
+		return message, nil
+	}
+	return &midiv1.ControlChangeMessage{Channel: channel, Controller: controller, Value: midiv1.ControllerValue(value)}, nil
+}
+
+// downgradeProgramChange returns the MIDI 1.0 messages of a Program Change message, led by the Bank Select Control
+// Change messages when its bank is valid.
+func downgradeProgramChange(pcm *ProgramChangeMessage) ([]midiv1.Message, error) {
+	var messages []midiv1.Message
+	if pcm.BankValid {
+		messages = append(messages,
+			&midiv1.ControlChangeMessage{Channel: pcm.Channel, Controller: midiv1.BankSelectMSBController, Value: midiv1.ControllerValue(pcm.BankMSB)},
+			&midiv1.ControlChangeMessage{Channel: pcm.Channel, Controller: midiv1.BankSelectLSBController, Value: midiv1.ControllerValue(pcm.BankLSB)},
+		)
+	}
+	return append(messages, &midiv1.ProgramChangeMessage{Channel: pcm.Channel, Program: midiv1.Program(pcm.Program)}), nil
+}
+
+// downgradeRelativeValue returns the Data Increment or Data Decrement step of a signed controller change, or false when
+// the change is zero.
+func downgradeRelativeValue(value int32) (midiv1.ParameterStep, bool) {
+	switch {
+	case value > 0:
+		return midiv1.IncrementParameterStep, true
+	case value < 0:
+		return midiv1.DecrementParameterStep, true
+	}
+	return midiv1.SetParameterStep, false
+}
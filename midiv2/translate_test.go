@@ -0,0 +1,272 @@
+package midiv2
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+func Test_Translator_Upgrade(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		options  []TranslatorOption
+		messages []midiv1.Message
+		expected []Message
+		err      error
+	}{
+		"note-on velocity scales up": {
+			options:  []TranslatorOption{WithGroup(2)},
+			messages: []midiv1.Message{&midiv1.NoteOnMessage{Channel: 1, Note: 60, Velocity: 64}},
+			expected: []Message{&NoteOnMessage{Group: 2, Channel: 1, Note: 60, Velocity: 0x8000}},
+		},
+		"note-on with a velocity of 0 becomes a note-off": {
+			messages: []midiv1.Message{&midiv1.NoteOnMessage{Channel: 1, Note: 60}},
+			expected: []Message{&NoteOffMessage{Channel: 1, Note: 60, Velocity: NoteOnZeroVelocityNoteOffVelocity}},
+		},
+		"note-on with an invalid velocity returns error": {
+			messages: []midiv1.Message{&midiv1.NoteOnMessage{Channel: 1, Note: 60, Velocity: 128}},
+			err:      ErrTranslatingMessage,
+		},
+		"pressure scales up": {
+			messages: []midiv1.Message{
+				&midiv1.PolyphonicKeyPressureMessage{Note: 60, Pressure: 127},
+				&midiv1.ChannelPressureMessage{Pressure: 64},
+			},
+			expected: []Message{
+				&PolyPressureMessage{Note: 60, Pressure: 0xFFFFFFFF},
+				&ChannelPressureMessage{Pressure: 0x80000000},
+			},
+		},
+		"pitch bend scales up": {
+			messages: []midiv1.Message{&midiv1.PitchBendChangeMessage{Channel: 3}},
+			expected: []Message{&PitchBendMessage{Channel: 3, Value: CenterPitchBend}},
+		},
+		"control change scales up": {
+			messages: []midiv1.Message{&midiv1.ControlChangeMessage{Controller: midiv1.ChannelVolumeMSBController, Value: 127}},
+			expected: []Message{&ControlChangeMessage{Index: midiv1.ChannelVolumeMSBController, Value: 0xFFFFFFFF}},
+		},
+		"channel mode message becomes a control change": {
+			messages: []midiv1.Message{&midiv1.AllSoundOffMessage{Channel: 4}},
+			expected: []Message{&ControlChangeMessage{Channel: 4, Index: 120}},
+		},
+		"rpn control changes fold into a registered controller": {
+			messages: []midiv1.Message{
+				&midiv1.ControlChangeMessage{Controller: midiv1.RegisteredParameterNumberMSBController, Value: 0},
+				&midiv1.ControlChangeMessage{Controller: midiv1.RegisteredParameterNumberLSBController, Value: 0},
+				&midiv1.ControlChangeMessage{Controller: midiv1.DataEntryMSBController, Value: 12},
+				&midiv1.ControlChangeMessage{Controller: midiv1.DataEntryLSBController, Value: 50},
+			},
+			expected: []Message{
+				&RegisteredControllerMessage{Value: ScaleUp(12<<7, 14, 32)},
+				&RegisteredControllerMessage{Value: ScaleUp(12<<7|50, 14, 32)},
+			},
+		},
+		"nrpn data increment folds into a relative assignable controller": {
+			messages: []midiv1.Message{
+				&midiv1.ControlChangeMessage{Channel: 2, Controller: midiv1.NonRegisteredParameterNumberMSBController, Value: 1},
+				&midiv1.ControlChangeMessage{Channel: 2, Controller: midiv1.NonRegisteredParameterNumberLSBController, Value: 8},
+				&midiv1.ControlChangeMessage{Channel: 2, Controller: midiv1.DataDecrementController},
+			},
+			expected: []Message{
+				&RelativeAssignableControllerMessage{Channel: 2, Bank: 1, Index: 8, Value: -RelativeParameterStep},
+			},
+		},
+		"rpn message becomes a registered controller": {
+			messages: []midiv1.Message{&midiv1.RegisteredParameterNumberMessage{Channel: 1, Parameter: midiv1.FineTuningParameter, Value: 0x3FFF}},
+			expected: []Message{&RegisteredControllerMessage{Channel: 1, Index: 1, Value: 0xFFFFFFFF}},
+		},
+		"program change has no bank": {
+			messages: []midiv1.Message{&midiv1.ProgramChangeMessage{Channel: 1, Program: 5}},
+			expected: []Message{&ProgramChangeMessage{Channel: 1, Program: 5}},
+		},
+		"system message is carried as is": {
+			messages: []midiv1.Message{&midiv1.TimingClockMessage{}},
+			expected: []Message{&SystemMessage{Message: &midiv1.TimingClockMessage{}}},
+		},
+		"system exclusive message is split into packets": {
+			messages: []midiv1.Message{&midiv1.SystemExclusiveMessage{Manufacturer: midiv1.RolandManufacturerID, Data: []byte{1, 2, 3, 4, 5, 6}}},
+			expected: []Message{
+				&SystemExclusive7Message{Status: StartSystemExclusiveStatus, Data: []byte{0x41, 1, 2, 3, 4, 5}},
+				&SystemExclusive7Message{Status: EndSystemExclusiveStatus, Data: []byte{6}},
+			},
+		},
+		"invalid group returns error": {
+			options:  []TranslatorOption{WithGroup(16)},
+			messages: []midiv1.Message{&midiv1.TimingClockMessage{}},
+			err:      ErrTranslatingMessage,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			translator := NewTranslator(test.options...)
+			var got []Message
+			for _, message := range test.messages {
+				translated, err := translator.Upgrade(message)
+				if test.err == nil && err != nil {
+					t.Fatalf("expected nil error, got %v", err)
+				}
+				if test.err != nil {
+					if !errors.Is(err, test.err) {
+						t.Fatalf("expected %v error, got %v", test.err, err)
+					}
+					return
+				}
+				got = append(got, translated...)
+			}
+			if test.err != nil {
+				t.Fatalf("expected non-nil %v error, got nil error", test.err)
+			}
+			if !reflect.DeepEqual(test.expected, got) {
+				t.Fatalf("expected %+v, got %+v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_Translator_Downgrade(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		options  []TranslatorOption
+		messages []Message
+		expected []midiv1.Message
+		err      error
+	}{
+		"note-on velocity scales down and attributes are dropped": {
+			messages: []Message{&NoteOnMessage{Group: 3, Channel: 1, Note: 60, Velocity: 0x8000, AttributeType: PitchAttributeType, Attribute: 0x7880}},
+			expected: []midiv1.Message{&midiv1.NoteOnMessage{Channel: 1, Note: 60, Velocity: 64}},
+		},
+		"note-on velocity that scales down to 0 is sent as 1": {
+			messages: []Message{&NoteOnMessage{Note: 60, Velocity: 0x0100}},
+			expected: []midiv1.Message{&midiv1.NoteOnMessage{Note: 60, Velocity: 1}},
+		},
+		"pitch bend scales down": {
+			messages: []Message{&PitchBendMessage{Channel: 2, Value: 0xFFFFFFFF}},
+			expected: []midiv1.Message{&midiv1.PitchBendChangeMessage{Channel: 2, PitchBend: midiv1.MaxPitchBend}},
+		},
+		"per-note pitch bend is dropped by default": {
+			messages: []Message{&PerNotePitchBendMessage{Note: 60, Value: 0}},
+		},
+		"per-note pitch bend becomes a channel pitch bend when enabled": {
+			options:  []TranslatorOption{WithPerNotePitchBendAsPitchBend()},
+			messages: []Message{&PerNotePitchBendMessage{Channel: 5, Note: 60, Value: CenterPitchBend}},
+			expected: []midiv1.Message{&midiv1.PitchBendChangeMessage{Channel: 5}},
+		},
+		"control change of a channel mode controller becomes a channel mode message": {
+			messages: []Message{&ControlChangeMessage{Channel: 4, Index: 120}},
+			expected: []midiv1.Message{&midiv1.AllSoundOffMessage{Channel: 4}},
+		},
+		"program change with a valid bank is led by bank select": {
+			messages: []Message{&ProgramChangeMessage{Channel: 1, Program: 5, BankValid: true, BankMSB: 1, BankLSB: 2}},
+			expected: []midiv1.Message{
+				&midiv1.ControlChangeMessage{Channel: 1, Controller: midiv1.BankSelectMSBController, Value: 1},
+				&midiv1.ControlChangeMessage{Channel: 1, Controller: midiv1.BankSelectLSBController, Value: 2},
+				&midiv1.ProgramChangeMessage{Channel: 1, Program: 5},
+			},
+		},
+		"registered controller becomes an rpn message": {
+			messages: []Message{&RegisteredControllerMessage{Channel: 1, Index: 1, Value: 0xFFFFFFFF}},
+			expected: []midiv1.Message{&midiv1.RegisteredParameterNumberMessage{Channel: 1, Parameter: midiv1.FineTuningParameter, Value: 0x3FFF}},
+		},
+		"relative assignable controller becomes an nrpn increment": {
+			messages: []Message{&RelativeAssignableControllerMessage{Bank: 1, Index: 8, Value: 100}},
+			expected: []midiv1.Message{&midiv1.NonRegisteredParameterNumberMessage{Parameter: midiv1.NewFourteenBitValueFromBytes(1, 8), Step: midiv1.IncrementParameterStep}},
+		},
+		"messages without a midi 1.0 equivalent are dropped": {
+			messages: []Message{
+				&JRTimestampMessage{SenderClockTimestamp: 1},
+				&RegisteredPerNoteControllerMessage{Note: 60, Index: 1, Value: 2},
+				&PerNoteManagementMessage{Note: 60, Reset: true},
+				&SystemExclusive8Message{Data: []byte{0xFF}},
+			},
+		},
+		"midi 1.0 messages in packets are unwrapped": {
+			messages: []Message{
+				&MIDI1ChannelVoiceMessage{Message: &midiv1.NoteOnMessage{Note: 60, Velocity: 100}},
+				&SystemMessage{Message: &midiv1.TimingClockMessage{}},
+			},
+			expected: []midiv1.Message{
+				&midiv1.NoteOnMessage{Note: 60, Velocity: 100},
+				&midiv1.TimingClockMessage{},
+			},
+		},
+		"system exclusive packets are joined": {
+			messages: []Message{
+				&SystemExclusive7Message{Status: StartSystemExclusiveStatus, Data: []byte{0x41, 1, 2, 3, 4, 5}},
+				&SystemExclusive7Message{Group: 1, Status: CompleteSystemExclusiveStatus, Data: []byte{0x43, 9}},
+				&SystemExclusive7Message{Status: EndSystemExclusiveStatus, Data: []byte{6}},
+			},
+			expected: []midiv1.Message{
+				&midiv1.SystemExclusiveMessage{Manufacturer: midiv1.YamahaManufacturerID, Data: []byte{9}},
+				&midiv1.SystemExclusiveMessage{Manufacturer: midiv1.RolandManufacturerID, Data: []byte{1, 2, 3, 4, 5, 6}},
+			},
+		},
+		"system exclusive packet that continues nothing returns error": {
+			messages: []Message{&SystemExclusive7Message{Status: ContinueSystemExclusiveStatus, Data: []byte{1}}},
+			err:      ErrTranslatingMessage,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			translator := NewTranslator(test.options...)
+			var got []midiv1.Message
+			for _, message := range test.messages {
+				translated, err := translator.Downgrade(message)
+				if test.err == nil && err != nil {
+					t.Fatalf("expected nil error, got %v", err)
+				}
+				if test.err != nil {
+					if !errors.Is(err, test.err) {
+						t.Fatalf("expected %v error, got %v", test.err, err)
+					}
+					return
+				}
+				got = append(got, translated...)
+			}
+			if test.err != nil {
+				t.Fatalf("expected non-nil %v error, got nil error", test.err)
+			}
+			if !reflect.DeepEqual(test.expected, got) {
+				t.Fatalf("expected %+v, got %+v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_Translator_RoundTrip(t *testing.T) {
+	t.Parallel()
+	messages := []midiv1.Message{
+		&midiv1.NoteOnMessage{Channel: 1, Note: 60, Velocity: 100},
+		&midiv1.NoteOffMessage{Channel: 1, Note: 60, Velocity: 37},
+		&midiv1.PolyphonicKeyPressureMessage{Channel: 2, Note: 61, Pressure: 99},
+		&midiv1.ChannelPressureMessage{Channel: 3, Pressure: 1},
+		&midiv1.ControlChangeMessage{Channel: 4, Controller: midiv1.PanMSBController, Value: 65},
+		&midiv1.PitchBendChangeMessage{Channel: 5, PitchBend: -1234},
+		&midiv1.ProgramChangeMessage{Channel: 6, Program: 127},
+		&midiv1.RegisteredParameterNumberMessage{Channel: 7, Parameter: midiv1.PitchBendSensitivityParameter, Value: 0x0C32},
+		&midiv1.NonRegisteredParameterNumberMessage{Channel: 8, Parameter: 0x1234, Value: 0x2ABC},
+		&midiv1.SystemExclusiveMessage{Manufacturer: midiv1.RolandManufacturerID, Data: []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13}},
+	}
+
+	upgrader, downgrader := NewTranslator(), NewTranslator()
+	for _, message := range messages {
+		upgraded, err := upgrader.Upgrade(message)
+		if err != nil {
+			t.Fatalf("expected nil error, got %v", err)
+		}
+		var got []midiv1.Message
+		for _, u := range upgraded {
+			downgraded, err := downgrader.Downgrade(u)
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			got = append(got, downgraded...)
+		}
+		if !reflect.DeepEqual([]midiv1.Message{message}, got) {
+			t.Fatalf("expected %+v, got %+v", message, got)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package midiv2
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+const (
+	// NoOpMessageStatus represents the status nibble of the NOOP Utility message.
+	NoOpMessageStatus byte = 0x0
+
+	// JRClockMessageStatus represents the status nibble of the Jitter Reduction Clock Utility message.
+	JRClockMessageStatus byte = 0x1
+
+	// JRTimestampMessageStatus represents the status nibble of the Jitter Reduction Timestamp Utility message.
+	JRTimestampMessageStatus byte = 0x2
+
+	// JRTicksPerSecond represents the number of Jitter Reduction clock ticks in a second.
+	JRTicksPerSecond int = 31250
+
+	// JRTickDuration represents the real time of a single Jitter Reduction clock tick.
+	JRTickDuration time.Duration = time.Second / time.Duration(JRTicksPerSecond)
+
+	// UtilityMessageStringFormat represents the printf-compatible format specifically for a Utility message string.
+	UtilityMessageStringFormat string = "%s:%s:%d"
+)
+
+// JRTime represents a 16-bit Jitter Reduction time, counted in ticks of 1/31250 of a second. It wraps around roughly
+// every 2.1 seconds.
+type JRTime uint16
+
+// NewJRTimeFromDuration returns the JRTime of the supplied duration since the start of the sender clock, wrapped around
+// to 16 bits.
+func NewJRTimeFromDuration(d time.Duration) JRTime {
+	return JRTime(uint64(d/JRTickDuration) & 0xFFFF)
+}
+
+// Duration returns the real time of the JRTime since the sender clock last wrapped around.
+func (t JRTime) Duration() time.Duration {
+	return time.Duration(t) * JRTickDuration
+}
+
+// NoOpMessage represents a NOOP Utility message, which does nothing and can pad a stream. Utility messages do not
+// belong to a group.
+type NoOpMessage struct{}
+
+// GetMessageName returns the name of this NOOP message.
+func (nm *NoOpMessage) GetMessageName() string {
+	return "NOOP"
+}
+
+// MarshalMIDI marshalls a NoOpMessage MIDI 2.0 message into the raw bytes of its Universal MIDI Packet.
+func (nm NoOpMessage) MarshalMIDI() ([]byte, error) {
+	return marshalUtilityMessage(NoOpMessageStatus, 0), nil
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (nm *NoOpMessage) String() string {
+	return fmt.Sprintf("%s:%s", MessageVersion, nm.GetMessageName())
+}
+
+// UnmarshalMIDI unmarshalls the raw bytes of a Universal MIDI Packet into a NoOpMessage struct pointer.
+//
+// Example: []byte{0x00, 0x00, 0x00, 0x00}
+func (nm *NoOpMessage) UnmarshalMIDI(b []byte) error {
+	if _, err := unmarshalUtilityMessage("noop", NoOpMessageStatus, b); err != nil {
+		return err
+	}
+	*nm = NoOpMessage{}
+	return nil
+}
+
+// JRClockMessage represents a Jitter Reduction Clock Utility message, which a sender transmits regularly so a receiver
+// can follow its clock.
+type JRClockMessage struct {
+	// SenderClockTime represents the time of the sender clock when the message was sent.
+	SenderClockTime JRTime
+}
+
+// GetMessageName returns the name of this JR Clock message.
+func (jcm *JRClockMessage) GetMessageName() string {
+	return "JR Clock"
+}
+
+// MarshalMIDI marshalls a JRClockMessage MIDI 2.0 message into the raw bytes of its Universal MIDI Packet.
+func (jcm JRClockMessage) MarshalMIDI() ([]byte, error) {
+	return marshalUtilityMessage(JRClockMessageStatus, uint16(jcm.SenderClockTime)), nil
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (jcm *JRClockMessage) String() string {
+	return fmt.Sprintf(UtilityMessageStringFormat, MessageVersion, jcm.GetMessageName(), jcm.SenderClockTime)
+}
+
+// UnmarshalMIDI unmarshalls the raw bytes of a Universal MIDI Packet into a JRClockMessage struct pointer.
+//
+// Example: []byte{0x00, 0x10, 0x7A, 0x12}
+//
+// The example forms a JR Clock message with a sender clock time of 31250 ticks (one second).
+func (jcm *JRClockMessage) UnmarshalMIDI(b []byte) error {
+	data, err := unmarshalUtilityMessage("jr clock", JRClockMessageStatus, b)
+	if err != nil {
+		return err
+	}
+	*jcm = JRClockMessage{
+		SenderClockTime: JRTime(data),
+	}
+	return nil
+}
+
+// JRTimestampMessage represents a Jitter Reduction Timestamp Utility message, which carries the time at which the
+// messages that follow it in the same group were sent.
+type JRTimestampMessage struct {
+	// SenderClockTimestamp represents the time of the sender clock at which the following messages were sent.
+	SenderClockTimestamp JRTime
+}
+
+// GetMessageName returns the name of this JR Timestamp message.
+func (jtm *JRTimestampMessage) GetMessageName() string {
+	return "JR Timestamp"
+}
+
+// MarshalMIDI marshalls a JRTimestampMessage MIDI 2.0 message into the raw bytes of its Universal MIDI Packet.
+func (jtm JRTimestampMessage) MarshalMIDI() ([]byte, error) {
+	return marshalUtilityMessage(JRTimestampMessageStatus, uint16(jtm.SenderClockTimestamp)), nil
+}
+
+// String returns the human-readable representation of the MIDI message.
+func (jtm *JRTimestampMessage) String() string {
+	return fmt.Sprintf(UtilityMessageStringFormat, MessageVersion, jtm.GetMessageName(), jtm.SenderClockTimestamp)
+}
+
+// UnmarshalMIDI unmarshalls the raw bytes of a Universal MIDI Packet into a JRTimestampMessage struct pointer.
+//
+// Example: []byte{0x00, 0x20, 0x00, 0x64}
+//
+// The example forms a JR Timestamp message with a sender clock timestamp of 100 ticks.
+func (jtm *JRTimestampMessage) UnmarshalMIDI(b []byte) error {
+	data, err := unmarshalUtilityMessage("jr timestamp", JRTimestampMessageStatus, b)
+	if err != nil {
+		return err
+	}
+	*jtm = JRTimestampMessage{
+		SenderClockTimestamp: JRTime(data),
+	}
+	return nil
+}
+
+// marshalUtilityMessage returns the packet of a Utility message with the supplied status and 16 bits of data.
+func marshalUtilityMessage(status byte, data uint16) []byte {
+	b := []byte{makeTypeAndGroupByte(UtilityMessageType, 0), status << 4, 0, 0}
+	binary.BigEndian.PutUint16(b[2:], data)
+	return b
+}
+
+// unmarshalUtilityMessage returns the 16 bits of data of a Utility message packet with the supplied status. The name is
+// used to describe the message in any returned errors.
+func unmarshalUtilityMessage(name string, status byte, b []byte) (uint16, error) {
+	if err := checkPacket(name, UtilityMessageType, b); err != nil {
+		return 0, err
+	}
+	if b[1]>>4 != status {
+		return 0, fmt.Errorf("%s messages must have status %#x, received %#x: %w", name, status, b[1]>>4, ErrUnmarshallingMessage)
+	}
+	return binary.BigEndian.Uint16(b[2:]), nil
+}
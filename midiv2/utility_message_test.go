@@ -0,0 +1,131 @@
+package midiv2
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_NewJRTimeFromDuration(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		duration time.Duration
+		expected JRTime
+	}{
+		"one second is 31250 ticks": {
+			duration: time.Second,
+			expected: 31250,
+		},
+		"durations wrap around to 16 bits": {
+			duration: 65537 * JRTickDuration,
+			expected: 1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := NewJRTimeFromDuration(test.duration); got != test.expected {
+				t.Fatalf("expected %d, got %d", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_JRTime_Duration(t *testing.T) {
+	t.Parallel()
+	if got := JRTime(31250).Duration(); got != time.Second {
+		t.Fatalf("expected %v, got %v", time.Second, got)
+	}
+}
+
+func Test_UtilityMessage_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  MessageMarshaler
+		expected []byte
+	}{
+		"noop message marshalls into expected bytes": {
+			message:  NoOpMessage{},
+			expected: []byte{0x00, 0x00, 0x00, 0x00},
+		},
+		"jr clock message marshalls into expected bytes": {
+			message:  JRClockMessage{SenderClockTime: 31250},
+			expected: []byte{0x00, 0x10, 0x7A, 0x12},
+		},
+		"jr timestamp message marshalls into expected bytes": {
+			message:  JRTimestampMessage{SenderClockTimestamp: 100},
+			expected: []byte{0x00, 0x20, 0x00, 0x64},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.message.MarshalMIDI()
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_JRClockMessage_String(t *testing.T) {
+	t.Parallel()
+	message := JRClockMessage{SenderClockTime: 100}
+	expected := fmt.Sprintf("%s:%s:%d", MessageVersion, "JR Clock", 100)
+	if message.String() != expected {
+		t.Fatalf("expected %s, got %s", expected, message.String())
+	}
+}
+
+func Test_JRTimestampMessage_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b               []byte
+		expectedMessage JRTimestampMessage
+		err             error
+	}{
+		"byte slice is not proper length": {
+			b:   []byte{0x00, 0x20, 0x00, 0x64, 0x00},
+			err: ErrUnmarshallingMessage,
+		},
+		"packet is not a utility message": {
+			b:   []byte{0x10, 0x20, 0x00, 0x64},
+			err: ErrUnmarshallingMessage,
+		},
+		"packet is a jr clock message": {
+			b:   []byte{0x00, 0x10, 0x00, 0x64},
+			err: ErrUnmarshallingMessage,
+		},
+		"bytes unmarshal into expected message": {
+			b:               []byte{0x00, 0x20, 0x00, 0x64},
+			expectedMessage: JRTimestampMessage{SenderClockTimestamp: 100},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got JRTimestampMessage
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil {
+				if err == nil {
+					t.Fatalf("expected non-nil %v error, got nil error", test.err)
+				}
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+			}
+			if !reflect.DeepEqual(test.expectedMessage, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedMessage, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package mpe
+
+import (
+	"fmt"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+// Expression represents the three dimensions of expression of an MPE note.
+type Expression struct {
+	// PitchBend represents the pitch bend of the note, relative to the pitch bend range of its channel.
+	PitchBend midiv1.PitchBend
+
+	// Pressure represents the pressure (or "Z" dimension) of the note.
+	Pressure midiv1.Pressure
+
+	// Timbre represents the value of the Timbre controller (CC74, or "Y" dimension) of the note.
+	Timbre midiv1.ControllerValue
+}
+
+// NewExpression returns the Expression of a note without any expression: no pitch bend, no pressure and a centred
+// timbre.
+func NewExpression() Expression {
+	return Expression{PitchBend: midiv1.ZeroPitchBend, Pressure: 0, Timbre: DefaultTimbre}
+}
+
+// Voice represents a note sounding on a member channel of a zone.
+type Voice struct {
+	// Channel represents the member channel the note was allocated to.
+	Channel midiv1.Channel
+
+	// Note represents the note number.
+	Note midiv1.Note
+}
+
+// PitchBend returns the message that bends the pitch of the voice alone.
+func (v Voice) PitchBend(pitchBend midiv1.PitchBend) *midiv1.PitchBendChangeMessage {
+	return &midiv1.PitchBendChangeMessage{Channel: v.Channel, PitchBend: pitchBend}
+}
+
+// Pressure returns the message that sets the pressure of the voice alone.
+func (v Voice) Pressure(pressure midiv1.Pressure) *midiv1.ChannelPressureMessage {
+	return &midiv1.ChannelPressureMessage{Channel: v.Channel, Pressure: pressure}
+}
+
+// Timbre returns the message that sets the Timbre controller (CC74) of the voice alone.
+func (v Voice) Timbre(value midiv1.ControllerValue) *midiv1.ControlChangeMessage {
+	return &midiv1.ControlChangeMessage{Channel: v.Channel, Controller: TimbreController, Value: value}
+}
+
+// memberChannel represents the notes sounding on a member channel.
+type memberChannel struct {
+	// channel represents the member channel
+	channel midiv1.Channel
+
+	// notes holds the notes sounding on the channel, oldest first
+	notes []midiv1.Note
+
+	// lastUsed represents when a note was last started or released on the channel, for least-recently-used allocation
+	lastUsed uint64
+}
+
+// Allocator hands out the member channels of a zone to notes, so each note gets a channel of its own for its
+// expression. A note is given the idle member channel that has gone unused the longest, which leaves the release of
+// recent notes undisturbed. When every member channel is busy the note shares the channel with the fewest notes,
+// preferring the one that has gone unused the longest. An Allocator is not safe for concurrent use.
+type Allocator struct {
+	// zone represents the zone whose member channels are allocated
+	zone Zone
+
+	// channels holds the state of each member channel
+	channels []memberChannel
+
+	// clock increments on every note-on and note-off
+	clock uint64
+}
+
+// NewAllocator returns an Allocator for the member channels of the zone, which must be enabled.
+func NewAllocator(zone Zone) (*Allocator, error) {
+	if !zone.Enabled() {
+		return nil, fmt.Errorf("cannot allocate notes in the %s without member channels: %w", zone.Type, ErrInvalidZone)
+	}
+	members := zone.Members()
+	channels := make([]memberChannel, len(members))
+	for i, member := range members {
+		channels[i] = memberChannel{channel: member}
+	}
+	return &Allocator{zone: zone, channels: channels}, nil
+}
+
+// Zone returns the zone whose member channels are allocated.
+func (a *Allocator) Zone() Zone {
+	return a.zone
+}
+
+// NoteOn allocates a member channel to the note and returns its Voice, along with the messages that start it. The
+// initial expression is sent on the member channel ahead of the Note-On, so the note starts with it rather than the
+// expression left behind by the previous note on the channel.
+func (a *Allocator) NoteOn(note midiv1.Note, velocity midiv1.Velocity, expression Expression) (Voice, []midiv1.Message) {
+	member := a.allocate()
+	a.clock++
+	member.notes = append(member.notes, note)
+	member.lastUsed = a.clock
+
+	voice := Voice{Channel: member.channel, Note: note}
+	return voice, []midiv1.Message{
+		voice.PitchBend(expression.PitchBend),
+		voice.Timbre(expression.Timbre),
+		voice.Pressure(expression.Pressure),
+		&midiv1.NoteOnMessage{Channel: voice.Channel, Note: note, Velocity: velocity},
+	}
+}
+
+// NoteOff releases the voice and returns the message that stops it. Releasing a voice that is not sounding still
+// returns the Note-Off message.
+func (a *Allocator) NoteOff(voice Voice, velocity midiv1.Velocity) *midiv1.NoteOffMessage {
+	for i := range a.channels {
+		member := &a.channels[i]
+		if member.channel != voice.Channel {
+			continue
+		}
+		for j, note := range member.notes {
+			if note == voice.Note {
+				member.notes = append(member.notes[:j], member.notes[j+1:]...)
+				a.clock++
+				member.lastUsed = a.clock
+				break
+			}
+		}
+		break
+	}
+	return &midiv1.NoteOffMessage{Channel: voice.Channel, Note: voice.Note, Velocity: velocity}
+}
+
+// Voices returns the voices that are sounding, in member channel order and oldest first within a channel.
+func (a *Allocator) Voices() []Voice {
+	voices := []Voice{}
+	for _, member := range a.channels {
+		for _, note := range member.notes {
+			voices = append(voices, Voice{Channel: member.channel, Note: note})
+		}
+	}
+	return voices
+}
+
+// allocate returns the member channel for the next note.
+func (a *Allocator) allocate() *memberChannel {
+	best := &a.channels[0]
+	for i := 1; i < len(a.channels); i++ {
+		member := &a.channels[i]
+		if len(member.notes) < len(best.notes) || (len(member.notes) == len(best.notes) && member.lastUsed < best.lastUsed) {
+			best = member
+		}
+	}
+	return best
+}
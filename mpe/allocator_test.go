@@ -0,0 +1,124 @@
+package mpe
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+func Test_NewAllocator(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		zone Zone
+		err  error
+	}{
+		"turned off zone cannot allocate": {
+			zone: Zone{Type: LowerZone},
+			err:  ErrInvalidZone,
+		},
+		"enabled zone can allocate": {
+			zone: Zone{Type: UpperZone, MemberChannels: 1},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewAllocator(test.zone)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func Test_Allocator_NoteOn(t *testing.T) {
+	t.Parallel()
+	type step struct {
+		noteOn  midiv1.Note
+		noteOff midiv1.Note
+	}
+	tests := map[string]struct {
+		zone     Zone
+		steps    []step
+		expected []midiv1.Channel
+	}{
+		"notes get their own channels": {
+			zone:     Zone{Type: LowerZone, MemberChannels: 3},
+			steps:    []step{{noteOn: 60}, {noteOn: 64}, {noteOn: 67}},
+			expected: []midiv1.Channel{1, 2, 3},
+		},
+		"upper zone allocates from the top": {
+			zone:     Zone{Type: UpperZone, MemberChannels: 3},
+			steps:    []step{{noteOn: 60}, {noteOn: 64}},
+			expected: []midiv1.Channel{14, 13},
+		},
+		"released channels are reused last": {
+			zone:     Zone{Type: LowerZone, MemberChannels: 3},
+			steps:    []step{{noteOn: 60}, {noteOn: 64}, {noteOff: 60}, {noteOn: 67}, {noteOn: 72}},
+			expected: []midiv1.Channel{1, 2, 3, 1},
+		},
+		"busy channels are shared, oldest first": {
+			zone:     Zone{Type: LowerZone, MemberChannels: 2},
+			steps:    []step{{noteOn: 60}, {noteOn: 64}, {noteOn: 67}, {noteOn: 72}, {noteOn: 76}},
+			expected: []midiv1.Channel{1, 2, 1, 2, 1},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			allocator, err := NewAllocator(test.zone)
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			voices := map[midiv1.Note]Voice{}
+			got := []midiv1.Channel{}
+			for _, s := range test.steps {
+				if s.noteOff != 0 {
+					allocator.NoteOff(voices[s.noteOff], 0)
+					continue
+				}
+				voice, _ := allocator.NoteOn(s.noteOn, 100, NewExpression())
+				voices[s.noteOn] = voice
+				got = append(got, voice.Channel)
+			}
+			if !reflect.DeepEqual(test.expected, got) {
+				t.Fatalf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_Allocator_Messages(t *testing.T) {
+	t.Parallel()
+	allocator, err := NewAllocator(Zone{Type: LowerZone, MemberChannels: 15})
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	expression := Expression{PitchBend: -100, Pressure: 10, Timbre: 20}
+	voice, got := allocator.NoteOn(60, 100, expression)
+	expected := []midiv1.Message{
+		&midiv1.PitchBendChangeMessage{Channel: 1, PitchBend: -100},
+		&midiv1.ControlChangeMessage{Channel: 1, Controller: TimbreController, Value: 20},
+		&midiv1.ChannelPressureMessage{Channel: 1, Pressure: 10},
+		&midiv1.NoteOnMessage{Channel: 1, Note: 60, Velocity: 100},
+	}
+	if !reflect.DeepEqual(expected, got) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+	if expected := []Voice{{Channel: 1, Note: 60}}; !reflect.DeepEqual(expected, allocator.Voices()) {
+		t.Fatalf("expected voices %v, got %v", expected, allocator.Voices())
+	}
+
+	noteOff := allocator.NoteOff(voice, 64)
+	if expected := (&midiv1.NoteOffMessage{Channel: 1, Note: 60, Velocity: 64}); !reflect.DeepEqual(expected, noteOff) {
+		t.Fatalf("expected %v, got %v", expected, noteOff)
+	}
+	if got := allocator.Voices(); len(got) != 0 {
+		t.Fatalf("expected no voices, got %v", got)
+	}
+}
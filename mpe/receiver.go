@@ -0,0 +1,313 @@
+package mpe
+
+import (
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+// EventType represents what changed when a Receiver handled a message.
+type EventType int
+
+const (
+	// NoteOnEvent represents a note that started.
+	NoteOnEvent EventType = iota
+
+	// NoteOffEvent represents a note that stopped, either with a Note-Off, a Note-On with a velocity of 0, or because its
+	// channel left its zone.
+	NoteOffEvent
+
+	// ExpressionEvent represents a change to the expression of a sounding note.
+	ExpressionEvent
+
+	// ZoneEvent represents a zone that was configured, either by an MPE Configuration Message or a Pitch Bend
+	// Sensitivity message.
+	ZoneEvent
+)
+
+// String returns the human-readable representation of the event type.
+func (et EventType) String() string {
+	switch et {
+	case NoteOnEvent:
+		return "Note On"
+	case NoteOffEvent:
+		return "Note Off"
+	case ExpressionEvent:
+		return "Expression"
+	case ZoneEvent:
+		return "Zone"
+	}
+	return "Unknown"
+}
+
+// Note represents a note received in an MPE zone, with the expression of its own channel and of the manager channel of
+// its zone.
+type Note struct {
+	// Zone represents the zone the note was received in.
+	Zone ZoneType
+
+	// Channel represents the channel the note was received on.
+	Channel midiv1.Channel
+
+	// Note represents the note number.
+	Note midiv1.Note
+
+	// Velocity represents the Note-On velocity of the note.
+	Velocity midiv1.Velocity
+
+	// Expression represents the expression of the channel of the note.
+	Expression Expression
+
+	// PitchBendRange represents the pitch bend range of the channel of the note in semitones.
+	PitchBendRange float64
+
+	// ZoneExpression represents the expression of the manager channel, which applies to every note in the zone. It has no
+	// expression for notes received on the manager channel itself.
+	ZoneExpression Expression
+
+	// ZonePitchBendRange represents the pitch bend range of the manager channel in semitones.
+	ZonePitchBendRange float64
+}
+
+// Semitones returns the total pitch bend of the note in semitones, combining the pitch bend of its own channel with that
+// of the manager channel.
+func (n Note) Semitones() float64 {
+	return n.Expression.PitchBend.Semitones(n.PitchBendRange) + n.ZoneExpression.PitchBend.Semitones(n.ZonePitchBendRange)
+}
+
+// Event represents a change reported by a Receiver.
+type Event struct {
+	// Type represents what changed.
+	Type EventType
+
+	// Note represents the note that changed, for every type but ZoneEvent.
+	Note Note
+
+	// Zone represents the zone that was configured, for ZoneEvent.
+	Zone Zone
+}
+
+// receivedNote represents a sounding note on a channel.
+type receivedNote struct {
+	// note represents the note number
+	note midiv1.Note
+
+	// velocity represents the Note-On velocity
+	velocity midiv1.Velocity
+}
+
+// Receiver reassembles the expression of each note from the messages of an MPE sender. It follows MPE Configuration
+// and Pitch Bend Sensitivity messages, whether they arrive as Control Change messages or as whole
+// RegisteredParameterNumberMessage values, to keep its Layout up to date.
+//
+// The expression of a channel is kept between notes, so Pitch Bend, Channel Pressure and Timbre messages sent ahead of a
+// Note-On apply to the note that follows. Messages on channels outside the zones are ignored. A Receiver is not safe for
+// concurrent use.
+type Receiver struct {
+	// layout represents the current zones
+	layout Layout
+
+	// parameters folds Control Change messages into RPN changes
+	parameters *midiv1.ParameterParser
+
+	// expressions holds the expression of each channel
+	expressions [16]Expression
+
+	// notes holds the sounding notes of each channel, oldest first
+	notes [16][]receivedNote
+}
+
+// NewReceiver returns a Receiver that starts with the supplied layout, such as one agreed with the sender out of band, or
+// NewLayout to wait for MPE Configuration Messages.
+func NewReceiver(layout Layout) *Receiver {
+	r := &Receiver{layout: layout, parameters: midiv1.NewParameterParser()}
+	for i := range r.expressions {
+		r.expressions[i] = NewExpression()
+	}
+	return r
+}
+
+// Layout returns the current zones.
+func (r *Receiver) Layout() Layout {
+	return r.layout
+}
+
+// Notes returns the sounding notes, in channel order and oldest first within a channel.
+func (r *Receiver) Notes() []Note {
+	notes := []Note{}
+	for channel := range r.notes {
+		zone, ok := r.layout.ZoneOf(midiv1.Channel(channel))
+		if !ok {
+			continue
+		}
+		for _, received := range r.notes[channel] {
+			notes = append(notes, r.note(zone, midiv1.Channel(channel), received))
+		}
+	}
+	return notes
+}
+
+// Receive updates the receiver from a message and returns the events it caused, if any.
+func (r *Receiver) Receive(message midiv1.Message) []Event {
+	switch m := message.(type) {
+	case *midiv1.RegisteredParameterNumberMessage:
+		return r.receiveParameter(midiv1.ParameterChange{
+			Channel:    m.Channel,
+			Registered: true,
+			Parameter:  midiv1.FourteenBitValue(m.Parameter),
+			Step:       m.Step,
+			Value:      m.Value,
+		})
+	case *midiv1.ControlChangeMessage:
+		if change, ok := r.parameters.Parse(m); ok {
+			return r.receiveParameter(change)
+		}
+		if m.Controller != TimbreController {
+			return nil
+		}
+		return r.receiveExpression(m.Channel, func(e *Expression) { e.Timbre = m.Value })
+	case *midiv1.PitchBendChangeMessage:
+		return r.receiveExpression(m.Channel, func(e *Expression) { e.PitchBend = m.PitchBend })
+	case *midiv1.ChannelPressureMessage:
+		return r.receiveExpression(m.Channel, func(e *Expression) { e.Pressure = m.Pressure })
+	case *midiv1.NoteOnMessage:
+		if m.Velocity == 0 {
+			return r.receiveNoteOff(m.Channel, m.Note)
+		}
+		return r.receiveNoteOn(m.Channel, m.Note, m.Velocity)
+	case *midiv1.NoteOffMessage:
+		return r.receiveNoteOff(m.Channel, m.Note)
+	}
+	return nil
+}
+
+// receiveNoteOn starts a note with the current expression of its channel.
+func (r *Receiver) receiveNoteOn(channel midiv1.Channel, note midiv1.Note, velocity midiv1.Velocity) []Event {
+	zone, ok := r.layout.ZoneOf(channel)
+	if !ok {
+		return nil
+	}
+	received := receivedNote{note: note, velocity: velocity}
+	r.notes[channel] = append(r.notes[channel], received)
+	return []Event{{Type: NoteOnEvent, Note: r.note(zone, channel, received)}}
+}
+
+// receiveNoteOff stops the oldest sounding instance of the note on the channel.
+func (r *Receiver) receiveNoteOff(channel midiv1.Channel, note midiv1.Note) []Event {
+	zone, ok := r.layout.ZoneOf(channel)
+	if !ok {
+		return nil
+	}
+	for i, received := range r.notes[channel] {
+		if received.note == note {
+			r.notes[channel] = append(r.notes[channel][:i], r.notes[channel][i+1:]...)
+			return []Event{{Type: NoteOffEvent, Note: r.note(zone, channel, received)}}
+		}
+	}
+	return nil
+}
+
+// receiveExpression updates the expression of the channel and reports every note it affects: the notes of the channel,
+// or every note of the zone for the manager channel.
+func (r *Receiver) receiveExpression(channel midiv1.Channel, update func(*Expression)) []Event {
+	zone, ok := r.layout.ZoneOf(channel)
+	if !ok {
+		return nil
+	}
+	update(&r.expressions[channel])
+
+	affected := []midiv1.Channel{channel}
+	if channel == zone.ManagerChannel() {
+		affected = append(affected, zone.Members()...)
+	}
+	events := []Event{}
+	for _, c := range affected {
+		for _, received := range r.notes[c] {
+			events = append(events, Event{Type: ExpressionEvent, Note: r.note(zone, c, received)})
+		}
+	}
+	return events
+}
+
+// receiveParameter applies MPE Configuration Messages received on a manager channel and Pitch Bend Sensitivity messages
+// received in a zone. Other parameters are ignored.
+func (r *Receiver) receiveParameter(change midiv1.ParameterChange) []Event {
+	if !change.Registered || change.Step != midiv1.SetParameterStep {
+		return nil
+	}
+	switch midiv1.RegisteredParameter(change.Parameter) {
+	case midiv1.MPEConfigurationParameter:
+		return r.configure(change.Channel, int(change.Value.GetMSB()))
+	case midiv1.PitchBendSensitivityParameter:
+		zone, ok := r.layout.ZoneOf(change.Channel)
+		if !ok {
+			return nil
+		}
+		bendRange := float64(change.Value.GetMSB()) + float64(change.Value.GetLSB())/100
+		if change.Channel == zone.ManagerChannel() {
+			zone.ManagerPitchBendRange = bendRange
+		} else {
+			zone.MemberPitchBendRange = bendRange
+		}
+		r.layout.SetZone(zone)
+		return []Event{{Type: ZoneEvent, Zone: zone}}
+	}
+	return nil
+}
+
+// configure applies an MPE Configuration Message, reporting the zones it changes and stopping the notes on channels that
+// left their zone.
+func (r *Receiver) configure(channel midiv1.Channel, memberChannels int) []Event {
+	var zoneType ZoneType
+	switch channel {
+	case LowerZone.ManagerChannel():
+		zoneType = LowerZone
+	case UpperZone.ManagerChannel():
+		zoneType = UpperZone
+	default:
+		return nil
+	}
+	if memberChannels > MaxMemberChannels {
+		memberChannels = MaxMemberChannels
+	}
+	zone, _ := NewZone(zoneType, memberChannels)
+
+	previous := r.layout
+	other := r.layout.SetZone(zone)
+	events := []Event{{Type: ZoneEvent, Zone: zone}}
+	if other != previous.Zone(other.Type) {
+		events = append(events, Event{Type: ZoneEvent, Zone: other})
+	}
+
+	for c := range r.notes {
+		channel := midiv1.Channel(c)
+		before, wasInZone := previous.ZoneOf(channel)
+		if after, ok := r.layout.ZoneOf(channel); ok && wasInZone && after.Type == before.Type {
+			continue
+		}
+		if wasInZone {
+			for _, received := range r.notes[c] {
+				events = append(events, Event{Type: NoteOffEvent, Note: r.note(before, channel, received)})
+			}
+		}
+		r.notes[c] = nil
+	}
+	return events
+}
+
+// note returns the Note of a sounding note, along with the expression and pitch bend ranges that apply to it.
+func (r *Receiver) note(zone Zone, channel midiv1.Channel, received receivedNote) Note {
+	n := Note{
+		Zone:               zone.Type,
+		Channel:            channel,
+		Note:               received.note,
+		Velocity:           received.velocity,
+		Expression:         r.expressions[channel],
+		PitchBendRange:     zone.MemberPitchBendRange,
+		ZoneExpression:     r.expressions[zone.ManagerChannel()],
+		ZonePitchBendRange: zone.ManagerPitchBendRange,
+	}
+	if channel == zone.ManagerChannel() {
+		n.PitchBendRange = zone.ManagerPitchBendRange
+		n.ZoneExpression = NewExpression()
+	}
+	return n
+}
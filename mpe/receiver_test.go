@@ -0,0 +1,161 @@
+package mpe
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+func Test_Receiver_Receive(t *testing.T) {
+	t.Parallel()
+	lower := Zone{Type: LowerZone, MemberChannels: 3, MemberPitchBendRange: 48, ManagerPitchBendRange: 2}
+	tests := map[string]struct {
+		layout   Layout
+		messages []midiv1.Message
+		expected []Event
+	}{
+		"expression sent before the note applies to it": {
+			layout: Layout{Lower: lower},
+			messages: []midiv1.Message{
+				&midiv1.PitchBendChangeMessage{Channel: 1, PitchBend: 4096},
+				&midiv1.ControlChangeMessage{Channel: 1, Controller: TimbreController, Value: 10},
+				&midiv1.ChannelPressureMessage{Channel: 1, Pressure: 20},
+				&midiv1.NoteOnMessage{Channel: 1, Note: 60, Velocity: 100},
+			},
+			expected: []Event{
+				{Type: NoteOnEvent, Note: Note{Zone: LowerZone, Channel: 1, Note: 60, Velocity: 100, Expression: Expression{PitchBend: 4096, Pressure: 20, Timbre: 10}, PitchBendRange: 48, ZoneExpression: NewExpression(), ZonePitchBendRange: 2}},
+			},
+		},
+		"member expression only affects its own note": {
+			layout: Layout{Lower: lower},
+			messages: []midiv1.Message{
+				&midiv1.NoteOnMessage{Channel: 1, Note: 60, Velocity: 100},
+				&midiv1.NoteOnMessage{Channel: 2, Note: 64, Velocity: 90},
+				&midiv1.ChannelPressureMessage{Channel: 2, Pressure: 50},
+			},
+			expected: []Event{
+				{Type: ExpressionEvent, Note: Note{Zone: LowerZone, Channel: 2, Note: 64, Velocity: 90, Expression: Expression{Pressure: 50, Timbre: DefaultTimbre}, PitchBendRange: 48, ZoneExpression: NewExpression(), ZonePitchBendRange: 2}},
+			},
+		},
+		"manager expression affects every note of the zone": {
+			layout: Layout{Lower: lower},
+			messages: []midiv1.Message{
+				&midiv1.NoteOnMessage{Channel: 1, Note: 60, Velocity: 100},
+				&midiv1.NoteOnMessage{Channel: 2, Note: 64, Velocity: 90},
+				&midiv1.PitchBendChangeMessage{Channel: 0, PitchBend: -8192},
+			},
+			expected: []Event{
+				{Type: ExpressionEvent, Note: Note{Zone: LowerZone, Channel: 1, Note: 60, Velocity: 100, Expression: NewExpression(), PitchBendRange: 48, ZoneExpression: Expression{PitchBend: -8192, Timbre: DefaultTimbre}, ZonePitchBendRange: 2}},
+				{Type: ExpressionEvent, Note: Note{Zone: LowerZone, Channel: 2, Note: 64, Velocity: 90, Expression: NewExpression(), PitchBendRange: 48, ZoneExpression: Expression{PitchBend: -8192, Timbre: DefaultTimbre}, ZonePitchBendRange: 2}},
+			},
+		},
+		"note-on with zero velocity stops the note": {
+			layout: Layout{Lower: lower},
+			messages: []midiv1.Message{
+				&midiv1.NoteOnMessage{Channel: 3, Note: 60, Velocity: 100},
+				&midiv1.NoteOnMessage{Channel: 3, Note: 60, Velocity: 0},
+			},
+			expected: []Event{
+				{Type: NoteOffEvent, Note: Note{Zone: LowerZone, Channel: 3, Note: 60, Velocity: 100, Expression: NewExpression(), PitchBendRange: 48, ZoneExpression: NewExpression(), ZonePitchBendRange: 2}},
+			},
+		},
+		"channels outside the zones are ignored": {
+			layout: Layout{Lower: lower},
+			messages: []midiv1.Message{
+				&midiv1.NoteOnMessage{Channel: 4, Note: 60, Velocity: 100},
+			},
+			expected: nil,
+		},
+		"MPE Configuration Message on a manager channel sets the zone": {
+			layout: NewLayout(),
+			messages: []midiv1.Message{
+				&midiv1.ControlChangeMessage{Channel: 15, Controller: midiv1.RegisteredParameterNumberMSBController, Value: 0},
+				&midiv1.ControlChangeMessage{Channel: 15, Controller: midiv1.RegisteredParameterNumberLSBController, Value: 6},
+				&midiv1.ControlChangeMessage{Channel: 15, Controller: midiv1.DataEntryMSBController, Value: 4},
+			},
+			expected: []Event{
+				{Type: ZoneEvent, Zone: Zone{Type: UpperZone, MemberChannels: 4, MemberPitchBendRange: 48, ManagerPitchBendRange: 2}},
+			},
+		},
+		"MPE Configuration Message on another channel is ignored": {
+			layout: NewLayout(),
+			messages: []midiv1.Message{
+				&midiv1.RegisteredParameterNumberMessage{Channel: 3, Parameter: midiv1.MPEConfigurationParameter, Value: midiv1.NewFourteenBitValueFromBytes(2, 0)},
+			},
+			expected: nil,
+		},
+		"shrinking the other zone stops its notes": {
+			layout: Layout{Lower: lower, Upper: Zone{Type: UpperZone, MemberChannels: 3, MemberPitchBendRange: 48, ManagerPitchBendRange: 2}},
+			messages: []midiv1.Message{
+				&midiv1.NoteOnMessage{Channel: 12, Note: 60, Velocity: 100},
+				&midiv1.RegisteredParameterNumberMessage{Channel: 0, Parameter: midiv1.MPEConfigurationParameter, Value: midiv1.NewFourteenBitValueFromBytes(12, 0)},
+			},
+			expected: []Event{
+				{Type: ZoneEvent, Zone: Zone{Type: LowerZone, MemberChannels: 12, MemberPitchBendRange: 48, ManagerPitchBendRange: 2}},
+				{Type: ZoneEvent, Zone: Zone{Type: UpperZone, MemberChannels: 2, MemberPitchBendRange: 48, ManagerPitchBendRange: 2}},
+				{Type: NoteOffEvent, Note: Note{Zone: UpperZone, Channel: 12, Note: 60, Velocity: 100, Expression: NewExpression(), PitchBendRange: 48, ZoneExpression: NewExpression(), ZonePitchBendRange: 2}},
+			},
+		},
+		"Pitch Bend Sensitivity on a member channel sets the range of the members": {
+			layout: Layout{Lower: lower},
+			messages: []midiv1.Message{
+				&midiv1.RegisteredParameterNumberMessage{Channel: 2, Parameter: midiv1.PitchBendSensitivityParameter, Value: midiv1.NewFourteenBitValueFromBytes(24, 50)},
+			},
+			expected: []Event{
+				{Type: ZoneEvent, Zone: Zone{Type: LowerZone, MemberChannels: 3, MemberPitchBendRange: 24.5, ManagerPitchBendRange: 2}},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			receiver := NewReceiver(test.layout)
+			var got []Event
+			for _, message := range test.messages {
+				got = receiver.Receive(message)
+			}
+			if len(test.expected) == 0 && len(got) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(test.expected, got) {
+				t.Fatalf("expected %+v, got %+v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_Receiver_Allocator(t *testing.T) {
+	t.Parallel()
+	layout := NewLayout()
+	layout.SetZone(Zone{Type: LowerZone, MemberChannels: 4, MemberPitchBendRange: 24, ManagerPitchBendRange: 2})
+	allocator, err := NewAllocator(layout.Lower)
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+
+	receiver := NewReceiver(NewLayout())
+	messages := layout.ConfigurationMessages()
+	first, noteOn := allocator.NoteOn(60, 100, NewExpression())
+	messages = append(messages, noteOn...)
+	second, noteOn := allocator.NoteOn(64, 100, NewExpression())
+	messages = append(messages, noteOn...)
+	messages = append(messages, first.PitchBend(midiv1.NewPitchBendFromSemitones(12, 24)), second.Pressure(80))
+	for _, message := range messages {
+		receiver.Receive(message)
+	}
+
+	if layout != receiver.Layout() {
+		t.Fatalf("expected layout %#v, got %#v", layout, receiver.Layout())
+	}
+	notes := receiver.Notes()
+	if len(notes) != 2 {
+		t.Fatalf("expected 2 notes, got %d", len(notes))
+	}
+	if got := notes[0].Semitones(); got < 11.99 || got > 12.01 {
+		t.Fatalf("expected the first note bent by 12 semitones, got %f", got)
+	}
+	if notes[0].Expression.Pressure != 0 || notes[1].Expression.Pressure != 80 || notes[1].Semitones() != 0 {
+		t.Fatalf("expected the pressure on the second note alone, got %+v", notes)
+	}
+}
@@ -0,0 +1,225 @@
+// Package mpe implements MIDI Polyphonic Expression (MPE), which gives every sounding note its own MIDI channel so that
+// its Pitch Bend, Channel Pressure and Timbre (CC74) messages affect that note alone.
+//
+// The channels are split into a Lower Zone, managed from channel 1 with member channels counting up from channel 2, and
+// an Upper Zone, managed from channel 16 with member channels counting down from channel 15. Zones are configured with
+// the MPE Configuration Message (RPN 6). An Allocator hands out member channels to notes on the sending side, and a
+// Receiver reassembles the expression of each note from incoming messages.
+package mpe
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+var (
+	// ErrInvalidZone represents an MPE zone with an invalid number of member channels, or a zone that is turned off where
+	// one is needed.
+	ErrInvalidZone error = errors.New("invalid MPE zone")
+)
+
+const (
+	// MaxMemberChannels represents the highest number of member channels of a zone, which leaves only its manager channel.
+	MaxMemberChannels int = 15
+
+	// DefaultMemberPitchBendRange represents the pitch bend range in semitones of member channels after an MPE
+	// Configuration Message.
+	DefaultMemberPitchBendRange float64 = 48
+
+	// DefaultManagerPitchBendRange represents the pitch bend range in semitones of manager channels after an MPE
+	// Configuration Message.
+	DefaultManagerPitchBendRange float64 = midiv1.DefaultPitchBendRange
+
+	// TimbreController represents the controller that carries the third dimension of expression (usually timbre or
+	// brightness) of MPE notes.
+	TimbreController midiv1.Controller = midiv1.SoundController5Controller
+
+	// DefaultTimbre represents the Timbre controller value of a note without any timbre expression.
+	DefaultTimbre midiv1.ControllerValue = midiv1.CenterControllerValue
+)
+
+// ZoneType represents which end of the channels an MPE zone is managed from.
+type ZoneType int
+
+const (
+	// LowerZone represents the zone managed from channel 1 (index 0), with member channels counting up.
+	LowerZone ZoneType = iota
+
+	// UpperZone represents the zone managed from channel 16 (index 15), with member channels counting down.
+	UpperZone
+)
+
+// String returns the human-readable representation of the zone type.
+func (zt ZoneType) String() string {
+	if zt == UpperZone {
+		return "Upper Zone"
+	}
+	return "Lower Zone"
+}
+
+// ManagerChannel returns the manager channel of zones of this type.
+func (zt ZoneType) ManagerChannel() midiv1.Channel {
+	if zt == UpperZone {
+		return midiv1.MaxChannel
+	}
+	return midiv1.MinChannel
+}
+
+// Zone represents an MPE zone: a manager channel for messages that affect every note of the zone, and the member
+// channels that each carry a single note and its expression.
+type Zone struct {
+	// Type represents whether this is the Lower or Upper Zone.
+	Type ZoneType
+
+	// MemberChannels represents the number of member channels, between 0 (the zone is turned off) and 15 inclusive.
+	MemberChannels int
+
+	// MemberPitchBendRange represents the pitch bend range of the member channels in semitones.
+	MemberPitchBendRange float64
+
+	// ManagerPitchBendRange represents the pitch bend range of the manager channel in semitones.
+	ManagerPitchBendRange float64
+}
+
+// NewZone returns a Zone of the supplied type and number of member channels, with the default pitch bend ranges.
+func NewZone(zoneType ZoneType, memberChannels int) (Zone, error) {
+	if zoneType != LowerZone && zoneType != UpperZone {
+		return Zone{}, fmt.Errorf("zone type %d is neither the Lower nor the Upper Zone: %w", zoneType, ErrInvalidZone)
+	}
+	if memberChannels < 0 || memberChannels > MaxMemberChannels {
+		return Zone{}, fmt.Errorf("zones have between 0 and %d member channels, inclusive, received %d: %w", MaxMemberChannels, memberChannels, ErrInvalidZone)
+	}
+	return Zone{
+		Type:                  zoneType,
+		MemberChannels:        memberChannels,
+		MemberPitchBendRange:  DefaultMemberPitchBendRange,
+		ManagerPitchBendRange: DefaultManagerPitchBendRange,
+	}, nil
+}
+
+// Enabled returns whether the zone has any member channels.
+func (z Zone) Enabled() bool {
+	return z.MemberChannels > 0
+}
+
+// ManagerChannel returns the manager channel of the zone.
+func (z Zone) ManagerChannel() midiv1.Channel {
+	return z.Type.ManagerChannel()
+}
+
+// Members returns the member channels of the zone, starting from the one next to the manager channel.
+func (z Zone) Members() []midiv1.Channel {
+	members := make([]midiv1.Channel, 0, z.MemberChannels)
+	for i := 1; i <= z.MemberChannels && i <= MaxMemberChannels; i++ {
+		if z.Type == UpperZone {
+			members = append(members, midiv1.MaxChannel-midiv1.Channel(i))
+		} else {
+			members = append(members, midiv1.MinChannel+midiv1.Channel(i))
+		}
+	}
+	return members
+}
+
+// IsMember returns whether the channel is a member channel of the zone.
+func (z Zone) IsMember(channel midiv1.Channel) bool {
+	if z.Type == UpperZone {
+		return channel < midiv1.MaxChannel && int(midiv1.MaxChannel-channel) <= z.MemberChannels
+	}
+	return channel > midiv1.MinChannel && int(channel-midiv1.MinChannel) <= z.MemberChannels
+}
+
+// Contains returns whether the channel is the manager channel or a member channel of an enabled zone.
+func (z Zone) Contains(channel midiv1.Channel) bool {
+	return z.Enabled() && (channel == z.ManagerChannel() || z.IsMember(channel))
+}
+
+// ConfigurationMessages returns the messages that configure the zone on a receiver: the MPE Configuration Message on the
+// manager channel, followed by Pitch Bend Sensitivity messages for any pitch bend range other than the default. A Pitch
+// Bend Sensitivity message on one member channel sets the range of every member channel.
+func (z Zone) ConfigurationMessages() []midiv1.Message {
+	messages := []midiv1.Message{}
+	configuration := midiv1.NewMPEConfigurationMessage(z.ManagerChannel(), z.MemberChannels)
+	messages = append(messages, &configuration)
+	if !z.Enabled() {
+		return messages
+	}
+	if z.ManagerPitchBendRange != DefaultManagerPitchBendRange {
+		sensitivity := newPitchBendSensitivityMessage(z.ManagerChannel(), z.ManagerPitchBendRange)
+		messages = append(messages, &sensitivity)
+	}
+	if z.MemberPitchBendRange != DefaultMemberPitchBendRange {
+		sensitivity := newPitchBendSensitivityMessage(z.Members()[0], z.MemberPitchBendRange)
+		messages = append(messages, &sensitivity)
+	}
+	return messages
+}
+
+// Layout represents the Lower and Upper Zones of an MPE device. Setting one zone shrinks the other so that the zones
+// never share a channel.
+type Layout struct {
+	// Lower represents the Lower Zone.
+	Lower Zone
+
+	// Upper represents the Upper Zone.
+	Upper Zone
+}
+
+// NewLayout returns a Layout with both zones turned off.
+func NewLayout() Layout {
+	lower, _ := NewZone(LowerZone, 0)
+	upper, _ := NewZone(UpperZone, 0)
+	return Layout{Lower: lower, Upper: upper}
+}
+
+// Zone returns the zone of the supplied type.
+func (l Layout) Zone(zoneType ZoneType) Zone {
+	if zoneType == UpperZone {
+		return l.Upper
+	}
+	return l.Lower
+}
+
+// SetZone replaces the zone of the same type and returns the other zone, which is shrunk (or turned off) when the two
+// zones would otherwise share a member channel, as MPE receivers do when they get an MPE Configuration Message.
+func (l *Layout) SetZone(zone Zone) Zone {
+	this, other := &l.Lower, &l.Upper
+	if zone.Type == UpperZone {
+		this, other = &l.Upper, &l.Lower
+	}
+	*this = zone
+
+	// the member channels of both zones must fit between the two manager channels
+	if available := MaxMemberChannels - 1 - zone.MemberChannels; other.MemberChannels > available {
+		if available < 0 {
+			available = 0
+		}
+		other.MemberChannels = available
+	}
+	return *other
+}
+
+// ZoneOf returns the enabled zone that the channel belongs to, as either its manager or a member channel.
+func (l Layout) ZoneOf(channel midiv1.Channel) (Zone, bool) {
+	if l.Lower.Contains(channel) {
+		return l.Lower, true
+	}
+	if l.Upper.Contains(channel) {
+		return l.Upper, true
+	}
+	return Zone{}, false
+}
+
+// ConfigurationMessages returns the messages that configure both zones of the layout on a receiver, starting with the
+// Lower Zone.
+func (l Layout) ConfigurationMessages() []midiv1.Message {
+	return append(l.Lower.ConfigurationMessages(), l.Upper.ConfigurationMessages()...)
+}
+
+// newPitchBendSensitivityMessage returns a Pitch Bend Sensitivity message for the channel with the range in semitones
+// split into whole semitones and cents.
+func newPitchBendSensitivityMessage(channel midiv1.Channel, bendRange float64) midiv1.RegisteredParameterNumberMessage {
+	semitones := int(bendRange)
+	return midiv1.NewPitchBendSensitivityMessage(channel, semitones, int((bendRange-float64(semitones))*100+0.5))
+}
@@ -0,0 +1,213 @@
+package mpe
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+func Test_NewZone(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		zoneType       ZoneType
+		memberChannels int
+		expected       Zone
+		err            error
+	}{
+		"zone type is not supported": {
+			zoneType: ZoneType(2),
+			err:      ErrInvalidZone,
+		},
+		"too few member channels": {
+			zoneType:       LowerZone,
+			memberChannels: -1,
+			err:            ErrInvalidZone,
+		},
+		"too many member channels": {
+			zoneType:       UpperZone,
+			memberChannels: 16,
+			err:            ErrInvalidZone,
+		},
+		"zone has the default pitch bend ranges": {
+			zoneType:       LowerZone,
+			memberChannels: 7,
+			expected:       Zone{Type: LowerZone, MemberChannels: 7, MemberPitchBendRange: 48, ManagerPitchBendRange: 2},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewZone(test.zoneType, test.memberChannels)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if test.expected != got {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_Zone_Members(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		zone            Zone
+		expectedManager midiv1.Channel
+		expected        []midiv1.Channel
+	}{
+		"turned off zone has no members": {
+			zone:            Zone{Type: LowerZone},
+			expectedManager: 0,
+			expected:        []midiv1.Channel{},
+		},
+		"lower zone members count up": {
+			zone:            Zone{Type: LowerZone, MemberChannels: 3},
+			expectedManager: 0,
+			expected:        []midiv1.Channel{1, 2, 3},
+		},
+		"upper zone members count down": {
+			zone:            Zone{Type: UpperZone, MemberChannels: 3},
+			expectedManager: 15,
+			expected:        []midiv1.Channel{14, 13, 12},
+		},
+		"full zone uses every other channel": {
+			zone:            Zone{Type: UpperZone, MemberChannels: 15},
+			expectedManager: 15,
+			expected:        []midiv1.Channel{14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1, 0},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := test.zone.ManagerChannel(); test.expectedManager != got {
+				t.Fatalf("expected manager channel %d, got %d", test.expectedManager, got)
+			}
+			got := test.zone.Members()
+			if !reflect.DeepEqual(test.expected, got) {
+				t.Fatalf("expected %v, got %v", test.expected, got)
+			}
+			for _, channel := range midiv1.AllChannels() {
+				expected := false
+				for _, member := range test.expected {
+					expected = expected || member == channel
+				}
+				if got := test.zone.IsMember(channel); expected != got {
+					t.Fatalf("expected channel %d membership %t, got %t", channel, expected, got)
+				}
+			}
+		})
+	}
+}
+
+func Test_Zone_ConfigurationMessages(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		zone     Zone
+		expected []byte
+	}{
+		"turned off zone only sends the MPE Configuration Message": {
+			zone:     Zone{Type: UpperZone, MemberPitchBendRange: 12},
+			expected: []byte{0xBF, 0x65, 0x00, 0xBF, 0x64, 0x06, 0xBF, 0x06, 0x00, 0xBF, 0x65, 0x7F, 0xBF, 0x64, 0x7F},
+		},
+		"default pitch bend ranges are not sent": {
+			zone:     Zone{Type: LowerZone, MemberChannels: 2, MemberPitchBendRange: 48, ManagerPitchBendRange: 2},
+			expected: []byte{0xB0, 0x65, 0x00, 0xB0, 0x64, 0x06, 0xB0, 0x06, 0x02, 0xB0, 0x65, 0x7F, 0xB0, 0x64, 0x7F},
+		},
+		"other pitch bend ranges are sent on the manager and first member channels": {
+			zone: Zone{Type: LowerZone, MemberChannels: 2, MemberPitchBendRange: 24.5, ManagerPitchBendRange: 12},
+			expected: []byte{
+				0xB0, 0x65, 0x00, 0xB0, 0x64, 0x06, 0xB0, 0x06, 0x02, 0xB0, 0x65, 0x7F, 0xB0, 0x64, 0x7F,
+				0xB0, 0x65, 0x00, 0xB0, 0x64, 0x00, 0xB0, 0x06, 0x0C, 0xB0, 0x26, 0x00, 0xB0, 0x65, 0x7F, 0xB0, 0x64, 0x7F,
+				0xB1, 0x65, 0x00, 0xB1, 0x64, 0x00, 0xB1, 0x06, 0x18, 0xB1, 0x26, 0x32, 0xB1, 0x65, 0x7F, 0xB1, 0x64, 0x7F,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := []byte{}
+			for _, message := range test.zone.ConfigurationMessages() {
+				b, err := message.(midiv1.MessageMarshaler).MarshalMIDI()
+				if err != nil {
+					t.Fatalf("expected nil error, got %v", err)
+				}
+				got = append(got, b...)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_Layout_SetZone(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		layout   Layout
+		zone     Zone
+		expected Layout
+	}{
+		"zones that fit are unchanged": {
+			layout:   Layout{Lower: Zone{Type: LowerZone, MemberChannels: 7}, Upper: Zone{Type: UpperZone}},
+			zone:     Zone{Type: UpperZone, MemberChannels: 7},
+			expected: Layout{Lower: Zone{Type: LowerZone, MemberChannels: 7}, Upper: Zone{Type: UpperZone, MemberChannels: 7}},
+		},
+		"other zone shrinks": {
+			layout:   Layout{Lower: Zone{Type: LowerZone, MemberChannels: 7}, Upper: Zone{Type: UpperZone, MemberChannels: 7}},
+			zone:     Zone{Type: UpperZone, MemberChannels: 10},
+			expected: Layout{Lower: Zone{Type: LowerZone, MemberChannels: 4}, Upper: Zone{Type: UpperZone, MemberChannels: 10}},
+		},
+		"other zone is turned off": {
+			layout:   Layout{Lower: Zone{Type: LowerZone, MemberChannels: 7}, Upper: Zone{Type: UpperZone, MemberChannels: 7}},
+			zone:     Zone{Type: LowerZone, MemberChannels: 15},
+			expected: Layout{Lower: Zone{Type: LowerZone, MemberChannels: 15}, Upper: Zone{Type: UpperZone}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := test.layout
+			other := got.SetZone(test.zone)
+			if test.expected != got {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+			if expected := test.expected.Zone(other.Type); expected != other {
+				t.Fatalf("expected other zone %#v, got %#v", expected, other)
+			}
+		})
+	}
+}
+
+func Test_Layout_ZoneOf(t *testing.T) {
+	t.Parallel()
+	layout := Layout{Lower: Zone{Type: LowerZone, MemberChannels: 5}, Upper: Zone{Type: UpperZone, MemberChannels: 3}}
+	tests := map[string]struct {
+		channel  midiv1.Channel
+		expected ZoneType
+		ok       bool
+	}{
+		"lower manager channel": {channel: 0, expected: LowerZone, ok: true},
+		"lower member channel":  {channel: 5, expected: LowerZone, ok: true},
+		"unused channel":        {channel: 6, ok: false},
+		"upper member channel":  {channel: 12, expected: UpperZone, ok: true},
+		"upper manager channel": {channel: 15, expected: UpperZone, ok: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, ok := layout.ZoneOf(test.channel)
+			if test.ok != ok {
+				t.Fatalf("expected ok %t, got %t", test.ok, ok)
+			}
+			if ok && test.expected != got.Type {
+				t.Fatalf("expected %s, got %s", test.expected, got.Type)
+			}
+		})
+	}
+}
@@ -0,0 +1,317 @@
+package mtc
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+const (
+	// DefaultLockTimeout represents the longest gap between MTC Quarter Frame messages that keeps a Decoder locked by
+	// default, which is a little over three frames at the slowest rate.
+	DefaultLockTimeout time.Duration = 150 * time.Millisecond
+)
+
+// EventType represents the kind of change reported by a Decoder.
+type EventType int
+
+const (
+	// LockedEvent reports the first complete quarter frame cycle after the decoder was unlocked.
+	LockedEvent EventType = iota
+
+	// TimecodeEvent reports the timecode of a complete quarter frame cycle.
+	TimecodeEvent
+
+	// LocatedEvent reports an MTC Full Message, which jumps to a new timecode.
+	LocatedEvent
+
+	// LockLostEvent reports that quarter frames stopped arriving or arrived out of order.
+	LockLostEvent
+)
+
+// String returns the human-readable representation of the event type.
+func (et EventType) String() string {
+	switch et {
+	case LockedEvent:
+		return "Locked"
+	case TimecodeEvent:
+		return "Timecode"
+	case LocatedEvent:
+		return "Located"
+	case LockLostEvent:
+		return "Lock Lost"
+	}
+	return fmt.Sprintf("EventType(%d)", int(et))
+}
+
+// Event represents a change reported by a Decoder.
+type Event struct {
+	// Type represents the kind of change.
+	Type EventType
+
+	// Time represents the time at which the message that caused the change arrived, or the time at which the lock was
+	// found to be lost.
+	Time time.Time
+
+	// Timecode represents the current timecode after the change.
+	Timecode Timecode
+
+	// Direction represents the direction in which the timecode is running.
+	Direction Direction
+}
+
+// Decoder follows a MIDI Time Code stream, putting the MTC Quarter Frame cycle back together into a timecode and
+// jumping to the timecode of any MTC Full Message.
+//
+// A cycle takes two frames to send, so the timecode of a cycle received forwards is two frames behind by the time its
+// last piece arrives, and the decoder adds them back. A cycle received in reverse runs from piece 7 to piece 0, and
+// the decoder takes the two frames away instead. The decoder is locked while complete cycles keep arriving in order,
+// and loses lock when a piece is skipped or no quarter frame arrives within the lock timeout. The methods of a Decoder
+// are safe for concurrent use.
+type Decoder struct {
+	// mutex guards the state of the decoder
+	mutex sync.Mutex
+
+	// now returns the current time and exists so tests can control the arrival time of messages
+	now func() time.Time
+
+	// handler receives every event raised by the decoder, or is nil to raise no events
+	handler func(Event)
+
+	// timeout is the longest gap between quarter frames that keeps the decoder locked
+	timeout time.Duration
+
+	// values holds the value nibble of every piece of the cycle in progress
+	values [QuarterFramesPerCycle]byte
+
+	// lastPiece is the piece of the last quarter frame, or -1 when no quarter frame is in progress
+	lastPiece int
+
+	// lastArrival is the arrival time of the last quarter frame
+	lastArrival time.Time
+
+	// direction is the direction of the quarter frames in progress
+	direction Direction
+
+	// run is the number of quarter frames in a row that arrived in order in the current direction
+	run int
+
+	// locked represents whether complete cycles are arriving in order
+	locked bool
+
+	// timecode is the current timecode
+	timecode Timecode
+
+	// hasTimecode represents whether a timecode has been received yet
+	hasTimecode bool
+}
+
+// DecoderOption configures a Decoder.
+type DecoderOption func(*Decoder)
+
+// WithEventHandler sets the function that receives the events raised by the decoder. The handler is called on the
+// goroutine that handed over the message, after the decoder has been updated.
+func WithEventHandler(handler func(Event)) DecoderOption {
+	return func(d *Decoder) {
+		d.handler = handler
+	}
+}
+
+// WithLockTimeout sets the longest gap between MTC Quarter Frame messages that keeps the decoder locked. The default is
+// DefaultLockTimeout.
+func WithLockTimeout(timeout time.Duration) DecoderOption {
+	return func(d *Decoder) {
+		d.timeout = timeout
+	}
+}
+
+// NewDecoder returns a new unlocked Decoder configured by any supplied options.
+func NewDecoder(options ...DecoderOption) *Decoder {
+	d := &Decoder{
+		now:       time.Now,
+		timeout:   DefaultLockTimeout,
+		lastPiece: -1,
+	}
+	for _, option := range options {
+		option(d)
+	}
+	return d
+}
+
+// Handle updates the decoder from a message that has just arrived. Messages other than MTC Quarter Frame messages and
+// MTC Full Messages are ignored.
+func (d *Decoder) Handle(message midiv1.Message) {
+	d.HandleAt(message, d.now())
+}
+
+// HandleAt updates the decoder from a message that arrived at the supplied time, such as a timestamp from a MIDI driver.
+// Messages other than MTC Quarter Frame messages and MTC Full Messages are ignored.
+func (d *Decoder) HandleAt(message midiv1.Message, t time.Time) {
+	d.mutex.Lock()
+	var events []Event
+	switch m := message.(type) {
+	case *midiv1.MTCQuarterFrameMessage:
+		events = d.quarterFrame(*m, t)
+	case *midiv1.MTCFullMessage:
+		// a jump to a new timecode starts a new cycle, so lock is found again once quarter frames resume
+		d.timecode = NewTimecodeFromFullMessage(*m)
+		d.hasTimecode = true
+		d.locked = false
+		d.lastPiece = -1
+		d.run = 0
+		events = append(events, d.event(LocatedEvent, t))
+	}
+	handler := d.handler
+	d.mutex.Unlock()
+
+	d.raise(handler, events)
+}
+
+// CheckLockAt reports whether the decoder is still locked at the supplied time, losing lock if no quarter frame has
+// arrived within the lock timeout. It can be called periodically to notice a stream that stopped altogether.
+func (d *Decoder) CheckLockAt(t time.Time) bool {
+	d.mutex.Lock()
+	var events []Event
+	if d.locked && t.Sub(d.lastArrival) > d.timeout {
+		events = append(events, d.loseLock(t))
+	}
+	locked := d.locked
+	handler := d.handler
+	d.mutex.Unlock()
+
+	d.raise(handler, events)
+	return locked
+}
+
+// Locked returns whether complete quarter frame cycles are arriving in order.
+func (d *Decoder) Locked() bool {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return d.locked
+}
+
+// Timecode returns the current timecode, and false if no timecode has been received yet.
+func (d *Decoder) Timecode() (Timecode, bool) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return d.timecode, d.hasTimecode
+}
+
+// Direction returns the direction of the quarter frames that are arriving.
+func (d *Decoder) Direction() Direction {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return d.direction
+}
+
+// quarterFrame records a quarter frame and returns the events it causes.
+func (d *Decoder) quarterFrame(message midiv1.MTCQuarterFrameMessage, t time.Time) []Event {
+	var events []Event
+	piece := int(message.Piece)
+	if piece >= QuarterFramesPerCycle {
+		return nil
+	}
+
+	if d.lastPiece >= 0 && t.Sub(d.lastArrival) > d.timeout {
+		if d.locked {
+			events = append(events, d.loseLock(d.lastArrival.Add(d.timeout)))
+		}
+		d.lastPiece = -1
+	}
+
+	switch {
+	case d.lastPiece < 0:
+		d.run = 1
+	case piece == (d.lastPiece+1)%QuarterFramesPerCycle:
+		d.follow(Forward)
+	case piece == (d.lastPiece+QuarterFramesPerCycle-1)%QuarterFramesPerCycle:
+		d.follow(Reverse)
+	default:
+		// a piece went missing, so the cycle in progress cannot be trusted
+		if d.locked {
+			events = append(events, d.loseLock(t))
+		}
+		d.run = 1
+	}
+	d.values[piece] = message.Value
+	d.lastPiece = piece
+	d.lastArrival = t
+
+	complete := (d.direction == Forward && piece == QuarterFramesPerCycle-1) || (d.direction == Reverse && piece == 0)
+	if !complete || d.run < QuarterFramesPerCycle {
+		return events
+	}
+	tc, err := d.assemble()
+	if err != nil {
+		if d.locked {
+			events = append(events, d.loseLock(t))
+		}
+		d.run = 1
+		return events
+	}
+	d.timecode = tc
+	d.hasTimecode = true
+	if !d.locked {
+		d.locked = true
+		events = append(events, d.event(LockedEvent, t))
+	}
+	return append(events, d.event(TimecodeEvent, t))
+}
+
+// follow counts a quarter frame that arrived in order in the supplied direction. A change of direction starts a new
+// cycle without losing lock.
+func (d *Decoder) follow(direction Direction) {
+	if d.run > 1 && direction != d.direction {
+		d.run = 1
+	}
+	d.direction = direction
+	d.run++
+}
+
+// assemble returns the current timecode from the pieces of a complete cycle.
+func (d *Decoder) assemble() (Timecode, error) {
+	v := d.values
+	tc := Timecode{
+		Rate:    midiv1.TimeCodeRate(v[7] >> 1 & 0x03),
+		Hours:   int(v[6]&0x0F) | int(v[7]&0x01)<<4,
+		Minutes: int(v[4]&0x0F) | int(v[5]&0x03)<<4,
+		Seconds: int(v[2]&0x0F) | int(v[3]&0x03)<<4,
+		Frames:  int(v[0]&0x0F) | int(v[1]&0x01)<<4,
+	}
+	if err := tc.Validate(); err != nil {
+		return Timecode{}, err
+	}
+	if d.direction == Reverse {
+		return tc.Add(-FramesPerCycle), nil
+	}
+	return tc.Add(FramesPerCycle), nil
+}
+
+// loseLock unlocks the decoder and returns the LockLost event.
+func (d *Decoder) loseLock(t time.Time) Event {
+	d.locked = false
+	d.run = 0
+	return d.event(LockLostEvent, t)
+}
+
+// event returns an Event of the supplied type that describes the current state of the decoder.
+func (d *Decoder) event(eventType EventType, t time.Time) Event {
+	return Event{
+		Type:      eventType,
+		Time:      t,
+		Timecode:  d.timecode,
+		Direction: d.direction,
+	}
+}
+
+// raise hands the events to the handler, if there is one.
+func (d *Decoder) raise(handler func(Event), events []Event) {
+	if handler == nil {
+		return
+	}
+	for _, event := range events {
+		handler(event)
+	}
+}
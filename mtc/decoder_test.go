@@ -0,0 +1,147 @@
+package mtc
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+// sendQuarterFrames hands count quarter frames from the encoder to the decoder, one QuarterFrameDuration apart starting
+// at start. It returns the time of the next quarter frame.
+func sendQuarterFrames(d *Decoder, e *Encoder, start time.Time, count int) time.Time {
+	t := start
+	for i := 0; i < count; i++ {
+		message := e.Next()
+		d.HandleAt(&message, t)
+		t = t.Add(QuarterFrameDuration(e.Timecode().Rate))
+	}
+	return t
+}
+
+func Test_Decoder_Forward(t *testing.T) {
+	t.Parallel()
+	var events []EventType
+	d := NewDecoder(WithEventHandler(func(event Event) {
+		events = append(events, event.Type)
+	}))
+	start := Timecode{Rate: midiv1.TimeCodeRate2997DropFrame, Seconds: 59, Frames: 28}
+	e, err := NewEncoder(start)
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+
+	sendQuarterFrames(d, e, time.Time{}, 7)
+	if d.Locked() {
+		t.Fatalf("expected the decoder to wait for a full cycle")
+	}
+	sendQuarterFrames(d, e, time.Time{}.Add(7*QuarterFrameDuration(start.Rate)), 1)
+	if !d.Locked() {
+		t.Fatalf("expected the decoder to lock after a full cycle")
+	}
+	// the cycle for 00:00:59;28 is complete two frames later, over the skipped frame numbers
+	expected := Timecode{Rate: midiv1.TimeCodeRate2997DropFrame, Minutes: 1, Frames: 2}
+	if got, _ := d.Timecode(); got != expected {
+		t.Fatalf("expected %s, got %s", expected, got)
+	}
+	if !reflect.DeepEqual([]EventType{LockedEvent, TimecodeEvent}, events) {
+		t.Fatalf("expected locked and timecode events, got %v", events)
+	}
+}
+
+func Test_Decoder_Reverse(t *testing.T) {
+	t.Parallel()
+	d := NewDecoder()
+	start := Timecode{Rate: midiv1.TimeCodeRate24, Hours: 1}
+	e, err := NewEncoder(start)
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	next := sendQuarterFrames(d, e, time.Time{}, 16)
+	e.SetDirection(Reverse)
+	sendQuarterFrames(d, e, next, 8)
+
+	if !d.Locked() {
+		t.Fatalf("expected the decoder to stay locked when the direction changes")
+	}
+	if d.Direction() != Reverse {
+		t.Fatalf("expected %s, got %s", Reverse, d.Direction())
+	}
+	// the encoder reached 01:00:00:04 going forwards, then sent that cycle in reverse
+	expected := Timecode{Rate: midiv1.TimeCodeRate24, Hours: 1, Frames: 2}
+	if got, _ := d.Timecode(); got != expected {
+		t.Fatalf("expected %s, got %s", expected, got)
+	}
+}
+
+func Test_Decoder_LockLost(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		send func(d *Decoder, e *Encoder, next time.Time)
+	}{
+		"piece is skipped": {
+			send: func(d *Decoder, e *Encoder, next time.Time) {
+				e.Next()
+				sendQuarterFrames(d, e, next, 1)
+			},
+		},
+		"quarter frames stop arriving": {
+			send: func(d *Decoder, e *Encoder, next time.Time) {
+				if !d.CheckLockAt(next) {
+					t.Fatalf("expected the decoder to still be locked")
+				}
+				d.CheckLockAt(next.Add(DefaultLockTimeout))
+			},
+		},
+		"quarter frames resume after a gap": {
+			send: func(d *Decoder, e *Encoder, next time.Time) {
+				sendQuarterFrames(d, e, next.Add(time.Second), 1)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var events []EventType
+			d := NewDecoder(WithEventHandler(func(event Event) {
+				events = append(events, event.Type)
+			}))
+			e, err := NewEncoder(Timecode{Rate: midiv1.TimeCodeRate25})
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			next := sendQuarterFrames(d, e, time.Time{}, 8)
+			test.send(d, e, next)
+			if d.Locked() {
+				t.Fatalf("expected the decoder to lose lock")
+			}
+			if events[len(events)-1] != LockLostEvent {
+				t.Fatalf("expected a lock lost event, got %v", events)
+			}
+		})
+	}
+}
+
+func Test_Decoder_FullMessage(t *testing.T) {
+	t.Parallel()
+	var got []Event
+	d := NewDecoder(WithEventHandler(func(event Event) {
+		got = append(got, event)
+	}))
+	if _, ok := d.Timecode(); ok {
+		t.Fatalf("expected no timecode before any message")
+	}
+	tc := Timecode{Rate: midiv1.TimeCodeRate30, Hours: 10, Minutes: 20, Seconds: 30, Frames: 15}
+	message := tc.FullMessage(midiv1.AllCallDeviceID)
+	d.HandleAt(&message, time.Time{})
+	d.HandleAt(&midiv1.NoteOnMessage{}, time.Time{})
+
+	expected := []Event{{Type: LocatedEvent, Timecode: tc}}
+	if !reflect.DeepEqual(expected, got) {
+		t.Fatalf("expected %+v, got %+v", expected, got)
+	}
+	if current, ok := d.Timecode(); !ok || current != tc {
+		t.Fatalf("expected %s, got %s", tc, current)
+	}
+}
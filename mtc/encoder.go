@@ -0,0 +1,91 @@
+package mtc
+
+import "github.com/matthewfritz/go-midi/midiv1"
+
+// Direction represents the direction in which a timecode is running.
+type Direction int
+
+const (
+	// Forward represents a timecode that counts up, with quarter frame pieces sent from 0 to 7.
+	Forward Direction = iota
+
+	// Reverse represents a timecode that counts down, with quarter frame pieces sent from 7 to 0.
+	Reverse
+)
+
+// String returns the human-readable representation of the direction.
+func (d Direction) String() string {
+	if d == Reverse {
+		return "Reverse"
+	}
+	return "Forward"
+}
+
+// Encoder produces the MTC Quarter Frame messages of a running timecode, one at a time. A new message should be sent
+// every QuarterFrameDuration of the rate, and the timecode moves on by FramesPerCycle frames after every cycle.
+type Encoder struct {
+	// timecode is the timecode carried by the current cycle
+	timecode Timecode
+
+	// sent is the number of pieces of the current cycle that have been produced
+	sent int
+
+	// direction is the direction in which the timecode is running
+	direction Direction
+}
+
+// NewEncoder returns a new Encoder that starts a forward cycle at the supplied timecode.
+func NewEncoder(start Timecode) (*Encoder, error) {
+	if err := start.Validate(); err != nil {
+		return nil, err
+	}
+	return &Encoder{
+		timecode: start,
+	}, nil
+}
+
+// Next returns the next MTC Quarter Frame message of the running timecode.
+func (e *Encoder) Next() midiv1.MTCQuarterFrameMessage {
+	pieces := e.timecode.QuarterFrames()
+	piece := e.sent
+	if e.direction == Reverse {
+		piece = QuarterFramesPerCycle - 1 - e.sent
+	}
+	message := pieces[piece]
+
+	e.sent++
+	if e.sent == QuarterFramesPerCycle {
+		e.sent = 0
+		if e.direction == Reverse {
+			e.timecode = e.timecode.Add(-FramesPerCycle)
+		} else {
+			e.timecode = e.timecode.Add(FramesPerCycle)
+		}
+	}
+	return message
+}
+
+// Locate moves the encoder to the start of a cycle at the supplied timecode. An MTC Full Message for the timecode
+// should be sent first so receivers jump straight to it.
+func (e *Encoder) Locate(tc Timecode) error {
+	if err := tc.Validate(); err != nil {
+		return err
+	}
+	e.timecode = tc
+	e.sent = 0
+	return nil
+}
+
+// SetDirection changes the direction in which the timecode runs, starting a new cycle at the current timecode.
+func (e *Encoder) SetDirection(direction Direction) {
+	if direction == e.direction {
+		return
+	}
+	e.direction = direction
+	e.sent = 0
+}
+
+// Timecode returns the timecode carried by the current cycle.
+func (e *Encoder) Timecode() Timecode {
+	return e.timecode
+}
@@ -0,0 +1,76 @@
+package mtc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+func Test_NewEncoder(t *testing.T) {
+	t.Parallel()
+	_, err := NewEncoder(Timecode{Rate: midiv1.TimeCodeRate25, Frames: 25})
+	if !errors.Is(err, midiv1.ErrInvalidTimeCode) {
+		t.Fatalf("expected %v error, got %v", midiv1.ErrInvalidTimeCode, err)
+	}
+}
+
+func Test_Encoder_Next(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		direction      Direction
+		expectedPieces []midiv1.MTCQuarterFramePiece
+		expected       Timecode
+	}{
+		"forward cycle": {
+			direction:      Forward,
+			expectedPieces: []midiv1.MTCQuarterFramePiece{0, 1, 2, 3, 4, 5, 6, 7},
+			expected:       Timecode{Rate: midiv1.TimeCodeRate25, Seconds: 1, Frames: 1},
+		},
+		"reverse cycle": {
+			direction:      Reverse,
+			expectedPieces: []midiv1.MTCQuarterFramePiece{7, 6, 5, 4, 3, 2, 1, 0},
+			expected:       Timecode{Rate: midiv1.TimeCodeRate25, Frames: 22},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			start := Timecode{Rate: midiv1.TimeCodeRate25, Frames: 24}
+			e, err := NewEncoder(start)
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			e.SetDirection(test.direction)
+			pieces := start.QuarterFrames()
+			for i, piece := range test.expectedPieces {
+				got := e.Next()
+				if got != pieces[piece] {
+					t.Fatalf("expected message %d to be %+v, got %+v", i, pieces[piece], got)
+				}
+			}
+			if e.Timecode() != test.expected {
+				t.Fatalf("expected %s after a cycle, got %s", test.expected, e.Timecode())
+			}
+		})
+	}
+}
+
+func Test_Encoder_Locate(t *testing.T) {
+	t.Parallel()
+	e, err := NewEncoder(Timecode{Rate: midiv1.TimeCodeRate30})
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	e.Next()
+	target := Timecode{Rate: midiv1.TimeCodeRate30, Hours: 2}
+	if err := e.Locate(target); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if got := e.Next(); got != target.QuarterFrames()[0] {
+		t.Fatalf("expected the first piece of %s, got %+v", target, got)
+	}
+	if err := e.Locate(Timecode{Rate: midiv1.TimeCodeRate30, Minutes: 60}); !errors.Is(err, midiv1.ErrInvalidTimeCode) {
+		t.Fatalf("expected %v error, got %v", midiv1.ErrInvalidTimeCode, err)
+	}
+}
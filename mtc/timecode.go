@@ -0,0 +1,214 @@
+// Package mtc converts SMPTE timecode to and from MIDI Time Code (MTC), as defined by the MIDI 1.0 specification.
+//
+// A running timecode is sent as a cycle of eight MTC Quarter Frame messages spread over two frames, while a jump to a
+// new position is sent as a single MTC Full Message. An Encoder produces the quarter frame cycle and a Decoder puts it
+// back together, following the direction of play and reporting when it loses lock.
+package mtc
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+const (
+	// TimecodeStringFormat represents the printf-compatible format for the string representation of a timecode. The
+	// separator before the frames is a semicolon for drop-frame rates and a colon for every other rate.
+	TimecodeStringFormat string = "%02d:%02d:%02d%s%02d"
+
+	// QuarterFramesPerCycle represents the number of MTC Quarter Frame messages that make up a full timecode.
+	QuarterFramesPerCycle int = 8
+
+	// FramesPerCycle represents the number of frames that pass while a full quarter frame cycle is sent.
+	FramesPerCycle int = 2
+
+	// dropFramesPerMinute represents the number of frame numbers skipped at the start of most minutes in drop-frame timecode.
+	dropFramesPerMinute int = 2
+
+	// dropFramesPerTenMinutes represents the number of frames in ten minutes of drop-frame timecode.
+	dropFramesPerTenMinutes int = 17982
+
+	// dropFramesPerDroppedMinute represents the number of frames in a minute of drop-frame timecode that skips frame numbers.
+	dropFramesPerDroppedMinute int = 1798
+)
+
+// Timecode represents an SMPTE timecode position.
+type Timecode struct {
+	// Rate represents the SMPTE frame rate of the timecode.
+	Rate midiv1.TimeCodeRate
+
+	// Hours represents the hours of the timecode, between 0 and 23 inclusive.
+	Hours int
+
+	// Minutes represents the minutes of the timecode, between 0 and 59 inclusive.
+	Minutes int
+
+	// Seconds represents the seconds of the timecode, between 0 and 59 inclusive.
+	Seconds int
+
+	// Frames represents the frames of the timecode, lower than the number of frames per second of the rate.
+	Frames int
+}
+
+// NewTimecode returns a Timecode based on the supplied rate and position, or an error if the position does not exist
+// at the rate.
+func NewTimecode(rate midiv1.TimeCodeRate, hours int, minutes int, seconds int, frames int) (Timecode, error) {
+	tc := Timecode{
+		Rate:    rate,
+		Hours:   hours,
+		Minutes: minutes,
+		Seconds: seconds,
+		Frames:  frames,
+	}
+	if err := tc.Validate(); err != nil {
+		return Timecode{}, err
+	}
+	return tc, nil
+}
+
+// NewTimecodeFromFrameCount returns the Timecode that is the supplied number of frames after 00:00:00:00 at the supplied
+// rate. Counts beyond a day wrap around, and negative counts count back from the end of the day.
+func NewTimecodeFromFrameCount(rate midiv1.TimeCodeRate, count int) Timecode {
+	perDay := framesPerDay(rate)
+	count %= perDay
+	if count < 0 {
+		count += perDay
+	}
+
+	// drop-frame counts are turned into the nominal 30 frames per second count that includes the skipped frame numbers
+	if rate.IsDropFrame() {
+		tens := count / dropFramesPerTenMinutes
+		remainder := count % dropFramesPerTenMinutes
+		count += 9*dropFramesPerMinute*tens + dropFramesPerMinute*((remainder-dropFramesPerMinute)/dropFramesPerDroppedMinute)
+	}
+
+	fps := rate.FramesPerSecond()
+	return Timecode{
+		Rate:    rate,
+		Hours:   count / (fps * 3600),
+		Minutes: count / (fps * 60) % 60,
+		Seconds: count / fps % 60,
+		Frames:  count % fps,
+	}
+}
+
+// NewTimecodeFromFullMessage returns the Timecode carried by an MTC Full Message.
+func NewTimecodeFromFullMessage(message midiv1.MTCFullMessage) Timecode {
+	return Timecode{
+		Rate:    message.Rate,
+		Hours:   message.Hours,
+		Minutes: message.Minutes,
+		Seconds: message.Seconds,
+		Frames:  message.Frames,
+	}
+}
+
+// Validate returns an error if the timecode does not exist at its rate, such as a frame number skipped by drop-frame
+// timecode.
+func (tc Timecode) Validate() error {
+	if tc.Rate > midiv1.TimeCodeRate30 {
+		return fmt.Errorf("unknown time code rate %d: %w", tc.Rate, midiv1.ErrInvalidTimeCode)
+	}
+	if tc.Hours < 0 || tc.Hours > 23 || tc.Minutes < 0 || tc.Minutes > 59 || tc.Seconds < 0 || tc.Seconds > 59 {
+		return fmt.Errorf("timecode %s is out of range: %w", tc, midiv1.ErrInvalidTimeCode)
+	}
+	if tc.Frames < 0 || tc.Frames >= tc.Rate.FramesPerSecond() {
+		return fmt.Errorf("timecode frames must be between 0 and %d at %s frames per second, received %d: %w", tc.Rate.FramesPerSecond()-1, tc.Rate, tc.Frames, midiv1.ErrInvalidTimeCode)
+	}
+	if tc.Rate.IsDropFrame() && tc.Seconds == 0 && tc.Frames < dropFramesPerMinute && tc.Minutes%10 != 0 {
+		return fmt.Errorf("timecode %s is skipped by drop-frame timecode: %w", tc, midiv1.ErrInvalidTimeCode)
+	}
+	return nil
+}
+
+// FrameCount returns the number of frames between 00:00:00:00 and the timecode.
+func (tc Timecode) FrameCount() int {
+	fps := tc.Rate.FramesPerSecond()
+	count := ((tc.Hours*60+tc.Minutes)*60+tc.Seconds)*fps + tc.Frames
+	if tc.Rate.IsDropFrame() {
+		minutes := tc.Hours*60 + tc.Minutes
+		count -= dropFramesPerMinute * (minutes - minutes/10)
+	}
+	return count
+}
+
+// Add returns the timecode that is the supplied number of frames later, or earlier for a negative number of frames.
+// The result wraps around at the end of the day.
+func (tc Timecode) Add(frames int) Timecode {
+	return NewTimecodeFromFrameCount(tc.Rate, tc.FrameCount()+frames)
+}
+
+// Duration returns the real time between 00:00:00:00 and the timecode. Drop-frame timecode runs at 30000/1001 frames
+// per second, so its durations match the wall clock.
+func (tc Timecode) Duration() time.Duration {
+	count := int64(tc.FrameCount())
+	if tc.Rate.IsDropFrame() {
+		return time.Duration(count * int64(time.Second) * 1001 / 30000)
+	}
+	return time.Duration(count * int64(time.Second) / int64(tc.Rate.FramesPerSecond()))
+}
+
+// FullMessage returns the MTC Full Message that carries the timecode to the supplied device.
+func (tc Timecode) FullMessage(deviceID midiv1.DeviceID) midiv1.MTCFullMessage {
+	return midiv1.MTCFullMessage{
+		DeviceID: deviceID,
+		Rate:     tc.Rate,
+		Hours:    tc.Hours,
+		Minutes:  tc.Minutes,
+		Seconds:  tc.Seconds,
+		Frames:   tc.Frames,
+	}
+}
+
+// QuarterFrames returns the cycle of eight MTC Quarter Frame messages that carries the timecode, in piece order.
+func (tc Timecode) QuarterFrames() [QuarterFramesPerCycle]midiv1.MTCQuarterFrameMessage {
+	values := [QuarterFramesPerCycle]byte{
+		byte(tc.Frames) & 0x0F,
+		byte(tc.Frames>>4) & 0x01,
+		byte(tc.Seconds) & 0x0F,
+		byte(tc.Seconds>>4) & 0x03,
+		byte(tc.Minutes) & 0x0F,
+		byte(tc.Minutes>>4) & 0x03,
+		byte(tc.Hours) & 0x0F,
+		byte(tc.Rate)<<1 | byte(tc.Hours>>4)&0x01,
+	}
+	var messages [QuarterFramesPerCycle]midiv1.MTCQuarterFrameMessage
+	for i, value := range values {
+		messages[i] = midiv1.MTCQuarterFrameMessage{
+			Piece: midiv1.MTCQuarterFramePiece(i),
+			Value: value,
+		}
+	}
+	return messages
+}
+
+// String returns the human-readable representation of the timecode.
+func (tc Timecode) String() string {
+	separator := ":"
+	if tc.Rate.IsDropFrame() {
+		separator = ";"
+	}
+	return fmt.Sprintf(TimecodeStringFormat, tc.Hours, tc.Minutes, tc.Seconds, separator, tc.Frames)
+}
+
+// FrameDuration returns the real time taken by a single frame at the supplied rate.
+func FrameDuration(rate midiv1.TimeCodeRate) time.Duration {
+	if rate.IsDropFrame() {
+		return time.Second * 1001 / 30000
+	}
+	return time.Second / time.Duration(rate.FramesPerSecond())
+}
+
+// QuarterFrameDuration returns the real time between two MTC Quarter Frame messages at the supplied rate.
+func QuarterFrameDuration(rate midiv1.TimeCodeRate) time.Duration {
+	return FrameDuration(rate) / 4
+}
+
+// framesPerDay returns the number of frames in a day of timecode at the supplied rate.
+func framesPerDay(rate midiv1.TimeCodeRate) int {
+	if rate.IsDropFrame() {
+		return 24 * 6 * dropFramesPerTenMinutes
+	}
+	return 24 * 3600 * rate.FramesPerSecond()
+}
@@ -0,0 +1,189 @@
+package mtc
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+func Test_NewTimecode(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		tc  Timecode
+		err error
+	}{
+		"hours are out of range": {
+			tc:  Timecode{Rate: midiv1.TimeCodeRate25, Hours: 24},
+			err: midiv1.ErrInvalidTimeCode,
+		},
+		"frames are out of range for the rate": {
+			tc:  Timecode{Rate: midiv1.TimeCodeRate24, Frames: 24},
+			err: midiv1.ErrInvalidTimeCode,
+		},
+		"frame number is skipped by drop-frame timecode": {
+			tc:  Timecode{Rate: midiv1.TimeCodeRate2997DropFrame, Minutes: 1, Frames: 1},
+			err: midiv1.ErrInvalidTimeCode,
+		},
+		"drop-frame timecode keeps the first frames of every tenth minute": {
+			tc: Timecode{Rate: midiv1.TimeCodeRate2997DropFrame, Minutes: 10},
+		},
+		"timecode is valid": {
+			tc: Timecode{Rate: midiv1.TimeCodeRate30, Hours: 23, Minutes: 59, Seconds: 59, Frames: 29},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewTimecode(test.tc.Rate, test.tc.Hours, test.tc.Minutes, test.tc.Seconds, test.tc.Frames)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if test.err == nil && got != test.tc {
+				t.Fatalf("expected %s, got %s", test.tc, got)
+			}
+		})
+	}
+}
+
+func Test_Timecode_FrameCount(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		tc       Timecode
+		expected int
+	}{
+		"24 frames per second": {
+			tc:       Timecode{Rate: midiv1.TimeCodeRate24, Hours: 1, Seconds: 1, Frames: 1},
+			expected: 3600*24 + 24 + 1,
+		},
+		"drop-frame minute": {
+			tc:       Timecode{Rate: midiv1.TimeCodeRate2997DropFrame, Minutes: 1, Frames: 2},
+			expected: 1800,
+		},
+		"drop-frame ten minutes": {
+			tc:       Timecode{Rate: midiv1.TimeCodeRate2997DropFrame, Minutes: 10},
+			expected: 17982,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := test.tc.FrameCount()
+			if got != test.expected {
+				t.Fatalf("expected %d, got %d", test.expected, got)
+			}
+			if back := NewTimecodeFromFrameCount(test.tc.Rate, got); back != test.tc {
+				t.Fatalf("expected %s, got %s", test.tc, back)
+			}
+		})
+	}
+}
+
+func Test_Timecode_Add(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		tc       Timecode
+		frames   int
+		expected Timecode
+	}{
+		"drop-frame timecode skips frame numbers": {
+			tc:       Timecode{Rate: midiv1.TimeCodeRate2997DropFrame, Seconds: 59, Frames: 29},
+			frames:   1,
+			expected: Timecode{Rate: midiv1.TimeCodeRate2997DropFrame, Minutes: 1, Frames: 2},
+		},
+		"drop-frame timecode counts back over skipped frame numbers": {
+			tc:       Timecode{Rate: midiv1.TimeCodeRate2997DropFrame, Minutes: 1, Frames: 2},
+			frames:   -1,
+			expected: Timecode{Rate: midiv1.TimeCodeRate2997DropFrame, Seconds: 59, Frames: 29},
+		},
+		"timecode wraps at the end of the day": {
+			tc:       Timecode{Rate: midiv1.TimeCodeRate25, Hours: 23, Minutes: 59, Seconds: 59, Frames: 24},
+			frames:   2,
+			expected: Timecode{Rate: midiv1.TimeCodeRate25, Frames: 1},
+		},
+		"timecode wraps at the start of the day": {
+			tc:       Timecode{Rate: midiv1.TimeCodeRate30},
+			frames:   -1,
+			expected: Timecode{Rate: midiv1.TimeCodeRate30, Hours: 23, Minutes: 59, Seconds: 59, Frames: 29},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := test.tc.Add(test.frames)
+			if got != test.expected {
+				t.Fatalf("expected %s, got %s", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_Timecode_Duration(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		tc       Timecode
+		expected time.Duration
+	}{
+		"25 frames per second": {
+			tc:       Timecode{Rate: midiv1.TimeCodeRate25, Minutes: 1, Frames: 5},
+			expected: time.Minute + 200*time.Millisecond,
+		},
+		"drop-frame timecode follows the wall clock": {
+			tc:       Timecode{Rate: midiv1.TimeCodeRate2997DropFrame, Hours: 1},
+			expected: time.Hour - 3600*time.Millisecond/1000,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := test.tc.Duration()
+			if got != test.expected {
+				t.Fatalf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_Timecode_String(t *testing.T) {
+	t.Parallel()
+	tc := Timecode{Rate: midiv1.TimeCodeRate2997DropFrame, Hours: 1, Minutes: 2, Seconds: 3, Frames: 4}
+	if tc.String() != "01:02:03;04" {
+		t.Fatalf("expected 01:02:03;04, got %s", tc.String())
+	}
+	tc.Rate = midiv1.TimeCodeRate30
+	if tc.String() != "01:02:03:04" {
+		t.Fatalf("expected 01:02:03:04, got %s", tc.String())
+	}
+}
+
+func Test_Timecode_QuarterFrames(t *testing.T) {
+	t.Parallel()
+	tc := Timecode{Rate: midiv1.TimeCodeRate30, Hours: 17, Minutes: 42, Seconds: 35, Frames: 27}
+	expected := [QuarterFramesPerCycle]byte{0x0B, 0x01, 0x03, 0x02, 0x0A, 0x02, 0x01, 0x07}
+	for i, message := range tc.QuarterFrames() {
+		if int(message.Piece) != i || message.Value != expected[i] {
+			t.Fatalf("expected piece %d with value %#x, got %+v", i, expected[i], message)
+		}
+	}
+}
+
+func Test_Timecode_FullMessage(t *testing.T) {
+	t.Parallel()
+	tc := Timecode{Rate: midiv1.TimeCodeRate24, Hours: 1, Minutes: 2, Seconds: 3, Frames: 4}
+	message := tc.FullMessage(midiv1.AllCallDeviceID)
+	b, err := message.MarshalMIDI()
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	var got midiv1.MTCFullMessage
+	if err := (&got).UnmarshalMIDI(b); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if NewTimecodeFromFullMessage(got) != tc {
+		t.Fatalf("expected %s, got %s", tc, NewTimecodeFromFullMessage(got))
+	}
+}
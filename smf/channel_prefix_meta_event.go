@@ -0,0 +1,57 @@
+package smf
+
+import (
+	"fmt"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+// ChannelPrefixMetaEvent represents a MIDI Channel Prefix meta event, which associates the meta events and System
+// Exclusive events that follow it with a MIDI channel.
+type ChannelPrefixMetaEvent struct {
+	// Channel represents the channel that following events are associated with.
+	Channel midiv1.Channel
+}
+
+// GetMessageName returns the name of this MIDI Channel Prefix meta event.
+func (cpme *ChannelPrefixMetaEvent) GetMessageName() string {
+	return "MIDI Channel Prefix"
+}
+
+// MarshalMIDI marshalls a ChannelPrefixMetaEvent into its raw track bytes.
+func (cpme ChannelPrefixMetaEvent) MarshalMIDI() ([]byte, error) {
+	if _, err := midiv1.NewChannelFromByte(byte(cpme.Channel)); err != nil {
+		return nil, fmt.Errorf("invalid channel (%v): %w", err, ErrMarshallingFile)
+	}
+	return marshalMetaEvent(ChannelPrefixMetaEventType, []byte{byte(cpme.Channel)})
+}
+
+// isFileEvent marks MIDI Channel Prefix meta events as events that are written to tracks as-is.
+func (cpme ChannelPrefixMetaEvent) isFileEvent() {}
+
+// String returns the human-readable representation of the meta event.
+func (cpme *ChannelPrefixMetaEvent) String() string {
+	return fmt.Sprintf("%s:%s:%d", EventVersion, cpme.GetMessageName(), cpme.Channel)
+}
+
+// UnmarshalMIDI unmarshalls raw track bytes into a ChannelPrefixMetaEvent struct pointer. MIDI Channel Prefix meta events
+// are represented by (left to right): 0xFF, 0x20, 0x01, channel.
+//
+// Example: []byte{0xFF, 0x20, 0x01, 0x09}
+//
+// The example forms a MIDI Channel Prefix meta event for channel 10 (index 9).
+func (cpme *ChannelPrefixMetaEvent) UnmarshalMIDI(b []byte) error {
+	data, err := unmarshalMetaEvent("channel prefix", ChannelPrefixMetaEventType, 1, b)
+	if err != nil {
+		return err
+	}
+	channel, err := midiv1.NewChannelFromByte(data[0])
+	if err != nil {
+		return fmt.Errorf("invalid channel (%v) from channel prefix byte: %w", err, ErrUnmarshallingFile)
+	}
+
+	*cpme = ChannelPrefixMetaEvent{
+		Channel: channel,
+	}
+	return nil
+}
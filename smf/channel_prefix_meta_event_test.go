@@ -0,0 +1,84 @@
+package smf
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_ChannelPrefixMetaEvent_GetMessageName(t *testing.T) {
+	t.Parallel()
+	event := ChannelPrefixMetaEvent{}
+	expected := "MIDI Channel Prefix"
+	if event.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, event.GetMessageName())
+	}
+}
+
+func Test_ChannelPrefixMetaEvent_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		event    ChannelPrefixMetaEvent
+		expected []byte
+		err      error
+	}{
+		"channel is out of range": {
+			event: ChannelPrefixMetaEvent{Channel: 16},
+			err:   ErrMarshallingFile,
+		},
+		"event marshalls into expected bytes": {
+			event:    ChannelPrefixMetaEvent{Channel: 9},
+			expected: []byte{0xFF, 0x20, 0x01, 0x09},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.event.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_ChannelPrefixMetaEvent_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b             []byte
+		expectedEvent ChannelPrefixMetaEvent
+		err           error
+	}{
+		"channel is out of range": {
+			b:   []byte{0xFF, 0x20, 0x01, 0x10},
+			err: ErrUnmarshallingFile,
+		},
+		"bytes unmarshal into expected event": {
+			b:             []byte{0xFF, 0x20, 0x01, 0x09},
+			expectedEvent: ChannelPrefixMetaEvent{Channel: 9},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got ChannelPrefixMetaEvent
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedEvent, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedEvent, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,357 @@
+package smf
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+// Read reads all of r and unmarshalls it into a Standard MIDI File.
+func Read(r io.Reader) (*File, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	f := &File{}
+	if err := f.UnmarshalMIDI(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// ReadFile reads the named file and unmarshalls it into a Standard MIDI File.
+func ReadFile(name string) (*File, error) {
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	f := &File{}
+	if err := f.UnmarshalMIDI(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// ReadLegacy reads all of r and unmarshalls it into a Standard MIDI File written by earlier versions of this package,
+// using File.UnmarshalLegacyMIDI.
+func ReadLegacy(r io.Reader) (*File, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	f := &File{}
+	if err := f.UnmarshalLegacyMIDI(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// ReadLegacyFile reads the named file and unmarshalls it into a Standard MIDI File written by earlier versions of this
+// package, using File.UnmarshalLegacyMIDI.
+func ReadLegacyFile(name string) (*File, error) {
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	f := &File{}
+	if err := f.UnmarshalLegacyMIDI(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalMIDI unmarshalls the raw bytes of a Standard MIDI File into a File struct pointer. The bytes must begin with
+// an MThd header chunk, followed by as many MTrk track chunks as the header declares. Chunks of any other type are skipped.
+//
+// Every Channel Pressure event is read with the standard two-byte layout. Files written by earlier versions of this
+// package, whose Channel Pressure events were a byte too long, must be read with UnmarshalLegacyMIDI instead.
+func (f *File) UnmarshalMIDI(b []byte) error {
+	return f.unmarshalMIDI(b, parseTrackEvents)
+}
+
+// UnmarshalLegacyMIDI unmarshalls the raw bytes of a Standard MIDI File written by earlier versions of this package into
+// a File struct pointer, reading every Channel Pressure event with the legacy three-byte layout.
+func (f *File) UnmarshalLegacyMIDI(b []byte) error {
+	return f.unmarshalMIDI(b, parseLegacyTrackEvents)
+}
+
+// unmarshalMIDI unmarshalls the raw bytes of a Standard MIDI File, using parse to read the events of each track.
+func (f *File) unmarshalMIDI(b []byte, parse func([]byte) ([]Event, error)) error {
+	chunkType, header, rest, err := parseChunk(b)
+	if err != nil {
+		return err
+	}
+	if chunkType != HeaderChunkType {
+		return fmt.Errorf("standard MIDI files must start with an %s chunk, received %q: %w", HeaderChunkType, chunkType, ErrUnmarshallingFile)
+	}
+	if len(header) < HeaderChunkLength {
+		return fmt.Errorf("%s chunks are made up of at least %d bytes, received %d byte(s): %w", HeaderChunkType, HeaderChunkLength, len(header), ErrUnmarshallingFile)
+	}
+
+	format := Format(binary.BigEndian.Uint16(header[0:2]))
+	trackCount := int(binary.BigEndian.Uint16(header[2:4]))
+	division := Division(binary.BigEndian.Uint16(header[4:6]))
+	if format > MultiSequenceFormat {
+		return fmt.Errorf("unsupported standard MIDI file format %d: %w", format, ErrUnmarshallingFile)
+	}
+	if format == SingleTrackFormat && trackCount != 1 {
+		return fmt.Errorf("format 0 files must contain exactly 1 track, header declares %d: %w", trackCount, ErrUnmarshallingFile)
+	}
+
+	tracks := make([]Track, 0, trackCount)
+	for len(rest) > 0 && len(tracks) < trackCount {
+		var data []byte
+		chunkType, data, rest, err = parseChunk(rest)
+		if err != nil {
+			return err
+		}
+		if chunkType != TrackChunkType {
+			// unknown chunk types must be ignored
+			continue
+		}
+		events, err := parse(data)
+		if err != nil {
+			return fmt.Errorf("track %d: %v: %w", len(tracks), err, ErrUnmarshallingFile)
+		}
+		tracks = append(tracks, Track{
+			Events: events,
+		})
+	}
+	if len(tracks) != trackCount {
+		return fmt.Errorf("header declares %d track(s), found %d: %w", trackCount, len(tracks), ErrUnmarshallingFile)
+	}
+
+	*f = File{
+		Format:   format,
+		Division: division,
+		Tracks:   tracks,
+	}
+	return nil
+}
+
+// UnmarshalMIDI unmarshalls the raw bytes of a single MTrk chunk, including its chunk type and length, into a Track
+// struct pointer. Every Channel Pressure event is read with the standard two-byte layout, as it is by File.UnmarshalMIDI.
+func (t *Track) UnmarshalMIDI(b []byte) error {
+	return t.unmarshalMIDI(b, parseTrackEvents)
+}
+
+// UnmarshalLegacyMIDI unmarshalls the raw bytes of a single MTrk chunk written by earlier versions of this package into a
+// Track struct pointer, reading every Channel Pressure event with the legacy three-byte layout.
+func (t *Track) UnmarshalLegacyMIDI(b []byte) error {
+	return t.unmarshalMIDI(b, parseLegacyTrackEvents)
+}
+
+// unmarshalMIDI unmarshalls the raw bytes of a single MTrk chunk, using parse to read its events.
+func (t *Track) unmarshalMIDI(b []byte, parse func([]byte) ([]Event, error)) error {
+	chunkType, data, rest, err := parseChunk(b)
+	if err != nil {
+		return err
+	}
+	if chunkType != TrackChunkType {
+		return fmt.Errorf("tracks must be %s chunks, received %q: %w", TrackChunkType, chunkType, ErrUnmarshallingFile)
+	}
+	if len(rest) > 0 {
+		return fmt.Errorf("track chunk has %d trailing byte(s): %w", len(rest), ErrUnmarshallingFile)
+	}
+	events, err := parse(data)
+	if err != nil {
+		return fmt.Errorf("%v: %w", err, ErrUnmarshallingFile)
+	}
+
+	*t = Track{
+		Events: events,
+	}
+	return nil
+}
+
+// parseChunk returns the type and data of the chunk at the start of b, along with the bytes that follow it.
+func parseChunk(b []byte) (string, []byte, []byte, error) {
+	if len(b) < chunkPrefixLength {
+		return "", nil, nil, fmt.Errorf("chunks are made up of at least %d bytes, received %d byte(s): %w", chunkPrefixLength, len(b), ErrUnmarshallingFile)
+	}
+	chunkType := string(b[0:4])
+	length := binary.BigEndian.Uint32(b[4:8])
+	if uint64(len(b)-chunkPrefixLength) < uint64(length) {
+		return "", nil, nil, fmt.Errorf("%q chunk data is %d byte(s), received %d byte(s): %w", chunkType, length, len(b)-chunkPrefixLength, ErrUnmarshallingFile)
+	}
+	end := chunkPrefixLength + int(length)
+	return chunkType, b[chunkPrefixLength:end], b[end:], nil
+}
+
+// parseTrackEvents returns the events within the data of a track chunk. Channel events may use running status, which
+// is cancelled by meta events and System Exclusive events. Any bytes after an End of Track meta event are ignored.
+func parseTrackEvents(data []byte) ([]Event, error) {
+	return parseTrackEventsWithLayout(data, false)
+}
+
+// parseLegacyTrackEvents returns the events within the data of a track chunk, reading every Channel Pressure event with
+// the legacy three-byte layout.
+func parseLegacyTrackEvents(data []byte) ([]Event, error) {
+	return parseTrackEventsWithLayout(data, true)
+}
+
+// parseTrackEventsWithLayout returns the events within the data of a track chunk, reading Channel Pressure events with
+// the legacy three-byte layout when legacy is true.
+func parseTrackEventsWithLayout(data []byte, legacy bool) ([]Event, error) {
+	var events []Event
+	var runningStatus byte
+	for pos := 0; pos < len(data); {
+		deltaTime, n, err := ParseVariableLengthQuantity(data[pos:])
+		if err != nil {
+			return nil, fmt.Errorf("event %d has an invalid delta time (%v)", len(events), err)
+		}
+		pos += n
+		if pos >= len(data) {
+			return nil, fmt.Errorf("event %d has a delta time but no data", len(events))
+		}
+
+		message, n, err := parseTrackEvent(data[pos:], runningStatus, legacy)
+		if err != nil {
+			return nil, fmt.Errorf("event %d: %v", len(events), err)
+		}
+		status := data[pos]
+		pos += n
+		events = append(events, Event{
+			DeltaTime: deltaTime,
+			Message:   message,
+		})
+
+		switch {
+		case status == MetaEventStatus || status == SystemExclusiveEventStatus || status == EscapeEventStatus:
+			runningStatus = 0
+		case midiv1.ByteHasStatusMSB(status):
+			runningStatus = status
+		}
+		if isEndOfTrack(message) {
+			break
+		}
+	}
+	return events, nil
+}
+
+// parseTrackEvent returns the event at the start of b, without its delta time, along with the number of bytes it occupies.
+// Channel Pressure events are read with the legacy three-byte layout when legacy is true.
+func parseTrackEvent(b []byte, runningStatus byte, legacy bool) (midiv1.MessageMarshaler, int, error) {
+	status := b[0]
+	switch {
+	case status == MetaEventStatus:
+		eventType, _, n, err := parseMetaEvent(b)
+		if err != nil {
+			return nil, 0, err
+		}
+		event, err := newMetaEvent(eventType, b[:n])
+		if err != nil {
+			return nil, 0, err
+		}
+		return event, n, nil
+	case status == SystemExclusiveEventStatus || status == EscapeEventStatus:
+		data, n, err := parseSystemExclusiveEvent(b)
+		if err != nil {
+			return nil, 0, err
+		}
+		return &SystemExclusiveEvent{
+			Status: status,
+			Data:   append([]byte(nil), data...),
+		}, n, nil
+	case midiv1.ByteHasStatusMSB(status):
+		if midiv1.ParseStatusFromStatusByte(status) == midiv1.SystemMessageStatusNibble {
+			return nil, 0, fmt.Errorf("status byte %#x is not allowed within a track", status)
+		}
+		length, err := channelEventLength(status, legacy)
+		if err != nil {
+			return nil, 0, err
+		}
+		if len(b) < length {
+			return nil, 0, fmt.Errorf("channel event is made up of %d bytes, received %d byte(s)", length, len(b))
+		}
+		if isLegacyChannelPressure(status, legacy) {
+			message := &midiv1.ChannelPressureMessage{}
+			if err := message.UnmarshalLegacyMIDI(b[:length]); err != nil {
+				return nil, 0, err
+			}
+			return message, length, nil
+		}
+		message, err := midiv1.Unmarshal(b[:length])
+		if err != nil {
+			if cc, ok := unmarshalChannelModeAsControlChange(b[:length]); ok {
+				return cc, length, nil
+			}
+			return nil, 0, err
+		}
+		return toMarshaler(message), length, nil
+	}
+
+	// data bytes continue the running status of the previous channel event
+	if runningStatus == 0 {
+		return nil, 0, fmt.Errorf("data byte %#x has no running status to apply to", status)
+	}
+	length, err := channelEventLength(runningStatus, legacy)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(b) < length-1 {
+		return nil, 0, fmt.Errorf("running status channel event is made up of %d bytes, received %d byte(s)", length-1, len(b))
+	}
+	if isLegacyChannelPressure(runningStatus, legacy) {
+		message := &midiv1.ChannelPressureMessage{}
+		if err := message.UnmarshalLegacyMIDI(append([]byte{runningStatus}, b[:length-1]...)); err != nil {
+			return nil, 0, err
+		}
+		return message, length - 1, nil
+	}
+	message, err := midiv1.UnmarshalRunningStatus(runningStatus, b[:length-1])
+	if err != nil {
+		if cc, ok := unmarshalChannelModeAsControlChange(append([]byte{runningStatus}, b[:length-1]...)); ok {
+			return cc, length - 1, nil
+		}
+		return nil, 0, err
+	}
+	return toMarshaler(message), length - 1, nil
+}
+
+// unmarshalChannelModeAsControlChange unmarshalls the bytes of a Channel Mode event whose value its type rejects, such as
+// an All Notes Off event with a non-zero value, into a plain *midiv1.ControlChangeMessage. It returns false when the bytes
+// are not a well-formed Control Change event for a Channel Mode controller number.
+func unmarshalChannelModeAsControlChange(b []byte) (*midiv1.ControlChangeMessage, bool) {
+	if len(b) != midiv1.ControlChangeMessageLength || midiv1.ParseStatusFromStatusByte(b[0]) != midiv1.ControlChangeMessageStatusNibble || !midiv1.Controller(b[1]).IsChannelMode() {
+		return nil, false
+	}
+	cc := &midiv1.ControlChangeMessage{}
+	if err := cc.UnmarshalMIDI(b); err != nil {
+		return nil, false
+	}
+	return cc, true
+}
+
+// channelEventLength returns the number of bytes in a channel event that begins with the supplied status byte.
+func channelEventLength(status byte, legacy bool) (int, error) {
+	if isLegacyChannelPressure(status, legacy) {
+		return midiv1.LegacyChannelPressureMessageLength, nil
+	}
+	return midiv1.MessageLength(status)
+}
+
+// isLegacyChannelPressure returns whether the status byte begins a Channel Pressure event in the legacy layout.
+func isLegacyChannelPressure(status byte, legacy bool) bool {
+	return legacy && midiv1.ParseStatusFromStatusByte(status) == midiv1.ChannelPressureMessageStatusNibble
+}
+
+// toMarshaler returns the midiv1 message as a MessageMarshaler. Every message type returned by the midiv1 unmarshal
+// functions is a pointer that implements MessageMarshaler.
+func toMarshaler(message midiv1.Message) midiv1.MessageMarshaler {
+	return message.(midiv1.MessageMarshaler)
+}
+
+// isEndOfTrack returns whether the message is an End of Track meta event.
+func isEndOfTrack(message midiv1.MessageMarshaler) bool {
+	switch me := message.(type) {
+	case *EndOfTrackMetaEvent:
+		return true
+	case *MetaEvent:
+		return me.Type == EndOfTrackMetaEventType
+	}
+	return false
+}
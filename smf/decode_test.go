@@ -0,0 +1,270 @@
+package smf
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+// chunk returns a chunk with the supplied type and data.
+func chunk(chunkType string, data ...byte) []byte {
+	length := len(data)
+	b := append([]byte(chunkType), byte(length>>24), byte(length>>16), byte(length>>8), byte(length))
+	return append(b, data...)
+}
+
+func Test_File_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b            []byte
+		expectedFile File
+		err          error
+	}{
+		"byte slice is too short": {
+			b:   []byte("MThd"),
+			err: ErrUnmarshallingFile,
+		},
+		"first chunk is not a header": {
+			b:   chunk("MTrk", 0x00, 0x00, 0x00, 0x01, 0x00, 0x60),
+			err: ErrUnmarshallingFile,
+		},
+		"format 0 file declares more than one track": {
+			b:   chunk("MThd", 0x00, 0x00, 0x00, 0x02, 0x00, 0x60),
+			err: ErrUnmarshallingFile,
+		},
+		"file has fewer tracks than declared": {
+			b: bytes.Join([][]byte{
+				chunk("MThd", 0x00, 0x01, 0x00, 0x02, 0x00, 0x60),
+				chunk("MTrk", 0x00, 0xFF, 0x2F, 0x00),
+			}, nil),
+			err: ErrUnmarshallingFile,
+		},
+		"track uses running status without a status byte": {
+			b: bytes.Join([][]byte{
+				chunk("MThd", 0x00, 0x00, 0x00, 0x01, 0x00, 0x60),
+				chunk("MTrk", 0x00, 0x40, 0x40, 0x00, 0xFF, 0x2F, 0x00),
+			}, nil),
+			err: ErrUnmarshallingFile,
+		},
+		"track contains a system real-time status byte": {
+			b: bytes.Join([][]byte{
+				chunk("MThd", 0x00, 0x00, 0x00, 0x01, 0x00, 0x60),
+				chunk("MTrk", 0x00, 0xF8, 0x00, 0xFF, 0x2F, 0x00),
+			}, nil),
+			err: ErrUnmarshallingFile,
+		},
+		"format 0 file unmarshals into expected file": {
+			b: bytes.Join([][]byte{
+				chunk("MThd", 0x00, 0x00, 0x00, 0x01, 0x00, 0x60),
+				chunk("MTrk",
+					0x00, 0xFF, 0x03, 0x04, 'L', 'e', 'a', 'd',
+					0x00, 0xC1, 0x05,
+					0x00, 0x91, 0x3C, 0x64,
+					0x60, 0x3C, 0x00,
+					0x00, 0xF0, 0x03, 0x43, 0x12, 0xF7,
+					0x00, 0xFF, 0x2F, 0x00,
+				),
+			}, nil),
+			expectedFile: File{
+				Format:   SingleTrackFormat,
+				Division: 96,
+				Tracks: []Track{
+					{
+						Events: []Event{
+							{DeltaTime: 0, Message: &TrackNameMetaEvent{Text: "Lead"}},
+							{DeltaTime: 0, Message: &midiv1.ProgramChangeMessage{Channel: 1, Program: 5}},
+							{DeltaTime: 0, Message: &midiv1.NoteOnMessage{Channel: 1, Note: 60, Velocity: 100}},
+							{DeltaTime: 96, Message: &midiv1.NoteOnMessage{Channel: 1, Note: 60, Velocity: 0}},
+							{DeltaTime: 0, Message: &SystemExclusiveEvent{Status: SystemExclusiveEventStatus, Data: []byte{0x43, 0x12, 0xF7}}},
+							{DeltaTime: 0, Message: &EndOfTrackMetaEvent{}},
+						},
+					},
+				},
+			},
+		},
+		"format 1 file skips unknown chunks": {
+			b: bytes.Join([][]byte{
+				chunk("MThd", 0x00, 0x01, 0x00, 0x02, 0xE7, 0x28),
+				chunk("MTrk", 0x00, 0xFF, 0x2F, 0x00),
+				chunk("XFIH", 0x01, 0x02),
+				chunk("MTrk", 0x83, 0x60, 0x80, 0x3C, 0x40, 0x00, 0xFF, 0x2F, 0x00),
+			}, nil),
+			expectedFile: File{
+				Format:   MultiTrackFormat,
+				Division: 0xE728,
+				Tracks: []Track{
+					{
+						Events: []Event{
+							{DeltaTime: 0, Message: &EndOfTrackMetaEvent{}},
+						},
+					},
+					{
+						Events: []Event{
+							{DeltaTime: 480, Message: &midiv1.NoteOffMessage{Channel: 0, Note: 60, Velocity: 64}},
+							{DeltaTime: 0, Message: &EndOfTrackMetaEvent{}},
+						},
+					},
+				},
+			},
+		},
+		"meta events cancel running status": {
+			b: bytes.Join([][]byte{
+				chunk("MThd", 0x00, 0x00, 0x00, 0x01, 0x00, 0x60),
+				chunk("MTrk", 0x00, 0x90, 0x3C, 0x64, 0x00, 0xFF, 0x01, 0x00, 0x00, 0x3C, 0x00, 0x00, 0xFF, 0x2F, 0x00),
+			}, nil),
+			err: ErrUnmarshallingFile,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got File
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil {
+				if err == nil {
+					t.Fatalf("expected non-nil %v error, got nil error", test.err)
+				}
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+			}
+			if !reflect.DeepEqual(test.expectedFile, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedFile, got)
+			}
+		})
+	}
+}
+
+func Test_Track_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b             []byte
+		expectedTrack Track
+		err           error
+	}{
+		"chunk is not a track": {
+			b:   chunk("MThd", 0x00, 0xFF, 0x2F, 0x00),
+			err: ErrUnmarshallingFile,
+		},
+		"chunk has trailing bytes": {
+			b:   append(chunk("MTrk", 0x00, 0xFF, 0x2F, 0x00), 0x00),
+			err: ErrUnmarshallingFile,
+		},
+		"events after end of track are ignored": {
+			b: chunk("MTrk", 0x00, 0xFF, 0x2F, 0x00, 0x00, 0x90, 0x3C, 0x64),
+			expectedTrack: Track{
+				Events: []Event{
+					{DeltaTime: 0, Message: &EndOfTrackMetaEvent{}},
+				},
+			},
+		},
+		"channel pressure events are two bytes": {
+			b: chunk("MTrk", 0x00, 0xD1, 0x20, 0x10, 0x21, 0x00, 0xFF, 0x2F, 0x00),
+			expectedTrack: Track{
+				Events: []Event{
+					{DeltaTime: 0, Message: &midiv1.ChannelPressureMessage{Channel: 1, Pressure: 32}},
+					{DeltaTime: 16, Message: &midiv1.ChannelPressureMessage{Channel: 1, Pressure: 33}},
+					{DeltaTime: 0, Message: &EndOfTrackMetaEvent{}},
+				},
+			},
+		},
+		"channel mode events with a rejected value are control change events": {
+			b: chunk("MTrk", 0x00, 0xB0, 0x7B, 0x7F, 0x00, 0x78, 0x01, 0x00, 0x7B, 0x00, 0x00, 0xFF, 0x2F, 0x00),
+			expectedTrack: Track{
+				Events: []Event{
+					{DeltaTime: 0, Message: &midiv1.ControlChangeMessage{Channel: 0, Controller: midiv1.AllNotesOffController, Value: 127}},
+					{DeltaTime: 0, Message: &midiv1.ControlChangeMessage{Channel: 0, Controller: midiv1.AllSoundOffController, Value: 1}},
+					{DeltaTime: 0, Message: &midiv1.AllNotesOffMessage{Channel: 0}},
+					{DeltaTime: 0, Message: &EndOfTrackMetaEvent{}},
+				},
+			},
+		},
+		"legacy channel pressure events are read with the standard layout": {
+			b: chunk("MTrk", 0x00, 0xD1, 0x3C, 0x20, 0x00, 0xFF, 0x2F, 0x00),
+			expectedTrack: Track{
+				Events: []Event{
+					{DeltaTime: 0, Message: &midiv1.ChannelPressureMessage{Channel: 1, Pressure: 60}},
+					{DeltaTime: 32, Message: &midiv1.ChannelPressureMessage{Channel: 1, Pressure: 0}},
+					{DeltaTime: 0x3FAF, Message: &midiv1.ChannelPressureMessage{Channel: 1, Pressure: 0}},
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got Track
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedTrack, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedTrack, got)
+			}
+		})
+	}
+}
+
+func Test_Track_UnmarshalLegacyMIDI(t *testing.T) {
+	t.Parallel()
+	// these events also happen to parse with the standard layout, so only an explicit legacy read gets them right
+	b := chunk("MTrk", 0x00, 0xD1, 0x40, 0x20, 0x10, 0x40, 0x21, 0x00, 0xFF, 0x2F, 0x00)
+	expected := Track{
+		Events: []Event{
+			{DeltaTime: 0, Message: &midiv1.ChannelPressureMessage{Channel: 1, Pressure: 32}},
+			{DeltaTime: 16, Message: &midiv1.ChannelPressureMessage{Channel: 1, Pressure: 33}},
+			{DeltaTime: 0, Message: &EndOfTrackMetaEvent{}},
+		},
+	}
+
+	var got Track
+	if err := (&got).UnmarshalLegacyMIDI(b); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if !reflect.DeepEqual(expected, got) {
+		t.Fatalf("expected %+v, got %+v", expected, got)
+	}
+}
+
+func Test_ReadLegacy(t *testing.T) {
+	t.Parallel()
+	b := bytes.Join([][]byte{
+		chunk("MThd", 0x00, 0x00, 0x00, 0x01, 0x01, 0xE0),
+		chunk("MTrk", 0x00, 0xD1, 0x3C, 0x20, 0x00, 0xFF, 0x2F, 0x00),
+	}, nil)
+	expected := []Event{
+		{DeltaTime: 0, Message: &midiv1.ChannelPressureMessage{Channel: 1, Pressure: 32}},
+		{DeltaTime: 0, Message: &EndOfTrackMetaEvent{}},
+	}
+	got, err := ReadLegacy(bytes.NewReader(b))
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if len(got.Tracks) != 1 || !reflect.DeepEqual(expected, got.Tracks[0].Events) {
+		t.Fatalf("expected %+v, got %+v", expected, got.Tracks)
+	}
+}
+
+func Test_Read(t *testing.T) {
+	t.Parallel()
+	b := bytes.Join([][]byte{
+		chunk("MThd", 0x00, 0x02, 0x00, 0x01, 0x01, 0xE0),
+		chunk("MTrk", 0x00, 0xFF, 0x2F, 0x00),
+	}, nil)
+	got, err := Read(bytes.NewReader(b))
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if got.Format != MultiSequenceFormat || got.Division.TicksPerQuarterNote() != 480 || len(got.Tracks) != 1 {
+		t.Fatalf("unexpected file %+v", got)
+	}
+}
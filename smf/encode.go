@@ -0,0 +1,186 @@
+package smf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+// Write marshalls the Standard MIDI File and writes it to w.
+func Write(w io.Writer, f *File) error {
+	b, err := f.MarshalMIDI()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// WriteFile marshalls the Standard MIDI File and writes it to the named file, creating or truncating it.
+func WriteFile(name string, f *File) error {
+	b, err := f.MarshalMIDI()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(name, b, 0o644)
+}
+
+// MarshalMIDI marshalls a File into the raw bytes of a Standard MIDI File: an MThd header chunk followed by one MTrk
+// chunk per track. Format 0 files must contain exactly one track.
+func (f File) MarshalMIDI() ([]byte, error) {
+	if f.Format > MultiSequenceFormat {
+		return nil, fmt.Errorf("unsupported standard MIDI file format %d: %w", f.Format, ErrMarshallingFile)
+	}
+	if f.Format == SingleTrackFormat && len(f.Tracks) != 1 {
+		return nil, fmt.Errorf("format 0 files must contain exactly 1 track, received %d: %w", len(f.Tracks), ErrMarshallingFile)
+	}
+	if len(f.Tracks) > 0xFFFF {
+		return nil, fmt.Errorf("files may contain at most %d tracks, received %d: %w", 0xFFFF, len(f.Tracks), ErrMarshallingFile)
+	}
+	if f.Division == 0 || (f.Division.IsSMPTE() && f.Division.TicksPerFrame() == 0) {
+		return nil, fmt.Errorf("division %#x is not valid: %w", uint16(f.Division), ErrMarshallingFile)
+	}
+
+	header := make([]byte, HeaderChunkLength)
+	binary.BigEndian.PutUint16(header[0:2], uint16(f.Format))
+	binary.BigEndian.PutUint16(header[2:4], uint16(len(f.Tracks)))
+	binary.BigEndian.PutUint16(header[4:6], uint16(f.Division))
+
+	var buf bytes.Buffer
+	buf.Write(appendChunk(nil, HeaderChunkType, header))
+	for i, track := range f.Tracks {
+		b, err := track.MarshalMIDI()
+		if err != nil {
+			return nil, fmt.Errorf("track %d: %w", i, err)
+		}
+		buf.Write(b)
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalMIDI marshalls a Track into the raw bytes of an MTrk chunk, including its chunk type and length.
+//
+// Channel events that share the status byte of the previous channel event are written with running status, and an
+// End of Track meta event is appended when the last event is not already one. Messages carried by several Control
+// Change messages, such as High Resolution Control Change messages, are written as one event per Control Change, with
+// the delta time of the event on the first.
+func (t Track) MarshalMIDI() ([]byte, error) {
+	var data []byte
+	var runningStatus byte
+	endOfTrack := false
+	for i, event := range t.Events {
+		if endOfTrack {
+			return nil, fmt.Errorf("event %d follows the end of track meta event: %w", i, ErrMarshallingFile)
+		}
+		if event.Message == nil {
+			return nil, fmt.Errorf("event %d has no message: %w", i, ErrMarshallingFile)
+		}
+
+		events, err := expandEvent(event)
+		if err != nil {
+			return nil, fmt.Errorf("event %d: %v: %w", i, err, ErrMarshallingFile)
+		}
+		for _, e := range events {
+			data, err = AppendVariableLengthQuantity(data, e.DeltaTime)
+			if err != nil {
+				return nil, fmt.Errorf("event %d has an invalid delta time (%v): %w", i, err, ErrMarshallingFile)
+			}
+			b, err := marshalTrackEvent(e.Message, runningStatus)
+			if err != nil {
+				return nil, fmt.Errorf("event %d: %v: %w", i, err, ErrMarshallingFile)
+			}
+			data = append(data, b...)
+
+			// file and System Exclusive events cancel running status, while channel events replace it
+			if _, ok := e.Message.(fileEvent); ok {
+				runningStatus = 0
+				endOfTrack = b[0] == MetaEventStatus && MetaEventType(b[1]) == EndOfTrackMetaEventType
+			} else if b[0] == SystemExclusiveEventStatus {
+				runningStatus = 0
+			} else if midiv1.ByteHasStatusMSB(b[0]) {
+				runningStatus = b[0]
+			}
+		}
+	}
+
+	if !endOfTrack {
+		data = append(data, 0x00, MetaEventStatus, byte(EndOfTrackMetaEventType), 0x00)
+	}
+	return appendChunk(nil, TrackChunkType, data), nil
+}
+
+// marshalTrackEvent returns the track bytes of an event message, without its delta time. Channel messages whose status
+// byte matches the running status are marshalled with MarshalRunningStatusMIDI, and midiv1 System Exclusive messages
+// are converted into 0xF0 System Exclusive events.
+func marshalTrackEvent(message midiv1.MessageMarshaler, runningStatus byte) ([]byte, error) {
+	b, err := message.MarshalMIDI()
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, fmt.Errorf("message marshalled into no bytes")
+	}
+	if _, ok := message.(fileEvent); ok {
+		return b, nil
+	}
+
+	// System Exclusive messages are stored as System Exclusive events holding everything after their 0xF0 status byte
+	if b[0] == midiv1.SystemExclusiveMessageStatus {
+		return SystemExclusiveEvent{Status: SystemExclusiveEventStatus, Data: b[1:]}.MarshalMIDI()
+	}
+
+	// only channel messages may be stored directly within a track
+	if !midiv1.ByteHasStatusMSB(b[0]) || midiv1.ParseStatusFromStatusByte(b[0]) == midiv1.SystemMessageStatusNibble {
+		return nil, fmt.Errorf("status byte %#x is not allowed within a track", b[0])
+	}
+	// each event holds exactly one channel message, so a reader can tell where the next delta time starts
+	if length, err := midiv1.MessageLength(b[0]); err != nil || len(b) != length {
+		return nil, fmt.Errorf("message with status byte %#x marshalled into %d bytes, which is not a single channel message", b[0], len(b))
+	}
+	if b[0] == runningStatus {
+		if rsm, ok := message.(midiv1.RunningStatusMessageMarshaler); ok {
+			return rsm.MarshalRunningStatusMIDI()
+		}
+	}
+	return b, nil
+}
+
+// controlChangeSequence represents a message that is carried by several Control Change messages, such as a High
+// Resolution Control Change or a Registered Parameter Number message.
+type controlChangeSequence interface {
+	ControlChanges() ([]midiv1.ControlChangeMessage, error)
+}
+
+// expandEvent returns the track events that carry an event. Control Change sequences become one event per Control Change
+// with the delta time of the event on the first, while other events are returned as they are.
+func expandEvent(event Event) ([]Event, error) {
+	sequence, ok := event.Message.(controlChangeSequence)
+	if !ok {
+		return []Event{event}, nil
+	}
+	ccms, err := sequence.ControlChanges()
+	if err != nil {
+		return nil, err
+	}
+	events := make([]Event, len(ccms))
+	for i := range ccms {
+		events[i] = Event{Message: &ccms[i]}
+	}
+	if len(events) > 0 {
+		events[0].DeltaTime = event.DeltaTime
+	}
+	return events, nil
+}
+
+// appendChunk appends a chunk with the supplied type and data to b.
+func appendChunk(b []byte, chunkType string, data []byte) []byte {
+	b = append(b, chunkType...)
+	length := make([]byte, 4)
+	binary.BigEndian.PutUint32(length, uint32(len(data)))
+	b = append(b, length...)
+	return append(b, data...)
+}
@@ -0,0 +1,273 @@
+package smf
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+func Test_File_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		file     File
+		expected []byte
+		err      error
+	}{
+		"format 0 file has more than one track": {
+			file: File{
+				Format:   SingleTrackFormat,
+				Division: 96,
+				Tracks:   []Track{{}, {}},
+			},
+			err: ErrMarshallingFile,
+		},
+		"unsupported format": {
+			file: File{
+				Format:   3,
+				Division: 96,
+				Tracks:   []Track{{}},
+			},
+			err: ErrMarshallingFile,
+		},
+		"division is zero": {
+			file: File{
+				Format: SingleTrackFormat,
+				Tracks: []Track{{}},
+			},
+			err: ErrMarshallingFile,
+		},
+		"track contains a system real-time message": {
+			file: File{
+				Format:   SingleTrackFormat,
+				Division: 96,
+				Tracks: []Track{
+					{Events: []Event{{Message: midiv1.RawMessage{Bytes: []byte{0xF8}}}}},
+				},
+			},
+			err: ErrMarshallingFile,
+		},
+		"channel event marshals into more than one message": {
+			file: File{
+				Format:   SingleTrackFormat,
+				Division: 96,
+				Tracks: []Track{
+					{Events: []Event{{Message: midiv1.RawMessage{Bytes: []byte{0xB0, 0x07, 0x64, 0xB0, 0x27, 0x05}}}}},
+				},
+			},
+			err: ErrMarshallingFile,
+		},
+		"event follows end of track": {
+			file: File{
+				Format:   SingleTrackFormat,
+				Division: 96,
+				Tracks: []Track{
+					{
+						Events: []Event{
+							{Message: MetaEvent{Type: EndOfTrackMetaEventType}},
+							{Message: midiv1.NoteOnMessage{Channel: 1, Note: 60, Velocity: 100}},
+						},
+					},
+				},
+			},
+			err: ErrMarshallingFile,
+		},
+		"format 0 file uses running status and appends end of track": {
+			file: File{
+				Format:   SingleTrackFormat,
+				Division: 96,
+				Tracks: []Track{
+					{
+						Events: []Event{
+							{DeltaTime: 0, Message: midiv1.NoteOnMessage{Channel: 1, Note: 60, Velocity: 100}},
+							{DeltaTime: 96, Message: midiv1.NoteOnMessage{Channel: 1, Note: 60, Velocity: 0}},
+							{DeltaTime: 0, Message: MetaEvent{Type: 0x01, Data: []byte("Hi")}},
+							{DeltaTime: 200, Message: &midiv1.NoteOnMessage{Channel: 1, Note: 62, Velocity: 100}},
+						},
+					},
+				},
+			},
+			expected: bytes.Join([][]byte{
+				chunk("MThd", 0x00, 0x00, 0x00, 0x01, 0x00, 0x60),
+				chunk("MTrk",
+					0x00, 0x91, 0x3C, 0x64,
+					0x60, 0x3C, 0x00,
+					0x00, 0xFF, 0x01, 0x02, 'H', 'i',
+					0x81, 0x48, 0x91, 0x3E, 0x64,
+					0x00, 0xFF, 0x2F, 0x00,
+				),
+			}, nil),
+		},
+		"format 1 file keeps an existing end of track": {
+			file: File{
+				Format:   MultiTrackFormat,
+				Division: 480,
+				Tracks: []Track{
+					{
+						Events: []Event{
+							{DeltaTime: 10, Message: MetaEvent{Type: EndOfTrackMetaEventType}},
+						},
+					},
+					{},
+				},
+			},
+			expected: bytes.Join([][]byte{
+				chunk("MThd", 0x00, 0x01, 0x00, 0x02, 0x01, 0xE0),
+				chunk("MTrk", 0x0A, 0xFF, 0x2F, 0x00),
+				chunk("MTrk", 0x00, 0xFF, 0x2F, 0x00),
+			}, nil),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.file.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_Write_RoundTrip(t *testing.T) {
+	t.Parallel()
+	var track Track
+	track.AddEvent(0, &TrackNameMetaEvent{Text: "Lead"})
+	track.AddEvent(0, &SetTempoMetaEvent{MicrosecondsPerQuarterNote: DefaultMicrosecondsPerQuarterNote})
+	track.AddEvent(0, &TimeSignatureMetaEvent{Numerator: 6, Denominator: 8, ClocksPerClick: 36, ThirtySecondNotesPerQuarterNote: 8})
+	track.AddEvent(0, &KeySignatureMetaEvent{Sharps: -3, Minor: true})
+	track.AddEvent(0, &midiv1.ProgramChangeMessage{Channel: 2, Program: 10})
+	track.AddEvent(0, &midiv1.NoteOnMessage{Channel: 2, Note: 60, Velocity: 100})
+	track.AddEvent(48, &midiv1.NoteOffMessage{Channel: 2, Note: 60, Velocity: 0})
+	track.AddEvent(0, &SystemExclusiveEvent{Status: SystemExclusiveEventStatus, Data: []byte{0x7E, 0x7F, 0x09, 0x01, 0xF7}})
+	track.AddEvent(0, &EndOfTrackMetaEvent{})
+	expected := &File{
+		Format:   MultiTrackFormat,
+		Division: 96,
+		Tracks:   []Track{track},
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, expected); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	got, err := Read(&buf)
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if !reflect.DeepEqual(expected, got) {
+		t.Fatalf("expected %+v, got %+v", expected, got)
+	}
+}
+
+func Test_Write_RoundTrip_ControlChangeSequences(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		message  midiv1.MessageMarshaler
+		expected []Event
+	}{
+		"high resolution control change is written as its MSB and LSB": {
+			message: &midiv1.HighResolutionControlChangeMessage{Channel: 0, Controller: midiv1.ChannelVolumeMSBController, Value: midiv1.NewFourteenBitValueFromBytes(0x64, 0x05)},
+			expected: []Event{
+				{DeltaTime: 10, Message: &midiv1.ControlChangeMessage{Channel: 0, Controller: midiv1.ChannelVolumeMSBController, Value: 0x64}},
+				{DeltaTime: 0, Message: &midiv1.ControlChangeMessage{Channel: 0, Controller: midiv1.ChannelVolumeLSBController, Value: 0x05}},
+			},
+		},
+		"registered parameter number is written as its Control Change sequence": {
+			message: &midiv1.RegisteredParameterNumberMessage{Channel: 0, Parameter: midiv1.PitchBendSensitivityParameter, Value: midiv1.NewFourteenBitValueFromBytes(12, 0)},
+			expected: []Event{
+				{DeltaTime: 10, Message: &midiv1.ControlChangeMessage{Channel: 0, Controller: midiv1.RegisteredParameterNumberMSBController, Value: 0x00}},
+				{DeltaTime: 0, Message: &midiv1.ControlChangeMessage{Channel: 0, Controller: midiv1.RegisteredParameterNumberLSBController, Value: 0x00}},
+				{DeltaTime: 0, Message: &midiv1.ControlChangeMessage{Channel: 0, Controller: midiv1.DataEntryMSBController, Value: 0x0C}},
+				{DeltaTime: 0, Message: &midiv1.ControlChangeMessage{Channel: 0, Controller: midiv1.DataEntryLSBController, Value: 0x00}},
+				{DeltaTime: 0, Message: &midiv1.ControlChangeMessage{Channel: 0, Controller: midiv1.RegisteredParameterNumberMSBController, Value: 0x7F}},
+				{DeltaTime: 0, Message: &midiv1.ControlChangeMessage{Channel: 0, Controller: midiv1.RegisteredParameterNumberLSBController, Value: 0x7F}},
+			},
+		},
+		"non-registered parameter number is written as its Control Change sequence": {
+			message: midiv1.NonRegisteredParameterNumberMessage{Channel: 0, Parameter: midiv1.NewFourteenBitValueFromBytes(0x01, 0x08), Value: midiv1.NewFourteenBitValueFromBytes(0x40, 0), MSBOnly: true, SkipNull: true},
+			expected: []Event{
+				{DeltaTime: 10, Message: &midiv1.ControlChangeMessage{Channel: 0, Controller: midiv1.NonRegisteredParameterNumberMSBController, Value: 0x01}},
+				{DeltaTime: 0, Message: &midiv1.ControlChangeMessage{Channel: 0, Controller: midiv1.NonRegisteredParameterNumberLSBController, Value: 0x08}},
+				{DeltaTime: 0, Message: &midiv1.ControlChangeMessage{Channel: 0, Controller: midiv1.DataEntryMSBController, Value: 0x40}},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var track Track
+			track.AddEvent(10, test.message)
+			track.AddEvent(0, &midiv1.NoteOnMessage{Channel: 0, Note: 60, Velocity: 100})
+
+			var buf bytes.Buffer
+			if err := Write(&buf, &File{Format: SingleTrackFormat, Division: 96, Tracks: []Track{track}}); err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			got, err := Read(&buf)
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			expected := append(test.expected,
+				Event{DeltaTime: 0, Message: &midiv1.NoteOnMessage{Channel: 0, Note: 60, Velocity: 100}},
+				Event{DeltaTime: 0, Message: &EndOfTrackMetaEvent{}},
+			)
+			if !reflect.DeepEqual(expected, got.Tracks[0].Events) {
+				t.Fatalf("expected %+v, got %+v", expected, got.Tracks[0].Events)
+			}
+		})
+	}
+}
+
+func Test_Write_RoundTrip_SystemExclusiveMessages(t *testing.T) {
+	t.Parallel()
+	var track Track
+	track.AddEvent(0, &midiv1.NoteOnMessage{Channel: 0, Note: 60, Velocity: 100})
+	track.AddEvent(0, &midiv1.SystemExclusiveMessage{Manufacturer: midiv1.RolandManufacturerID, Data: []byte{0x10, 0x42}})
+	track.AddEvent(0, &midiv1.GeneralMIDISystemOnMessage{DeviceID: midiv1.AllCallDeviceID})
+	track.AddEvent(0, &midiv1.NoteOnMessage{Channel: 0, Note: 64, Velocity: 100})
+	file := &File{Format: SingleTrackFormat, Division: 96, Tracks: []Track{track}}
+
+	b, err := track.MarshalMIDI()
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	expectedBytes := []byte{
+		'M', 'T', 'r', 'k', 0x00, 0x00, 0x00, 0x1B,
+		0x00, 0x90, 0x3C, 0x64,
+		0x00, 0xF0, 0x04, 0x41, 0x10, 0x42, 0xF7,
+		0x00, 0xF0, 0x05, 0x7E, 0x7F, 0x09, 0x01, 0xF7,
+		0x00, 0x90, 0x40, 0x64,
+		0x00, 0xFF, 0x2F, 0x00,
+	}
+	if !bytes.Equal(expectedBytes, b) {
+		t.Fatalf("expected %#v, got %#v", expectedBytes, b)
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, file); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	got, err := Read(&buf)
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	expected := []Event{
+		{Message: &midiv1.NoteOnMessage{Channel: 0, Note: 60, Velocity: 100}},
+		{Message: &SystemExclusiveEvent{Status: SystemExclusiveEventStatus, Data: []byte{0x41, 0x10, 0x42, 0xF7}}},
+		{Message: &SystemExclusiveEvent{Status: SystemExclusiveEventStatus, Data: []byte{0x7E, 0x7F, 0x09, 0x01, 0xF7}}},
+		{Message: &midiv1.NoteOnMessage{Channel: 0, Note: 64, Velocity: 100}},
+		{Message: &EndOfTrackMetaEvent{}},
+	}
+	if !reflect.DeepEqual(expected, got.Tracks[0].Events) {
+		t.Fatalf("expected %+v, got %+v", expected, got.Tracks[0].Events)
+	}
+}
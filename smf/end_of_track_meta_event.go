@@ -0,0 +1,35 @@
+package smf
+
+import "fmt"
+
+// EndOfTrackMetaEvent represents an End of Track meta event, which must be the last event of every track.
+type EndOfTrackMetaEvent struct{}
+
+// GetMessageName returns the name of this End of Track meta event.
+func (eotme *EndOfTrackMetaEvent) GetMessageName() string {
+	return "End of Track"
+}
+
+// MarshalMIDI marshalls an EndOfTrackMetaEvent into its raw track bytes.
+func (eotme EndOfTrackMetaEvent) MarshalMIDI() ([]byte, error) {
+	return marshalMetaEvent(EndOfTrackMetaEventType, nil)
+}
+
+// isFileEvent marks End of Track meta events as events that are written to tracks as-is.
+func (eotme EndOfTrackMetaEvent) isFileEvent() {}
+
+// String returns the human-readable representation of the meta event.
+func (eotme *EndOfTrackMetaEvent) String() string {
+	return fmt.Sprintf("%s:%s", EventVersion, eotme.GetMessageName())
+}
+
+// UnmarshalMIDI unmarshalls raw track bytes into an EndOfTrackMetaEvent struct pointer. End of Track meta events are
+// represented by three bytes: 0xFF, 0x2F, 0x00.
+func (eotme *EndOfTrackMetaEvent) UnmarshalMIDI(b []byte) error {
+	if _, err := unmarshalMetaEvent("end of track", EndOfTrackMetaEventType, 0, b); err != nil {
+		return err
+	}
+
+	*eotme = EndOfTrackMetaEvent{}
+	return nil
+}
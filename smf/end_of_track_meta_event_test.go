@@ -0,0 +1,84 @@
+package smf
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_EndOfTrackMetaEvent_GetMessageName(t *testing.T) {
+	t.Parallel()
+	event := EndOfTrackMetaEvent{}
+	expected := "End of Track"
+	if event.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, event.GetMessageName())
+	}
+}
+
+func Test_EndOfTrackMetaEvent_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		event    EndOfTrackMetaEvent
+		expected []byte
+		err      error
+	}{
+		"event marshalls into expected bytes": {
+			event:    EndOfTrackMetaEvent{},
+			expected: []byte{0xFF, 0x2F, 0x00},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.event.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_EndOfTrackMetaEvent_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b             []byte
+		expectedEvent EndOfTrackMetaEvent
+		err           error
+	}{
+		"event has data": {
+			b:   []byte{0xFF, 0x2F, 0x01, 0x00},
+			err: ErrUnmarshallingFile,
+		},
+		"type is not end of track": {
+			b:   []byte{0xFF, 0x01, 0x00},
+			err: ErrUnmarshallingFile,
+		},
+		"bytes unmarshal into expected event": {
+			b:             []byte{0xFF, 0x2F, 0x00},
+			expectedEvent: EndOfTrackMetaEvent{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got EndOfTrackMetaEvent
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedEvent, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedEvent, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package smf
+
+import "fmt"
+
+const (
+	// MinKeySignatureSharps represents the lowest key signature value (7 flats).
+	MinKeySignatureSharps int = -7
+
+	// MaxKeySignatureSharps represents the highest key signature value (7 sharps).
+	MaxKeySignatureSharps int = 7
+)
+
+// KeySignatureMetaEvent represents a Key Signature meta event.
+type KeySignatureMetaEvent struct {
+	// Sharps represents the number of sharps in the key signature, with negative values representing flats. Valid values
+	// are between -7 and 7 inclusive.
+	Sharps int
+
+	// Minor represents whether the key is minor rather than major.
+	Minor bool
+}
+
+// GetMessageName returns the name of this Key Signature meta event.
+func (ksme *KeySignatureMetaEvent) GetMessageName() string {
+	return "Key Signature"
+}
+
+// MarshalMIDI marshalls a KeySignatureMetaEvent into its raw track bytes.
+func (ksme KeySignatureMetaEvent) MarshalMIDI() ([]byte, error) {
+	if ksme.Sharps < MinKeySignatureSharps || ksme.Sharps > MaxKeySignatureSharps {
+		return nil, fmt.Errorf("key signatures must have between %d and %d sharps, inclusive, received %d: %w", MinKeySignatureSharps, MaxKeySignatureSharps, ksme.Sharps, ErrMarshallingFile)
+	}
+	var minor byte
+	if ksme.Minor {
+		minor = 1
+	}
+	return marshalMetaEvent(KeySignatureMetaEventType, []byte{byte(int8(ksme.Sharps)), minor})
+}
+
+// isFileEvent marks Key Signature meta events as events that are written to tracks as-is.
+func (ksme KeySignatureMetaEvent) isFileEvent() {}
+
+// String returns the human-readable representation of the meta event.
+func (ksme *KeySignatureMetaEvent) String() string {
+	return fmt.Sprintf("%s:%s:%d:%t", EventVersion, ksme.GetMessageName(), ksme.Sharps, ksme.Minor)
+}
+
+// UnmarshalMIDI unmarshalls raw track bytes into a KeySignatureMetaEvent struct pointer. Key Signature meta events are
+// represented by (left to right): 0xFF, 0x59, 0x02, signed number of sharps, 0 for major or 1 for minor.
+//
+// Example: []byte{0xFF, 0x59, 0x02, 0xFD, 0x01}
+//
+// The example forms a Key Signature meta event of C minor (3 flats).
+func (ksme *KeySignatureMetaEvent) UnmarshalMIDI(b []byte) error {
+	data, err := unmarshalMetaEvent("key signature", KeySignatureMetaEventType, 2, b)
+	if err != nil {
+		return err
+	}
+	sharps := int(int8(data[0]))
+	if sharps < MinKeySignatureSharps || sharps > MaxKeySignatureSharps {
+		return fmt.Errorf("key signatures must have between %d and %d sharps, inclusive, received %d: %w", MinKeySignatureSharps, MaxKeySignatureSharps, sharps, ErrUnmarshallingFile)
+	}
+	if data[1] > 1 {
+		return fmt.Errorf("key signature scales must be 0 (major) or 1 (minor), received %d: %w", data[1], ErrUnmarshallingFile)
+	}
+
+	*ksme = KeySignatureMetaEvent{
+		Sharps: sharps,
+		Minor:  data[1] == 1,
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package smf
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_KeySignatureMetaEvent_GetMessageName(t *testing.T) {
+	t.Parallel()
+	event := KeySignatureMetaEvent{}
+	expected := "Key Signature"
+	if event.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, event.GetMessageName())
+	}
+}
+
+func Test_KeySignatureMetaEvent_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		event    KeySignatureMetaEvent
+		expected []byte
+		err      error
+	}{
+		"too many flats": {
+			event: KeySignatureMetaEvent{Sharps: -8},
+			err:   ErrMarshallingFile,
+		},
+		"too many sharps": {
+			event: KeySignatureMetaEvent{Sharps: 8},
+			err:   ErrMarshallingFile,
+		},
+		"flat minor key marshalls into expected bytes": {
+			event:    KeySignatureMetaEvent{Sharps: -3, Minor: true},
+			expected: []byte{0xFF, 0x59, 0x02, 0xFD, 0x01},
+		},
+		"sharp major key marshalls into expected bytes": {
+			event:    KeySignatureMetaEvent{Sharps: 2},
+			expected: []byte{0xFF, 0x59, 0x02, 0x02, 0x00},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.event.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_KeySignatureMetaEvent_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b             []byte
+		expectedEvent KeySignatureMetaEvent
+		err           error
+	}{
+		"too many sharps": {
+			b:   []byte{0xFF, 0x59, 0x02, 0x08, 0x00},
+			err: ErrUnmarshallingFile,
+		},
+		"scale is invalid": {
+			b:   []byte{0xFF, 0x59, 0x02, 0x00, 0x02},
+			err: ErrUnmarshallingFile,
+		},
+		"bytes unmarshal into expected event": {
+			b:             []byte{0xFF, 0x59, 0x02, 0xFD, 0x01},
+			expectedEvent: KeySignatureMetaEvent{Sharps: -3, Minor: true},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got KeySignatureMetaEvent
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedEvent, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedEvent, got)
+			}
+		})
+	}
+}
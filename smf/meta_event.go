@@ -0,0 +1,221 @@
+package smf
+
+import (
+	"fmt"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+const (
+	// MetaEventStatus represents the status byte that starts every meta event within a track.
+	MetaEventStatus byte = 0xFF
+
+	// MetaEventStringFormat represents the printf-compatible format for the string representation of a meta event.
+	MetaEventStringFormat string = "%s:%s:%#x:% X"
+)
+
+// MetaEventType represents the type byte of a meta event.
+type MetaEventType byte
+
+// MetaEvent represents a meta event within a track. Meta events carry information that is not sent over the wire,
+// such as tempo and track names, and are represented by three parts (left to right): 0xFF, type, variable-length data.
+type MetaEvent struct {
+	// Type represents the type of the meta event.
+	Type MetaEventType
+
+	// Data represents the data bytes of the meta event, excluding its length.
+	Data []byte
+}
+
+// GetMessageName returns the name of this meta event.
+func (me *MetaEvent) GetMessageName() string {
+	return "Meta Event"
+}
+
+// MarshalMIDI marshalls a MetaEvent into its raw track bytes.
+func (me MetaEvent) MarshalMIDI() ([]byte, error) {
+	b, err := AppendVariableLengthQuantity([]byte{MetaEventStatus, byte(me.Type)}, uint32(len(me.Data)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid meta event length (%v): %w", err, ErrMarshallingFile)
+	}
+	return append(b, me.Data...), nil
+}
+
+// isFileEvent marks meta events as events that are written to tracks as-is.
+func (me MetaEvent) isFileEvent() {}
+
+// String returns the human-readable representation of the meta event.
+func (me *MetaEvent) String() string {
+	return fmt.Sprintf(MetaEventStringFormat, EventVersion, me.GetMessageName(), me.Type, me.Data)
+}
+
+// UnmarshalMIDI unmarshalls raw track bytes into a MetaEvent struct pointer. Meta events are represented by
+// (left to right): 0xFF, type, variable-length data length, data.
+//
+// Example: []byte{0xFF, 0x01, 0x02, 0x48, 0x69}
+//
+// The example forms a Text meta event (type 0x01) with the data "Hi".
+func (me *MetaEvent) UnmarshalMIDI(b []byte) error {
+	eventType, data, n, err := parseMetaEvent(b)
+	if err != nil {
+		return err
+	}
+	if n != len(b) {
+		return fmt.Errorf("meta event is made up of %d bytes, received %d byte(s): %w", n, len(b), ErrUnmarshallingFile)
+	}
+
+	*me = MetaEvent{
+		Type: eventType,
+		Data: append([]byte(nil), data...),
+	}
+	return nil
+}
+
+// parseMetaEvent returns the type and data of the meta event at the start of b, along with the number of bytes it occupies.
+func parseMetaEvent(b []byte) (MetaEventType, []byte, int, error) {
+	if len(b) < 3 {
+		return 0, nil, 0, fmt.Errorf("meta events are made up of at least 3 bytes, received %d byte(s): %w", len(b), ErrUnmarshallingFile)
+	}
+	if b[0] != MetaEventStatus {
+		return 0, nil, 0, fmt.Errorf("meta events must start with %#x, received %#x: %w", MetaEventStatus, b[0], ErrUnmarshallingFile)
+	}
+	length, n, err := ParseVariableLengthQuantity(b[2:])
+	if err != nil {
+		return 0, nil, 0, fmt.Errorf("invalid meta event length (%v): %w", err, ErrUnmarshallingFile)
+	}
+	start := 2 + n
+	if uint64(len(b)-start) < uint64(length) {
+		return 0, nil, 0, fmt.Errorf("meta event data is %d byte(s), received %d byte(s): %w", length, len(b)-start, ErrUnmarshallingFile)
+	}
+	end := start + int(length)
+	return MetaEventType(b[1]), b[start:end], end, nil
+}
+
+const (
+	// SequenceNumberMetaEventType represents the type of the Sequence Number meta event.
+	SequenceNumberMetaEventType MetaEventType = 0x00
+
+	// TextMetaEventType represents the type of the Text meta event.
+	TextMetaEventType MetaEventType = 0x01
+
+	// CopyrightMetaEventType represents the type of the Copyright Notice meta event.
+	CopyrightMetaEventType MetaEventType = 0x02
+
+	// TrackNameMetaEventType represents the type of the Sequence/Track Name meta event.
+	TrackNameMetaEventType MetaEventType = 0x03
+
+	// InstrumentNameMetaEventType represents the type of the Instrument Name meta event.
+	InstrumentNameMetaEventType MetaEventType = 0x04
+
+	// LyricMetaEventType represents the type of the Lyric meta event.
+	LyricMetaEventType MetaEventType = 0x05
+
+	// MarkerMetaEventType represents the type of the Marker meta event.
+	MarkerMetaEventType MetaEventType = 0x06
+
+	// CuePointMetaEventType represents the type of the Cue Point meta event.
+	CuePointMetaEventType MetaEventType = 0x07
+
+	// ChannelPrefixMetaEventType represents the type of the MIDI Channel Prefix meta event.
+	ChannelPrefixMetaEventType MetaEventType = 0x20
+
+	// PortPrefixMetaEventType represents the type of the MIDI Port Prefix meta event.
+	PortPrefixMetaEventType MetaEventType = 0x21
+
+	// EndOfTrackMetaEventType represents the type of the End of Track meta event, which is required as the last event of every track.
+	EndOfTrackMetaEventType MetaEventType = 0x2F
+
+	// SetTempoMetaEventType represents the type of the Set Tempo meta event.
+	SetTempoMetaEventType MetaEventType = 0x51
+
+	// SMPTEOffsetMetaEventType represents the type of the SMPTE Offset meta event.
+	SMPTEOffsetMetaEventType MetaEventType = 0x54
+
+	// TimeSignatureMetaEventType represents the type of the Time Signature meta event.
+	TimeSignatureMetaEventType MetaEventType = 0x58
+
+	// KeySignatureMetaEventType represents the type of the Key Signature meta event.
+	KeySignatureMetaEventType MetaEventType = 0x59
+
+	// SequencerSpecificMetaEventType represents the type of the Sequencer-Specific meta event.
+	SequencerSpecificMetaEventType MetaEventType = 0x7F
+)
+
+// metaEventUnmarshaler represents a typed meta event that can unmarshal itself.
+type metaEventUnmarshaler interface {
+	midiv1.MessageMarshaler
+	midiv1.MessageUnmarshaler
+}
+
+// newMetaEvent returns the typed meta event for the raw bytes of a meta event of the supplied type. Unknown types, and
+// known types whose data does not match the specification, are returned as a generic *MetaEvent.
+func newMetaEvent(eventType MetaEventType, b []byte) (midiv1.MessageMarshaler, error) {
+	var event metaEventUnmarshaler
+	switch eventType {
+	case SequenceNumberMetaEventType:
+		event = &SequenceNumberMetaEvent{}
+	case TextMetaEventType:
+		event = &TextMetaEvent{}
+	case CopyrightMetaEventType:
+		event = &CopyrightMetaEvent{}
+	case TrackNameMetaEventType:
+		event = &TrackNameMetaEvent{}
+	case InstrumentNameMetaEventType:
+		event = &InstrumentNameMetaEvent{}
+	case LyricMetaEventType:
+		event = &LyricMetaEvent{}
+	case MarkerMetaEventType:
+		event = &MarkerMetaEvent{}
+	case CuePointMetaEventType:
+		event = &CuePointMetaEvent{}
+	case ChannelPrefixMetaEventType:
+		event = &ChannelPrefixMetaEvent{}
+	case PortPrefixMetaEventType:
+		event = &PortPrefixMetaEvent{}
+	case EndOfTrackMetaEventType:
+		event = &EndOfTrackMetaEvent{}
+	case SetTempoMetaEventType:
+		event = &SetTempoMetaEvent{}
+	case SMPTEOffsetMetaEventType:
+		event = &SMPTEOffsetMetaEvent{}
+	case TimeSignatureMetaEventType:
+		event = &TimeSignatureMetaEvent{}
+	case KeySignatureMetaEventType:
+		event = &KeySignatureMetaEvent{}
+	case SequencerSpecificMetaEventType:
+		event = &SequencerSpecificMetaEvent{}
+	}
+	if event != nil && event.UnmarshalMIDI(b) == nil {
+		return event, nil
+	}
+
+	generic := &MetaEvent{}
+	if err := generic.UnmarshalMIDI(b); err != nil {
+		return nil, err
+	}
+	return generic, nil
+}
+
+// marshalMetaEvent marshalls the type and data of a typed meta event into its raw track bytes.
+func marshalMetaEvent(eventType MetaEventType, data []byte) ([]byte, error) {
+	return MetaEvent{
+		Type: eventType,
+		Data: data,
+	}.MarshalMIDI()
+}
+
+// unmarshalMetaEvent validates the raw track bytes of a typed meta event and returns its data. The name is used to
+// describe the event in any returned errors, and a length of -1 allows data of any length.
+func unmarshalMetaEvent(name string, eventType MetaEventType, length int, b []byte) ([]byte, error) {
+	var event MetaEvent
+	if err := event.UnmarshalMIDI(b); err != nil {
+		return nil, err
+	}
+	if event.Type != eventType {
+		return nil, fmt.Errorf("%s meta events must have type %#x, received %#x: %w", name, eventType, event.Type, ErrUnmarshallingFile)
+	}
+	if length >= 0 && len(event.Data) != length {
+		return nil, fmt.Errorf("%s meta events are made up of %d data bytes, received %d byte(s): %w", name, length, len(event.Data), ErrUnmarshallingFile)
+	}
+	return event.Data, nil
+}
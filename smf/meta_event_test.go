@@ -0,0 +1,162 @@
+package smf
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func Test_MetaEvent_GetMessageName(t *testing.T) {
+	t.Parallel()
+	event := MetaEvent{}
+	expected := "Meta Event"
+	if event.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, event.GetMessageName())
+	}
+}
+
+func Test_MetaEvent_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		event    MetaEvent
+		expected []byte
+	}{
+		"event marshalls into expected bytes": {
+			event: MetaEvent{
+				Type: 0x01,
+				Data: []byte("Hi"),
+			},
+			expected: []byte{0xFF, 0x01, 0x02, 0x48, 0x69},
+		},
+		"event without data marshalls into expected bytes": {
+			event: MetaEvent{
+				Type: EndOfTrackMetaEventType,
+			},
+			expected: []byte{0xFF, 0x2F, 0x00},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.event.MarshalMIDI()
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_MetaEvent_String(t *testing.T) {
+	t.Parallel()
+	event := MetaEvent{
+		Type: 0x01,
+		Data: []byte("Hi"),
+	}
+	expected := fmt.Sprintf("%s:%s:%s:%s", EventVersion, "Meta Event", "0x1", "48 69")
+	if event.String() != expected {
+		t.Fatalf("expected %s, got %s", expected, event.String())
+	}
+}
+
+func Test_MetaEvent_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b             []byte
+		expectedEvent MetaEvent
+		err           error
+	}{
+		"byte slice is too short": {
+			b:   []byte{0xFF, 0x2F},
+			err: ErrUnmarshallingFile,
+		},
+		"first byte is not 0xFF": {
+			b:   []byte{0xF0, 0x2F, 0x00},
+			err: ErrUnmarshallingFile,
+		},
+		"data is shorter than its length": {
+			b:   []byte{0xFF, 0x01, 0x03, 0x48, 0x69},
+			err: ErrUnmarshallingFile,
+		},
+		"data is longer than its length": {
+			b:   []byte{0xFF, 0x01, 0x01, 0x48, 0x69},
+			err: ErrUnmarshallingFile,
+		},
+		"bytes unmarshal into expected event": {
+			b: []byte{0xFF, 0x01, 0x02, 0x48, 0x69},
+			expectedEvent: MetaEvent{
+				Type: 0x01,
+				Data: []byte("Hi"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got MetaEvent
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedEvent, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedEvent, got)
+			}
+		})
+	}
+}
+
+func Test_newMetaEvent(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		eventType     MetaEventType
+		b             []byte
+		expectedEvent interface{}
+		err           error
+	}{
+		"known type returns typed event": {
+			eventType:     SetTempoMetaEventType,
+			b:             []byte{0xFF, 0x51, 0x03, 0x07, 0xA1, 0x20},
+			expectedEvent: &SetTempoMetaEvent{MicrosecondsPerQuarterNote: 500000},
+		},
+		"known type with malformed data returns generic event": {
+			eventType:     SetTempoMetaEventType,
+			b:             []byte{0xFF, 0x51, 0x02, 0x07, 0xA1},
+			expectedEvent: &MetaEvent{Type: SetTempoMetaEventType, Data: []byte{0x07, 0xA1}},
+		},
+		"unknown type returns generic event": {
+			eventType:     0x60,
+			b:             []byte{0xFF, 0x60, 0x01, 0x01},
+			expectedEvent: &MetaEvent{Type: 0x60, Data: []byte{0x01}},
+		},
+		"invalid bytes return error": {
+			eventType: TextMetaEventType,
+			b:         []byte{0xFF, 0x01},
+			err:       ErrUnmarshallingFile,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := newMetaEvent(test.eventType, test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil {
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected %v error, got %v", test.err, err)
+				}
+				return
+			}
+			if !reflect.DeepEqual(test.expectedEvent, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedEvent, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package smf
+
+import "fmt"
+
+const (
+	// MaxPort represents the highest MIDI port number that can be stored in a MIDI Port Prefix meta event.
+	MaxPort int = 127
+)
+
+// PortPrefixMetaEvent represents a MIDI Port Prefix meta event, which selects the MIDI output port that the events of
+// the track are sent to.
+type PortPrefixMetaEvent struct {
+	// Port represents the output port number. Valid values are between 0 and 127 inclusive.
+	Port int
+}
+
+// GetMessageName returns the name of this MIDI Port Prefix meta event.
+func (ppme *PortPrefixMetaEvent) GetMessageName() string {
+	return "MIDI Port Prefix"
+}
+
+// MarshalMIDI marshalls a PortPrefixMetaEvent into its raw track bytes.
+func (ppme PortPrefixMetaEvent) MarshalMIDI() ([]byte, error) {
+	if ppme.Port < 0 || ppme.Port > MaxPort {
+		return nil, fmt.Errorf("port numbers must be between 0 and %d, inclusive, received %d: %w", MaxPort, ppme.Port, ErrMarshallingFile)
+	}
+	return marshalMetaEvent(PortPrefixMetaEventType, []byte{byte(ppme.Port)})
+}
+
+// isFileEvent marks MIDI Port Prefix meta events as events that are written to tracks as-is.
+func (ppme PortPrefixMetaEvent) isFileEvent() {}
+
+// String returns the human-readable representation of the meta event.
+func (ppme *PortPrefixMetaEvent) String() string {
+	return fmt.Sprintf("%s:%s:%d", EventVersion, ppme.GetMessageName(), ppme.Port)
+}
+
+// UnmarshalMIDI unmarshalls raw track bytes into a PortPrefixMetaEvent struct pointer. MIDI Port Prefix meta events
+// are represented by (left to right): 0xFF, 0x21, 0x01, port.
+//
+// Example: []byte{0xFF, 0x21, 0x01, 0x02}
+//
+// The example forms a MIDI Port Prefix meta event for port 2.
+func (ppme *PortPrefixMetaEvent) UnmarshalMIDI(b []byte) error {
+	data, err := unmarshalMetaEvent("port prefix", PortPrefixMetaEventType, 1, b)
+	if err != nil {
+		return err
+	}
+	if int(data[0]) > MaxPort {
+		return fmt.Errorf("port numbers must be between 0 and %d, inclusive, received %d: %w", MaxPort, data[0], ErrUnmarshallingFile)
+	}
+
+	*ppme = PortPrefixMetaEvent{
+		Port: int(data[0]),
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package smf
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_PortPrefixMetaEvent_GetMessageName(t *testing.T) {
+	t.Parallel()
+	event := PortPrefixMetaEvent{}
+	expected := "MIDI Port Prefix"
+	if event.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, event.GetMessageName())
+	}
+}
+
+func Test_PortPrefixMetaEvent_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		event    PortPrefixMetaEvent
+		expected []byte
+		err      error
+	}{
+		"port is negative": {
+			event: PortPrefixMetaEvent{Port: -1},
+			err:   ErrMarshallingFile,
+		},
+		"port is too high": {
+			event: PortPrefixMetaEvent{Port: 128},
+			err:   ErrMarshallingFile,
+		},
+		"event marshalls into expected bytes": {
+			event:    PortPrefixMetaEvent{Port: 2},
+			expected: []byte{0xFF, 0x21, 0x01, 0x02},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.event.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_PortPrefixMetaEvent_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b             []byte
+		expectedEvent PortPrefixMetaEvent
+		err           error
+	}{
+		"port is too high": {
+			b:   []byte{0xFF, 0x21, 0x01, 0x80},
+			err: ErrUnmarshallingFile,
+		},
+		"bytes unmarshal into expected event": {
+			b:             []byte{0xFF, 0x21, 0x01, 0x02},
+			expectedEvent: PortPrefixMetaEvent{Port: 2},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got PortPrefixMetaEvent
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedEvent, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedEvent, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package smf
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// SequenceNumberMetaEvent represents a Sequence Number meta event, which identifies a sequence within a format 2 file
+// or a collection of files. It must occur at the start of a track, before any non-zero delta time.
+type SequenceNumberMetaEvent struct {
+	// Number represents the sequence number.
+	Number uint16
+}
+
+// GetMessageName returns the name of this Sequence Number meta event.
+func (snme *SequenceNumberMetaEvent) GetMessageName() string {
+	return "Sequence Number"
+}
+
+// MarshalMIDI marshalls a SequenceNumberMetaEvent into its raw track bytes.
+func (snme SequenceNumberMetaEvent) MarshalMIDI() ([]byte, error) {
+	data := make([]byte, 2)
+	binary.BigEndian.PutUint16(data, snme.Number)
+	return marshalMetaEvent(SequenceNumberMetaEventType, data)
+}
+
+// isFileEvent marks Sequence Number meta events as events that are written to tracks as-is.
+func (snme SequenceNumberMetaEvent) isFileEvent() {}
+
+// String returns the human-readable representation of the meta event.
+func (snme *SequenceNumberMetaEvent) String() string {
+	return fmt.Sprintf("%s:%s:%d", EventVersion, snme.GetMessageName(), snme.Number)
+}
+
+// UnmarshalMIDI unmarshalls raw track bytes into a SequenceNumberMetaEvent struct pointer. Sequence Number meta events
+// are represented by (left to right): 0xFF, 0x00, 0x02, sequence number MSB, sequence number LSB.
+//
+// Example: []byte{0xFF, 0x00, 0x02, 0x00, 0x07}
+//
+// The example forms a Sequence Number meta event for sequence 7.
+func (snme *SequenceNumberMetaEvent) UnmarshalMIDI(b []byte) error {
+	data, err := unmarshalMetaEvent("sequence number", SequenceNumberMetaEventType, 2, b)
+	if err != nil {
+		return err
+	}
+
+	*snme = SequenceNumberMetaEvent{
+		Number: binary.BigEndian.Uint16(data),
+	}
+	return nil
+}
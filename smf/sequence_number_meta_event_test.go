@@ -0,0 +1,80 @@
+package smf
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_SequenceNumberMetaEvent_GetMessageName(t *testing.T) {
+	t.Parallel()
+	event := SequenceNumberMetaEvent{}
+	expected := "Sequence Number"
+	if event.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, event.GetMessageName())
+	}
+}
+
+func Test_SequenceNumberMetaEvent_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		event    SequenceNumberMetaEvent
+		expected []byte
+		err      error
+	}{
+		"event marshalls into expected bytes": {
+			event:    SequenceNumberMetaEvent{Number: 0x0107},
+			expected: []byte{0xFF, 0x00, 0x02, 0x01, 0x07},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.event.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_SequenceNumberMetaEvent_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b             []byte
+		expectedEvent SequenceNumberMetaEvent
+		err           error
+	}{
+		"wrong data length": {
+			b:   []byte{0xFF, 0x00, 0x01, 0x07},
+			err: ErrUnmarshallingFile,
+		},
+		"bytes unmarshal into expected event": {
+			b:             []byte{0xFF, 0x00, 0x02, 0x01, 0x07},
+			expectedEvent: SequenceNumberMetaEvent{Number: 0x0107},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got SequenceNumberMetaEvent
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedEvent, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedEvent, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package smf
+
+import "fmt"
+
+// SequencerSpecificMetaEvent represents a Sequencer-Specific meta event, which holds data for a particular sequencer.
+// The data normally begins with a manufacturer ID, in the same form as a System Exclusive message.
+type SequencerSpecificMetaEvent struct {
+	// Data represents the data bytes of the event.
+	Data []byte
+}
+
+// GetMessageName returns the name of this Sequencer-Specific meta event.
+func (ssme *SequencerSpecificMetaEvent) GetMessageName() string {
+	return "Sequencer-Specific"
+}
+
+// MarshalMIDI marshalls a SequencerSpecificMetaEvent into its raw track bytes.
+func (ssme SequencerSpecificMetaEvent) MarshalMIDI() ([]byte, error) {
+	return marshalMetaEvent(SequencerSpecificMetaEventType, ssme.Data)
+}
+
+// isFileEvent marks Sequencer-Specific meta events as events that are written to tracks as-is.
+func (ssme SequencerSpecificMetaEvent) isFileEvent() {}
+
+// String returns the human-readable representation of the meta event.
+func (ssme *SequencerSpecificMetaEvent) String() string {
+	return fmt.Sprintf("%s:%s:% X", EventVersion, ssme.GetMessageName(), ssme.Data)
+}
+
+// UnmarshalMIDI unmarshalls raw track bytes into a SequencerSpecificMetaEvent struct pointer.
+func (ssme *SequencerSpecificMetaEvent) UnmarshalMIDI(b []byte) error {
+	data, err := unmarshalMetaEvent("sequencer-specific", SequencerSpecificMetaEventType, -1, b)
+	if err != nil {
+		return err
+	}
+
+	*ssme = SequencerSpecificMetaEvent{
+		Data: append([]byte(nil), data...),
+	}
+	return nil
+}
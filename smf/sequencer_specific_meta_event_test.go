@@ -0,0 +1,80 @@
+package smf
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_SequencerSpecificMetaEvent_GetMessageName(t *testing.T) {
+	t.Parallel()
+	event := SequencerSpecificMetaEvent{}
+	expected := "Sequencer-Specific"
+	if event.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, event.GetMessageName())
+	}
+}
+
+func Test_SequencerSpecificMetaEvent_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		event    SequencerSpecificMetaEvent
+		expected []byte
+		err      error
+	}{
+		"event marshalls into expected bytes": {
+			event:    SequencerSpecificMetaEvent{Data: []byte{0x00, 0x00, 0x41, 0x01}},
+			expected: []byte{0xFF, 0x7F, 0x04, 0x00, 0x00, 0x41, 0x01},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.event.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_SequencerSpecificMetaEvent_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b             []byte
+		expectedEvent SequencerSpecificMetaEvent
+		err           error
+	}{
+		"type is not sequencer-specific": {
+			b:   []byte{0xFF, 0x01, 0x01, 0x41},
+			err: ErrUnmarshallingFile,
+		},
+		"bytes unmarshal into expected event": {
+			b:             []byte{0xFF, 0x7F, 0x04, 0x00, 0x00, 0x41, 0x01},
+			expectedEvent: SequencerSpecificMetaEvent{Data: []byte{0x00, 0x00, 0x41, 0x01}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got SequencerSpecificMetaEvent
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedEvent, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedEvent, got)
+			}
+		})
+	}
+}
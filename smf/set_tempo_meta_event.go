@@ -0,0 +1,97 @@
+package smf
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	// DefaultMicrosecondsPerQuarterNote represents the tempo of a track before any Set Tempo meta event (120 BPM).
+	DefaultMicrosecondsPerQuarterNote uint32 = 500000
+
+	// MaxMicrosecondsPerQuarterNote represents the slowest tempo that fits within the 3 bytes of a Set Tempo meta event.
+	MaxMicrosecondsPerQuarterNote uint32 = 0xFFFFFF
+
+	// microsecondsPerMinute represents the number of microseconds in a minute, used for BPM conversions.
+	microsecondsPerMinute float64 = 60000000
+)
+
+// SetTempoMetaEvent represents a Set Tempo meta event, which sets the tempo in microseconds per quarter note.
+type SetTempoMetaEvent struct {
+	// MicrosecondsPerQuarterNote represents the length of a quarter note. Valid values are between 1 and 0xFFFFFF inclusive.
+	MicrosecondsPerQuarterNote uint32
+}
+
+// NewSetTempoMetaEventFromBPM returns a SetTempoMetaEvent for the supplied tempo in quarter note beats per minute, or an
+// error if the tempo cannot be represented.
+func NewSetTempoMetaEventFromBPM(bpm float64) (SetTempoMetaEvent, error) {
+	if bpm <= 0 || math.IsInf(bpm, 0) || math.IsNaN(bpm) {
+		return SetTempoMetaEvent{}, fmt.Errorf("tempo must be a positive number of beats per minute, received %v: %w", bpm, ErrMarshallingFile)
+	}
+	microseconds := math.Round(microsecondsPerMinute / bpm)
+	if microseconds < 1 || microseconds > float64(MaxMicrosecondsPerQuarterNote) {
+		return SetTempoMetaEvent{}, fmt.Errorf("tempo of %v beats per minute cannot be represented: %w", bpm, ErrMarshallingFile)
+	}
+	return SetTempoMetaEvent{
+		MicrosecondsPerQuarterNote: uint32(microseconds),
+	}, nil
+}
+
+// BPM returns the tempo in quarter note beats per minute.
+func (stme SetTempoMetaEvent) BPM() float64 {
+	if stme.MicrosecondsPerQuarterNote == 0 {
+		return 0
+	}
+	return microsecondsPerMinute / float64(stme.MicrosecondsPerQuarterNote)
+}
+
+// QuarterNoteDuration returns the length of a quarter note at this tempo.
+func (stme SetTempoMetaEvent) QuarterNoteDuration() time.Duration {
+	return time.Duration(stme.MicrosecondsPerQuarterNote) * time.Microsecond
+}
+
+// GetMessageName returns the name of this Set Tempo meta event.
+func (stme *SetTempoMetaEvent) GetMessageName() string {
+	return "Set Tempo"
+}
+
+// MarshalMIDI marshalls a SetTempoMetaEvent into its raw track bytes.
+func (stme SetTempoMetaEvent) MarshalMIDI() ([]byte, error) {
+	if stme.MicrosecondsPerQuarterNote == 0 || stme.MicrosecondsPerQuarterNote > MaxMicrosecondsPerQuarterNote {
+		return nil, fmt.Errorf("microseconds per quarter note must be between 1 and %d, inclusive, received %d: %w", MaxMicrosecondsPerQuarterNote, stme.MicrosecondsPerQuarterNote, ErrMarshallingFile)
+	}
+	mpqn := stme.MicrosecondsPerQuarterNote
+	return marshalMetaEvent(SetTempoMetaEventType, []byte{byte(mpqn >> 16), byte(mpqn >> 8), byte(mpqn)})
+}
+
+// isFileEvent marks Set Tempo meta events as events that are written to tracks as-is.
+func (stme SetTempoMetaEvent) isFileEvent() {}
+
+// String returns the human-readable representation of the meta event.
+func (stme *SetTempoMetaEvent) String() string {
+	return fmt.Sprintf("%s:%s:%d", EventVersion, stme.GetMessageName(), stme.MicrosecondsPerQuarterNote)
+}
+
+// UnmarshalMIDI unmarshalls raw track bytes into a SetTempoMetaEvent struct pointer. Set Tempo meta events are
+// represented by (left to right): 0xFF, 0x51, 0x03, three bytes of microseconds per quarter note (most significant first).
+//
+// Example: []byte{0xFF, 0x51, 0x03, 0x07, 0xA1, 0x20}
+//
+// The example forms a Set Tempo meta event of 500000 microseconds per quarter note (120 BPM).
+func (stme *SetTempoMetaEvent) UnmarshalMIDI(b []byte) error {
+	data, err := unmarshalMetaEvent("set tempo", SetTempoMetaEventType, 3, b)
+	if err != nil {
+		return err
+	}
+
+	mpqn := uint32(data[0])<<16 | uint32(data[1])<<8 | uint32(data[2])
+	if mpqn == 0 {
+		return fmt.Errorf("microseconds per quarter note must be between 1 and %d, inclusive, received %d: %w", MaxMicrosecondsPerQuarterNote, mpqn, ErrUnmarshallingFile)
+	}
+
+	*stme = SetTempoMetaEvent{
+		MicrosecondsPerQuarterNote: mpqn,
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package smf
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_SetTempoMetaEvent_GetMessageName(t *testing.T) {
+	t.Parallel()
+	event := SetTempoMetaEvent{}
+	expected := "Set Tempo"
+	if event.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, event.GetMessageName())
+	}
+}
+
+func Test_SetTempoMetaEvent_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		event    SetTempoMetaEvent
+		expected []byte
+		err      error
+	}{
+		"tempo is zero": {
+			event: SetTempoMetaEvent{},
+			err:   ErrMarshallingFile,
+		},
+		"tempo is too slow": {
+			event: SetTempoMetaEvent{MicrosecondsPerQuarterNote: 0x1000000},
+			err:   ErrMarshallingFile,
+		},
+		"event marshalls into expected bytes": {
+			event:    SetTempoMetaEvent{MicrosecondsPerQuarterNote: 500000},
+			expected: []byte{0xFF, 0x51, 0x03, 0x07, 0xA1, 0x20},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.event.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_SetTempoMetaEvent_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b             []byte
+		expectedEvent SetTempoMetaEvent
+		err           error
+	}{
+		"wrong data length": {
+			b:   []byte{0xFF, 0x51, 0x02, 0x07, 0xA1},
+			err: ErrUnmarshallingFile,
+		},
+		"zero microseconds per quarter note": {
+			b:   []byte{0xFF, 0x51, 0x03, 0x00, 0x00, 0x00},
+			err: ErrUnmarshallingFile,
+		},
+		"bytes unmarshal into expected event": {
+			b:             []byte{0xFF, 0x51, 0x03, 0x07, 0xA1, 0x20},
+			expectedEvent: SetTempoMetaEvent{MicrosecondsPerQuarterNote: 500000},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got SetTempoMetaEvent
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedEvent, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedEvent, got)
+			}
+		})
+	}
+}
+
+func Test_NewSetTempoMetaEventFromBPM(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		bpm           float64
+		expectedEvent SetTempoMetaEvent
+		err           error
+	}{
+		"zero tempo": {
+			bpm: 0,
+			err: ErrMarshallingFile,
+		},
+		"tempo is too slow": {
+			bpm: 3,
+			err: ErrMarshallingFile,
+		},
+		"120 BPM returns default tempo": {
+			bpm:           120,
+			expectedEvent: SetTempoMetaEvent{MicrosecondsPerQuarterNote: DefaultMicrosecondsPerQuarterNote},
+		},
+		"fractional tempo is rounded": {
+			bpm:           140,
+			expectedEvent: SetTempoMetaEvent{MicrosecondsPerQuarterNote: 428571},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewSetTempoMetaEventFromBPM(test.bpm)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if got != test.expectedEvent {
+				t.Fatalf("expected %+v, got %+v", test.expectedEvent, got)
+			}
+		})
+	}
+}
+
+func Test_SetTempoMetaEvent_BPM(t *testing.T) {
+	t.Parallel()
+	event := SetTempoMetaEvent{MicrosecondsPerQuarterNote: 400000}
+	if event.BPM() != 150 {
+		t.Fatalf("expected 150, got %v", event.BPM())
+	}
+	if event.QuarterNoteDuration() != 400*time.Millisecond {
+		t.Fatalf("expected %v, got %v", 400*time.Millisecond, event.QuarterNoteDuration())
+	}
+}
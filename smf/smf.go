@@ -0,0 +1,158 @@
+// Package smf reads and writes Standard MIDI Files (.mid), as defined by the MIDI 1.0 specification.
+//
+// Channel events within tracks are represented by the midiv1 message types, while meta events and System Exclusive
+// events are represented by the types in this package. Meta events with a known type, such as Set Tempo or Marker, are
+// read into their typed struct, and any other meta event is read into a generic MetaEvent.
+package smf
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+var (
+	// ErrMarshallingFile represents an error marshalling a Standard MIDI File.
+	ErrMarshallingFile error = errors.New("error marshalling standard MIDI file")
+
+	// ErrUnmarshallingFile represents an error unmarshalling a Standard MIDI File.
+	ErrUnmarshallingFile error = errors.New("error unmarshalling standard MIDI file")
+
+	// ErrInvalidDivision represents an invalid Standard MIDI File timing division.
+	ErrInvalidDivision error = errors.New("invalid standard MIDI file division")
+
+	// ErrInvalidTempoMap represents a tempo map that cannot be built or a position that does not exist within it.
+	ErrInvalidTempoMap error = errors.New("invalid tempo map")
+)
+
+const (
+	// EventVersion represents the version string for events that only exist within Standard MIDI Files.
+	EventVersion string = "SMF"
+
+	// HeaderChunkType represents the chunk type of the header chunk.
+	HeaderChunkType string = "MThd"
+
+	// HeaderChunkLength represents the number of data bytes in a header chunk.
+	HeaderChunkLength int = 6
+
+	// TrackChunkType represents the chunk type of a track chunk.
+	TrackChunkType string = "MTrk"
+
+	// chunkPrefixLength represents the number of bytes in a chunk type and chunk length.
+	chunkPrefixLength int = 8
+)
+
+// Format represents the format of a Standard MIDI File from its header chunk.
+type Format uint16
+
+const (
+	// SingleTrackFormat represents a format 0 file, which contains a single multi-channel track.
+	SingleTrackFormat Format = 0
+
+	// MultiTrackFormat represents a format 1 file, which contains one or more simultaneous tracks of a sequence.
+	MultiTrackFormat Format = 1
+
+	// MultiSequenceFormat represents a format 2 file, which contains one or more sequentially independent single-track patterns.
+	MultiSequenceFormat Format = 2
+)
+
+// Division represents the timing division of a Standard MIDI File from its header chunk. When bit 15 is clear, the
+// remaining bits are the number of ticks per quarter note. When bit 15 is set, the upper byte is a negative SMPTE
+// frame rate (two's complement) and the lower byte is the number of ticks per frame.
+type Division uint16
+
+// NewTicksPerQuarterNoteDivision returns a metrical Division with the supplied number of ticks per quarter note.
+func NewTicksPerQuarterNoteDivision(ticks int) (Division, error) {
+	if ticks < 1 || ticks > 0x7FFF {
+		return 0, fmt.Errorf("ticks per quarter note must be between 1 and %d, inclusive: %w", 0x7FFF, ErrInvalidDivision)
+	}
+	return Division(ticks), nil
+}
+
+// NewSMPTEDivision returns a time-code-based Division with the supplied SMPTE frame rate (24, 25, 29 for 30 drop-frame,
+// or 30) and number of ticks per frame.
+func NewSMPTEDivision(framesPerSecond int, ticksPerFrame int) (Division, error) {
+	switch framesPerSecond {
+	case 24, 25, 29, 30:
+	default:
+		return 0, fmt.Errorf("SMPTE frame rate must be 24, 25, 29 or 30, received %d: %w", framesPerSecond, ErrInvalidDivision)
+	}
+	if ticksPerFrame < 1 || ticksPerFrame > 0xFF {
+		return 0, fmt.Errorf("ticks per frame must be between 1 and %d, inclusive: %w", 0xFF, ErrInvalidDivision)
+	}
+	return Division(uint16(byte(-int8(framesPerSecond)))<<8 | uint16(ticksPerFrame)), nil
+}
+
+// IsSMPTE returns whether the division is time-code-based rather than metrical.
+func (d Division) IsSMPTE() bool {
+	return d&0x8000 != 0
+}
+
+// TicksPerQuarterNote returns the number of ticks per quarter note of a metrical division, or 0 for an SMPTE division.
+func (d Division) TicksPerQuarterNote() int {
+	if d.IsSMPTE() {
+		return 0
+	}
+	return int(d)
+}
+
+// FramesPerSecond returns the SMPTE frame rate of a time-code-based division, or 0 for a metrical division. A frame
+// rate of 29 represents 30 drop-frame (29.97 frames per second).
+func (d Division) FramesPerSecond() int {
+	if !d.IsSMPTE() {
+		return 0
+	}
+	return -int(int8(d >> 8))
+}
+
+// TicksPerFrame returns the number of ticks per SMPTE frame of a time-code-based division, or 0 for a metrical division.
+func (d Division) TicksPerFrame() int {
+	if !d.IsSMPTE() {
+		return 0
+	}
+	return int(d & 0xFF)
+}
+
+// Event represents a single timed event within a track.
+type Event struct {
+	// DeltaTime represents the number of ticks between the previous event in the track and this event.
+	DeltaTime uint32
+
+	// Message represents the content of the event. It is a midiv1 channel message, a meta event or a System Exclusive event.
+	// Messages carried by several Control Change messages are written as one event per Control Change, and midiv1 System
+	// Exclusive messages are written as System Exclusive events, which is how they are read back.
+	Message midiv1.MessageMarshaler
+}
+
+// fileEvent represents an event that only exists within Standard MIDI Files, such as a meta event. File events
+// marshal into their complete track bytes and cancel running status.
+type fileEvent interface {
+	isFileEvent()
+}
+
+// Track represents a single track chunk of a Standard MIDI File.
+type Track struct {
+	// Events represents the timed events of the track, in order.
+	Events []Event
+}
+
+// AddEvent appends an event with the supplied delta time and message to the end of the track.
+func (t *Track) AddEvent(deltaTime uint32, message midiv1.MessageMarshaler) {
+	t.Events = append(t.Events, Event{
+		DeltaTime: deltaTime,
+		Message:   message,
+	})
+}
+
+// File represents a Standard MIDI File.
+type File struct {
+	// Format represents the format of the file.
+	Format Format
+
+	// Division represents the meaning of the delta times within the tracks of the file.
+	Division Division
+
+	// Tracks represents the track chunks of the file, in order.
+	Tracks []Track
+}
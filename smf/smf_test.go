@@ -0,0 +1,123 @@
+package smf
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_NewTicksPerQuarterNoteDivision(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		ticks            int
+		expectedDivision Division
+		err              error
+	}{
+		"ticks too low": {
+			ticks: 0,
+			err:   ErrInvalidDivision,
+		},
+		"ticks too high": {
+			ticks: 0x8000,
+			err:   ErrInvalidDivision,
+		},
+		"division is intended value": {
+			ticks:            480,
+			expectedDivision: 480,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewTicksPerQuarterNoteDivision(test.ticks)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if got != test.expectedDivision {
+				t.Fatalf("expected %#x, got %#x", test.expectedDivision, got)
+			}
+		})
+	}
+}
+
+func Test_NewSMPTEDivision(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		framesPerSecond  int
+		ticksPerFrame    int
+		expectedDivision Division
+		err              error
+	}{
+		"unsupported frame rate": {
+			framesPerSecond: 60,
+			ticksPerFrame:   40,
+			err:             ErrInvalidDivision,
+		},
+		"ticks per frame too high": {
+			framesPerSecond: 25,
+			ticksPerFrame:   256,
+			err:             ErrInvalidDivision,
+		},
+		"division is intended value": {
+			framesPerSecond:  25,
+			ticksPerFrame:    40,
+			expectedDivision: 0xE728,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewSMPTEDivision(test.framesPerSecond, test.ticksPerFrame)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if got != test.expectedDivision {
+				t.Fatalf("expected %#x, got %#x", test.expectedDivision, got)
+			}
+		})
+	}
+}
+
+func Test_Division(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		division                    Division
+		expectedIsSMPTE             bool
+		expectedTicksPerQuarterNote int
+		expectedFramesPerSecond     int
+		expectedTicksPerFrame       int
+	}{
+		"metrical division": {
+			division:                    96,
+			expectedTicksPerQuarterNote: 96,
+		},
+		"SMPTE division": {
+			division:                0xE250,
+			expectedIsSMPTE:         true,
+			expectedFramesPerSecond: 30,
+			expectedTicksPerFrame:   80,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := test.division.IsSMPTE(); got != test.expectedIsSMPTE {
+				t.Fatalf("expected IsSMPTE %v, got %v", test.expectedIsSMPTE, got)
+			}
+			if got := test.division.TicksPerQuarterNote(); got != test.expectedTicksPerQuarterNote {
+				t.Fatalf("expected %d ticks per quarter note, got %d", test.expectedTicksPerQuarterNote, got)
+			}
+			if got := test.division.FramesPerSecond(); got != test.expectedFramesPerSecond {
+				t.Fatalf("expected %d frames per second, got %d", test.expectedFramesPerSecond, got)
+			}
+			if got := test.division.TicksPerFrame(); got != test.expectedTicksPerFrame {
+				t.Fatalf("expected %d ticks per frame, got %d", test.expectedTicksPerFrame, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package smf
+
+import "fmt"
+
+// SMPTEOffsetMetaEvent represents an SMPTE Offset meta event, which sets the SMPTE time at which the track starts. The
+// frame rate is taken from the hours byte, in the same way as an MTC Full Frame message.
+type SMPTEOffsetMetaEvent struct {
+	// FramesPerSecond represents the SMPTE frame rate: 24, 25, 29 (30 drop-frame) or 30.
+	FramesPerSecond int
+
+	// Hours represents the hours of the offset, between 0 and 23 inclusive.
+	Hours int
+
+	// Minutes represents the minutes of the offset, between 0 and 59 inclusive.
+	Minutes int
+
+	// Seconds represents the seconds of the offset, between 0 and 59 inclusive.
+	Seconds int
+
+	// Frames represents the frames of the offset, lower than the frame rate.
+	Frames int
+
+	// FractionalFrames represents the hundredths of a frame of the offset, between 0 and 99 inclusive.
+	FractionalFrames int
+}
+
+// smpteRateCodes maps the SMPTE frame rates to the two rate bits of the hours byte.
+var smpteRateCodes = map[int]byte{
+	24: 0b00,
+	25: 0b01,
+	29: 0b10,
+	30: 0b11,
+}
+
+// smpteRates maps the two rate bits of the hours byte to the SMPTE frame rates.
+var smpteRates = [4]int{24, 25, 29, 30}
+
+// GetMessageName returns the name of this SMPTE Offset meta event.
+func (smoe *SMPTEOffsetMetaEvent) GetMessageName() string {
+	return "SMPTE Offset"
+}
+
+// MarshalMIDI marshalls an SMPTEOffsetMetaEvent into its raw track bytes.
+func (smoe SMPTEOffsetMetaEvent) MarshalMIDI() ([]byte, error) {
+	if err := smoe.validate(); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, ErrMarshallingFile)
+	}
+	return marshalMetaEvent(SMPTEOffsetMetaEventType, []byte{
+		smpteRateCodes[smoe.FramesPerSecond]<<5 | byte(smoe.Hours),
+		byte(smoe.Minutes),
+		byte(smoe.Seconds),
+		byte(smoe.Frames),
+		byte(smoe.FractionalFrames),
+	})
+}
+
+// isFileEvent marks SMPTE Offset meta events as events that are written to tracks as-is.
+func (smoe SMPTEOffsetMetaEvent) isFileEvent() {}
+
+// String returns the human-readable representation of the meta event.
+func (smoe *SMPTEOffsetMetaEvent) String() string {
+	return fmt.Sprintf("%s:%s:%d:%02d:%02d:%02d:%02d.%02d", EventVersion, smoe.GetMessageName(), smoe.FramesPerSecond, smoe.Hours, smoe.Minutes, smoe.Seconds, smoe.Frames, smoe.FractionalFrames)
+}
+
+// UnmarshalMIDI unmarshalls raw track bytes into an SMPTEOffsetMetaEvent struct pointer. SMPTE Offset meta events are
+// represented by (left to right): 0xFF, 0x54, 0x05, rate and hours, minutes, seconds, frames, fractional frames.
+//
+// Example: []byte{0xFF, 0x54, 0x05, 0x61, 0x02, 0x03, 0x04, 0x05}
+//
+// The example forms an SMPTE Offset meta event of 01:02:03:04.05 at 30 frames per second.
+func (smoe *SMPTEOffsetMetaEvent) UnmarshalMIDI(b []byte) error {
+	data, err := unmarshalMetaEvent("SMPTE offset", SMPTEOffsetMetaEventType, 5, b)
+	if err != nil {
+		return err
+	}
+	if data[0]&0x80 != 0 {
+		return fmt.Errorf("invalid SMPTE offset hours byte %#x: %w", data[0], ErrUnmarshallingFile)
+	}
+
+	event := SMPTEOffsetMetaEvent{
+		FramesPerSecond:  smpteRates[data[0]>>5&0b11],
+		Hours:            int(data[0] & 0x1F),
+		Minutes:          int(data[1]),
+		Seconds:          int(data[2]),
+		Frames:           int(data[3]),
+		FractionalFrames: int(data[4]),
+	}
+	if err := event.validate(); err != nil {
+		return fmt.Errorf("%v: %w", err, ErrUnmarshallingFile)
+	}
+	*smoe = event
+	return nil
+}
+
+// validate returns an error if any part of the offset is out of range.
+func (smoe SMPTEOffsetMetaEvent) validate() error {
+	if _, ok := smpteRateCodes[smoe.FramesPerSecond]; !ok {
+		return fmt.Errorf("SMPTE frame rates must be 24, 25, 29 or 30, received %d", smoe.FramesPerSecond)
+	}
+	switch {
+	case smoe.Hours < 0 || smoe.Hours > 23:
+		return fmt.Errorf("SMPTE hours must be between 0 and 23, inclusive, received %d", smoe.Hours)
+	case smoe.Minutes < 0 || smoe.Minutes > 59:
+		return fmt.Errorf("SMPTE minutes must be between 0 and 59, inclusive, received %d", smoe.Minutes)
+	case smoe.Seconds < 0 || smoe.Seconds > 59:
+		return fmt.Errorf("SMPTE seconds must be between 0 and 59, inclusive, received %d", smoe.Seconds)
+	case smoe.Frames < 0 || smoe.Frames > smoe.maxFrame():
+		return fmt.Errorf("SMPTE frames must be between 0 and %d, inclusive, received %d", smoe.maxFrame(), smoe.Frames)
+	case smoe.FractionalFrames < 0 || smoe.FractionalFrames > 99:
+		return fmt.Errorf("SMPTE fractional frames must be between 0 and 99, inclusive, received %d", smoe.FractionalFrames)
+	}
+	return nil
+}
+
+// maxFrame returns the highest frame number at the frame rate of the offset.
+func (smoe SMPTEOffsetMetaEvent) maxFrame() int {
+	if smoe.FramesPerSecond == 29 {
+		return 29
+	}
+	return smoe.FramesPerSecond - 1
+}
@@ -0,0 +1,100 @@
+package smf
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_SMPTEOffsetMetaEvent_GetMessageName(t *testing.T) {
+	t.Parallel()
+	event := SMPTEOffsetMetaEvent{}
+	expected := "SMPTE Offset"
+	if event.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, event.GetMessageName())
+	}
+}
+
+func Test_SMPTEOffsetMetaEvent_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		event    SMPTEOffsetMetaEvent
+		expected []byte
+		err      error
+	}{
+		"frame rate is invalid": {
+			event: SMPTEOffsetMetaEvent{FramesPerSecond: 60},
+			err:   ErrMarshallingFile,
+		},
+		"hours are out of range": {
+			event: SMPTEOffsetMetaEvent{FramesPerSecond: 30, Hours: 24},
+			err:   ErrMarshallingFile,
+		},
+		"frames are out of range": {
+			event: SMPTEOffsetMetaEvent{FramesPerSecond: 25, Frames: 25},
+			err:   ErrMarshallingFile,
+		},
+		"event marshalls into expected bytes": {
+			event:    SMPTEOffsetMetaEvent{FramesPerSecond: 30, Hours: 1, Minutes: 2, Seconds: 3, Frames: 4, FractionalFrames: 5},
+			expected: []byte{0xFF, 0x54, 0x05, 0x61, 0x02, 0x03, 0x04, 0x05},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.event.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_SMPTEOffsetMetaEvent_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b             []byte
+		expectedEvent SMPTEOffsetMetaEvent
+		err           error
+	}{
+		"wrong data length": {
+			b:   []byte{0xFF, 0x54, 0x04, 0x61, 0x02, 0x03, 0x04},
+			err: ErrUnmarshallingFile,
+		},
+		"minutes are out of range": {
+			b:   []byte{0xFF, 0x54, 0x05, 0x61, 0x3C, 0x03, 0x04, 0x05},
+			err: ErrUnmarshallingFile,
+		},
+		"bytes unmarshal into expected event": {
+			b:             []byte{0xFF, 0x54, 0x05, 0x61, 0x02, 0x03, 0x04, 0x05},
+			expectedEvent: SMPTEOffsetMetaEvent{FramesPerSecond: 30, Hours: 1, Minutes: 2, Seconds: 3, Frames: 4, FractionalFrames: 5},
+		},
+		"drop-frame rate unmarshals into expected event": {
+			b:             []byte{0xFF, 0x54, 0x05, 0x40, 0x00, 0x00, 0x1D, 0x00},
+			expectedEvent: SMPTEOffsetMetaEvent{FramesPerSecond: 29, Frames: 29},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got SMPTEOffsetMetaEvent
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedEvent, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedEvent, got)
+			}
+		})
+	}
+}
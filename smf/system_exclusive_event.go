@@ -0,0 +1,94 @@
+package smf
+
+import "fmt"
+
+const (
+	// SystemExclusiveEventStatus represents the status byte of a System Exclusive event that starts a message.
+	SystemExclusiveEventStatus byte = 0xF0
+
+	// EscapeEventStatus represents the status byte of a System Exclusive continuation or escape event.
+	EscapeEventStatus byte = 0xF7
+
+	// SystemExclusiveEventStringFormat represents the printf-compatible format for the string representation of a System Exclusive event.
+	SystemExclusiveEventStringFormat string = "%s:%s:%#x:% X"
+)
+
+// SystemExclusiveEvent represents a System Exclusive event within a track. System Exclusive events are represented by
+// (left to right): 0xF0 or 0xF7, variable-length data length, data.
+//
+// An 0xF0 event holds the bytes sent after the 0xF0 status byte, normally ending with 0xF7. An 0xF7 event holds bytes
+// that are sent as-is, either continuing a divided System Exclusive message or escaping other raw bytes.
+type SystemExclusiveEvent struct {
+	// Status represents the status byte of the event, which is SystemExclusiveEventStatus or EscapeEventStatus.
+	Status byte
+
+	// Data represents the data bytes of the event, excluding its length.
+	Data []byte
+}
+
+// GetMessageName returns the name of this System Exclusive event.
+func (see *SystemExclusiveEvent) GetMessageName() string {
+	return "System Exclusive Event"
+}
+
+// MarshalMIDI marshalls a SystemExclusiveEvent into its raw track bytes.
+func (see SystemExclusiveEvent) MarshalMIDI() ([]byte, error) {
+	if see.Status != SystemExclusiveEventStatus && see.Status != EscapeEventStatus {
+		return nil, fmt.Errorf("system exclusive events must have a status of %#x or %#x, received %#x: %w", SystemExclusiveEventStatus, EscapeEventStatus, see.Status, ErrMarshallingFile)
+	}
+	b, err := AppendVariableLengthQuantity([]byte{see.Status}, uint32(len(see.Data)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid system exclusive event length (%v): %w", err, ErrMarshallingFile)
+	}
+	return append(b, see.Data...), nil
+}
+
+// isFileEvent marks System Exclusive events as events that are written to tracks as-is.
+func (see SystemExclusiveEvent) isFileEvent() {}
+
+// String returns the human-readable representation of the System Exclusive event.
+func (see *SystemExclusiveEvent) String() string {
+	return fmt.Sprintf(SystemExclusiveEventStringFormat, EventVersion, see.GetMessageName(), see.Status, see.Data)
+}
+
+// UnmarshalMIDI unmarshalls raw track bytes into a SystemExclusiveEvent struct pointer.
+//
+// Example: []byte{0xF0, 0x05, 0x7E, 0x7F, 0x09, 0x01, 0xF7}
+//
+// The example forms a System Exclusive event holding a General MIDI System On message.
+func (see *SystemExclusiveEvent) UnmarshalMIDI(b []byte) error {
+	data, n, err := parseSystemExclusiveEvent(b)
+	if err != nil {
+		return err
+	}
+	if n != len(b) {
+		return fmt.Errorf("system exclusive event is made up of %d bytes, received %d byte(s): %w", n, len(b), ErrUnmarshallingFile)
+	}
+
+	*see = SystemExclusiveEvent{
+		Status: b[0],
+		Data:   append([]byte(nil), data...),
+	}
+	return nil
+}
+
+// parseSystemExclusiveEvent returns the data of the System Exclusive event at the start of b, along with the number of
+// bytes it occupies.
+func parseSystemExclusiveEvent(b []byte) ([]byte, int, error) {
+	if len(b) < 2 {
+		return nil, 0, fmt.Errorf("system exclusive events are made up of at least 2 bytes, received %d byte(s): %w", len(b), ErrUnmarshallingFile)
+	}
+	if b[0] != SystemExclusiveEventStatus && b[0] != EscapeEventStatus {
+		return nil, 0, fmt.Errorf("system exclusive events must start with %#x or %#x, received %#x: %w", SystemExclusiveEventStatus, EscapeEventStatus, b[0], ErrUnmarshallingFile)
+	}
+	length, n, err := ParseVariableLengthQuantity(b[1:])
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid system exclusive event length (%v): %w", err, ErrUnmarshallingFile)
+	}
+	start := 1 + n
+	if uint64(len(b)-start) < uint64(length) {
+		return nil, 0, fmt.Errorf("system exclusive event data is %d byte(s), received %d byte(s): %w", length, len(b)-start, ErrUnmarshallingFile)
+	}
+	end := start + int(length)
+	return b[start:end], end, nil
+}
@@ -0,0 +1,120 @@
+package smf
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func Test_SystemExclusiveEvent_GetMessageName(t *testing.T) {
+	t.Parallel()
+	event := SystemExclusiveEvent{}
+	expected := "System Exclusive Event"
+	if event.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, event.GetMessageName())
+	}
+}
+
+func Test_SystemExclusiveEvent_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		event    SystemExclusiveEvent
+		expected []byte
+		err      error
+	}{
+		"event has an invalid status": {
+			event: SystemExclusiveEvent{
+				Status: 0xF1,
+			},
+			err: ErrMarshallingFile,
+		},
+		"event marshalls into expected bytes": {
+			event: SystemExclusiveEvent{
+				Status: SystemExclusiveEventStatus,
+				Data:   []byte{0x7E, 0x7F, 0x09, 0x01, 0xF7},
+			},
+			expected: []byte{0xF0, 0x05, 0x7E, 0x7F, 0x09, 0x01, 0xF7},
+		},
+		"escape event marshalls into expected bytes": {
+			event: SystemExclusiveEvent{
+				Status: EscapeEventStatus,
+				Data:   []byte{0xF3, 0x01},
+			},
+			expected: []byte{0xF7, 0x02, 0xF3, 0x01},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.event.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_SystemExclusiveEvent_String(t *testing.T) {
+	t.Parallel()
+	event := SystemExclusiveEvent{
+		Status: SystemExclusiveEventStatus,
+		Data:   []byte{0x43, 0xF7},
+	}
+	expected := fmt.Sprintf("%s:%s:%s:%s", EventVersion, "System Exclusive Event", "0xf0", "43 F7")
+	if event.String() != expected {
+		t.Fatalf("expected %s, got %s", expected, event.String())
+	}
+}
+
+func Test_SystemExclusiveEvent_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b             []byte
+		expectedEvent SystemExclusiveEvent
+		err           error
+	}{
+		"byte slice is too short": {
+			b:   []byte{0xF0},
+			err: ErrUnmarshallingFile,
+		},
+		"first byte is not a system exclusive status": {
+			b:   []byte{0xF1, 0x00},
+			err: ErrUnmarshallingFile,
+		},
+		"data does not match its length": {
+			b:   []byte{0xF0, 0x02, 0x43},
+			err: ErrUnmarshallingFile,
+		},
+		"bytes unmarshal into expected event": {
+			b: []byte{0xF0, 0x02, 0x43, 0xF7},
+			expectedEvent: SystemExclusiveEvent{
+				Status: SystemExclusiveEventStatus,
+				Data:   []byte{0x43, 0xF7},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got SystemExclusiveEvent
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedEvent, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedEvent, got)
+			}
+		})
+	}
+}
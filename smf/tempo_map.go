@@ -0,0 +1,295 @@
+package smf
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"sort"
+	"time"
+)
+
+const (
+	// BarsBeatsTicksStringFormat represents the printf-compatible format for the string representation of a musical position.
+	BarsBeatsTicksStringFormat string = "%d:%d:%d"
+)
+
+// BarsBeatsTicks represents a musical position as a bar, a beat within the bar and a tick within the beat. Bars and
+// beats are counted from 1, as on a sequencer display, while ticks are counted from 0.
+type BarsBeatsTicks struct {
+	// Bar represents the bar number, starting at 1.
+	Bar int
+
+	// Beat represents the beat within the bar, starting at 1. A beat is the note value of the time signature denominator.
+	Beat int
+
+	// Tick represents the tick within the beat, starting at 0.
+	Tick int
+}
+
+// String returns the human-readable representation of the musical position.
+func (bbt BarsBeatsTicks) String() string {
+	return fmt.Sprintf(BarsBeatsTicksStringFormat, bbt.Bar, bbt.Beat, bbt.Tick)
+}
+
+// tempoSegment represents a stretch of ticks that share a single tempo. The length of a tick within the segment is
+// numerator / denominator nanoseconds.
+type tempoSegment struct {
+	// tick is the absolute tick at which the segment starts
+	tick uint64
+
+	// start is the time at which the segment starts
+	start time.Duration
+
+	// numerator is the numerator of the length of a tick in nanoseconds
+	numerator uint64
+
+	// denominator is the denominator of the length of a tick in nanoseconds
+	denominator uint64
+}
+
+// meterSegment represents a stretch of ticks that share a single time signature.
+type meterSegment struct {
+	// tick is the absolute tick at which the segment starts
+	tick uint64
+
+	// bar is the bar number, counted from 0, at which the segment starts
+	bar uint64
+
+	// beatsPerBar is the time signature numerator
+	beatsPerBar uint64
+
+	// ticksPerBeat is the number of ticks in a beat of the time signature
+	ticksPerBeat uint64
+}
+
+// TempoMap converts between absolute tick positions within a sequence, wall-clock time and musical positions, following
+// the Set Tempo and Time Signature meta events of the sequence.
+//
+// Before the first Set Tempo meta event the tempo is 120 BPM, and before the first Time Signature meta event the time
+// signature is 4/4. Time-code-based (SMPTE) divisions have a fixed length of tick, so Set Tempo meta events do not
+// affect them and musical positions are not available.
+type TempoMap struct {
+	// division is the timing division of the sequence
+	division Division
+
+	// tempos holds the tempo segments of the sequence in tick order, starting at tick 0
+	tempos []tempoSegment
+
+	// meters holds the time signature segments of the sequence in tick order, starting at tick 0
+	meters []meterSegment
+}
+
+// NewTempoMap returns a TempoMap for the supplied division, built from the Set Tempo and Time Signature meta events
+// found in the supplied tracks. The tracks are played simultaneously, as in a format 0 or format 1 file, so a format 2
+// pattern should be passed on its own. When several events share a tick, the last one read wins.
+func NewTempoMap(division Division, tracks ...Track) (*TempoMap, error) {
+	if division == 0 || (division.IsSMPTE() && (division.TicksPerFrame() == 0 || division.FramesPerSecond() <= 0)) {
+		return nil, fmt.Errorf("division %#x cannot be used to measure time: %w", uint16(division), ErrInvalidDivision)
+	}
+
+	var tempos []timedMetaEvent
+	var meters []timedMetaEvent
+	for _, track := range tracks {
+		var tick uint64
+		for _, event := range track.Events {
+			tick += uint64(event.DeltaTime)
+			switch message := event.Message.(type) {
+			case *SetTempoMetaEvent:
+				tempos = append(tempos, timedMetaEvent{tick: tick, tempo: *message})
+			case SetTempoMetaEvent:
+				tempos = append(tempos, timedMetaEvent{tick: tick, tempo: message})
+			case *TimeSignatureMetaEvent:
+				meters = append(meters, timedMetaEvent{tick: tick, signature: *message})
+			case TimeSignatureMetaEvent:
+				meters = append(meters, timedMetaEvent{tick: tick, signature: message})
+			}
+		}
+	}
+
+	tm := &TempoMap{
+		division: division,
+	}
+	if err := tm.buildTempos(tempos); err != nil {
+		return nil, err
+	}
+	if err := tm.buildMeters(meters); err != nil {
+		return nil, err
+	}
+	return tm, nil
+}
+
+// TempoMap returns a TempoMap built from every track of the file.
+func (f *File) TempoMap() (*TempoMap, error) {
+	return NewTempoMap(f.Division, f.Tracks...)
+}
+
+// timedMetaEvent holds a Set Tempo or Time Signature meta event along with its absolute tick.
+type timedMetaEvent struct {
+	tick      uint64
+	tempo     SetTempoMetaEvent
+	signature TimeSignatureMetaEvent
+}
+
+// buildTempos fills in the tempo segments of the map from the Set Tempo meta events of the sequence.
+func (tm *TempoMap) buildTempos(events []timedMetaEvent) error {
+	if tm.division.IsSMPTE() {
+		// every tick lasts 1 / (frames per second * ticks per frame) seconds, where 29 represents 30000/1001 frames per second
+		numerator, denominator := uint64(time.Second), uint64(tm.division.FramesPerSecond()*tm.division.TicksPerFrame())
+		if tm.division.FramesPerSecond() == 29 {
+			numerator, denominator = uint64(time.Second)*1001, uint64(30000*tm.division.TicksPerFrame())
+		}
+		tm.tempos = []tempoSegment{{numerator: numerator, denominator: denominator}}
+		return nil
+	}
+
+	ppq := uint64(tm.division.TicksPerQuarterNote())
+	tm.tempos = []tempoSegment{{
+		numerator:   uint64(DefaultMicrosecondsPerQuarterNote) * uint64(time.Microsecond),
+		denominator: ppq,
+	}}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].tick < events[j].tick
+	})
+	for _, event := range events {
+		mpqn := event.tempo.MicrosecondsPerQuarterNote
+		if mpqn == 0 || mpqn > MaxMicrosecondsPerQuarterNote {
+			return fmt.Errorf("invalid tempo of %d microseconds per quarter note at tick %d: %w", mpqn, event.tick, ErrInvalidTempoMap)
+		}
+		segment := tempoSegment{
+			tick:        event.tick,
+			start:       tm.Duration(event.tick),
+			numerator:   uint64(mpqn) * uint64(time.Microsecond),
+			denominator: ppq,
+		}
+		if last := &tm.tempos[len(tm.tempos)-1]; last.tick == event.tick {
+			*last = segment
+			continue
+		}
+		tm.tempos = append(tm.tempos, segment)
+	}
+	return nil
+}
+
+// buildMeters fills in the time signature segments of the map from the Time Signature meta events of the sequence. A
+// time signature that changes partway through a bar starts a new bar.
+func (tm *TempoMap) buildMeters(events []timedMetaEvent) error {
+	if tm.division.IsSMPTE() {
+		return nil
+	}
+
+	ppq := uint64(tm.division.TicksPerQuarterNote())
+	tm.meters = []meterSegment{{
+		beatsPerBar:  4,
+		ticksPerBeat: ppq,
+	}}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].tick < events[j].tick
+	})
+	for _, event := range events {
+		signature := event.signature
+		if signature.Numerator < 1 || signature.Denominator < 1 || signature.Denominator&(signature.Denominator-1) != 0 {
+			return fmt.Errorf("invalid time signature %d/%d at tick %d: %w", signature.Numerator, signature.Denominator, event.tick, ErrInvalidTempoMap)
+		}
+		if (ppq*4)%uint64(signature.Denominator) != 0 {
+			return fmt.Errorf("time signature %d/%d at tick %d does not have a whole number of ticks per beat at %d ticks per quarter note: %w", signature.Numerator, signature.Denominator, event.tick, ppq, ErrInvalidTempoMap)
+		}
+
+		last := tm.meters[len(tm.meters)-1]
+		ticksPerBar := last.beatsPerBar * last.ticksPerBeat
+		segment := meterSegment{
+			tick:         event.tick,
+			bar:          last.bar + (event.tick-last.tick+ticksPerBar-1)/ticksPerBar,
+			beatsPerBar:  uint64(signature.Numerator),
+			ticksPerBeat: ppq * 4 / uint64(signature.Denominator),
+		}
+		if last.tick == event.tick {
+			segment.bar = last.bar
+			tm.meters[len(tm.meters)-1] = segment
+			continue
+		}
+		tm.meters = append(tm.meters, segment)
+	}
+	return nil
+}
+
+// Duration returns the time from the start of the sequence to the supplied absolute tick, rounded to the nearest
+// nanosecond.
+func (tm *TempoMap) Duration(tick uint64) time.Duration {
+	i := sort.Search(len(tm.tempos), func(i int) bool {
+		return tm.tempos[i].tick > tick
+	}) - 1
+	segment := tm.tempos[i]
+	elapsed := mulDivRound(tick-segment.tick, segment.numerator, segment.denominator)
+	if elapsed > uint64(math.MaxInt64-segment.start) {
+		return time.Duration(math.MaxInt64)
+	}
+	return segment.start + time.Duration(elapsed)
+}
+
+// Tick returns the absolute tick nearest to the supplied time from the start of the sequence. Negative times return tick 0.
+func (tm *TempoMap) Tick(d time.Duration) uint64 {
+	if d <= 0 {
+		return 0
+	}
+	i := sort.Search(len(tm.tempos), func(i int) bool {
+		return tm.tempos[i].start > d
+	}) - 1
+	segment := tm.tempos[i]
+	elapsed := mulDivRound(uint64(d-segment.start), segment.denominator, segment.numerator)
+	if elapsed > math.MaxUint64-segment.tick {
+		return math.MaxUint64
+	}
+	return segment.tick + elapsed
+}
+
+// BarsBeatsTicks returns the musical position of the supplied absolute tick. An error is returned for time-code-based
+// divisions, which have no musical positions.
+func (tm *TempoMap) BarsBeatsTicks(tick uint64) (BarsBeatsTicks, error) {
+	if tm.division.IsSMPTE() {
+		return BarsBeatsTicks{}, fmt.Errorf("SMPTE divisions do not have bars and beats: %w", ErrInvalidDivision)
+	}
+	i := sort.Search(len(tm.meters), func(i int) bool {
+		return tm.meters[i].tick > tick
+	}) - 1
+	segment := tm.meters[i]
+	offset := tick - segment.tick
+	ticksPerBar := segment.beatsPerBar * segment.ticksPerBeat
+	return BarsBeatsTicks{
+		Bar:  int(segment.bar+offset/ticksPerBar) + 1,
+		Beat: int(offset%ticksPerBar/segment.ticksPerBeat) + 1,
+		Tick: int(offset % segment.ticksPerBeat),
+	}, nil
+}
+
+// TickFromBarsBeatsTicks returns the absolute tick of the supplied musical position. An error is returned for
+// time-code-based divisions, and for positions whose beat or tick does not exist in the time signature of their bar.
+func (tm *TempoMap) TickFromBarsBeatsTicks(bbt BarsBeatsTicks) (uint64, error) {
+	if tm.division.IsSMPTE() {
+		return 0, fmt.Errorf("SMPTE divisions do not have bars and beats: %w", ErrInvalidDivision)
+	}
+	if bbt.Bar < 1 || bbt.Beat < 1 || bbt.Tick < 0 {
+		return 0, fmt.Errorf("invalid musical position %s: bars and beats start at 1 and ticks start at 0: %w", bbt, ErrInvalidTempoMap)
+	}
+	bar := uint64(bbt.Bar - 1)
+	i := sort.Search(len(tm.meters), func(i int) bool {
+		return tm.meters[i].bar > bar
+	}) - 1
+	segment := tm.meters[i]
+	if uint64(bbt.Beat) > segment.beatsPerBar || uint64(bbt.Tick) >= segment.ticksPerBeat {
+		return 0, fmt.Errorf("musical position %s does not exist with %d beats of %d ticks per bar: %w", bbt, segment.beatsPerBar, segment.ticksPerBeat, ErrInvalidTempoMap)
+	}
+	return segment.tick + (bar-segment.bar)*segment.beatsPerBar*segment.ticksPerBeat + uint64(bbt.Beat-1)*segment.ticksPerBeat + uint64(bbt.Tick), nil
+}
+
+// mulDivRound returns a * b / c rounded to the nearest whole number, without overflowing the intermediate product. Results
+// that do not fit within a uint64 return the largest uint64.
+func mulDivRound(a, b, c uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	lo, carry := bits.Add64(lo, c/2, 0)
+	hi += carry
+	if hi >= c {
+		return math.MaxUint64
+	}
+	q, _ := bits.Div64(hi, lo, c)
+	return q
+}
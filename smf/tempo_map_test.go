@@ -0,0 +1,292 @@
+package smf
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/matthewfritz/go-midi/midiv1"
+)
+
+// tempoMapTestTrack returns a conductor track at 96 ticks per quarter note with a tempo change from 120 BPM to 60 BPM
+// after 2 bars of 4/4, followed by a change to 6/8 after 2 further bars.
+func tempoMapTestTrack() Track {
+	var track Track
+	track.AddEvent(0, &TrackNameMetaEvent{Text: "Conductor"})
+	track.AddEvent(0, &SetTempoMetaEvent{MicrosecondsPerQuarterNote: 500000})
+	track.AddEvent(768, &SetTempoMetaEvent{MicrosecondsPerQuarterNote: 1000000})
+	track.AddEvent(768, &TimeSignatureMetaEvent{Numerator: 6, Denominator: 8, ClocksPerClick: 36, ThirtySecondNotesPerQuarterNote: 8})
+	track.AddEvent(0, &EndOfTrackMetaEvent{})
+	return track
+}
+
+func Test_NewTempoMap(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		division Division
+		tracks   []Track
+		err      error
+	}{
+		"zero division": {
+			division: 0,
+			err:      ErrInvalidDivision,
+		},
+		"invalid tempo": {
+			division: 96,
+			tracks:   []Track{{Events: []Event{{Message: &SetTempoMetaEvent{}}}}},
+			err:      ErrInvalidTempoMap,
+		},
+		"invalid time signature": {
+			division: 96,
+			tracks:   []Track{{Events: []Event{{Message: &TimeSignatureMetaEvent{Numerator: 3, Denominator: 6}}}}},
+			err:      ErrInvalidTempoMap,
+		},
+		"beat without a whole number of ticks": {
+			division: 12,
+			tracks:   []Track{{Events: []Event{{Message: &TimeSignatureMetaEvent{Numerator: 3, Denominator: 64}}}}},
+			err:      ErrInvalidTempoMap,
+		},
+		"valid tracks": {
+			division: 96,
+			tracks:   []Track{tempoMapTestTrack()},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewTempoMap(test.division, test.tracks...)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func Test_TempoMap_Duration(t *testing.T) {
+	t.Parallel()
+	var notes Track
+	notes.AddEvent(1000, &midiv1.NoteOnMessage{Note: 60, Velocity: 100})
+	conductor := tempoMapTestTrack()
+	tm, err := NewTempoMap(96, notes, conductor)
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	tests := map[string]struct {
+		tick     uint64
+		expected time.Duration
+	}{
+		"start of sequence": {
+			tick:     0,
+			expected: 0,
+		},
+		"one quarter note at 120 BPM": {
+			tick:     96,
+			expected: 500 * time.Millisecond,
+		},
+		"tempo change": {
+			tick:     768,
+			expected: 4 * time.Second,
+		},
+		"one quarter note after tempo change": {
+			tick:     864,
+			expected: 5 * time.Second,
+		},
+		"one tick after tempo change": {
+			tick:     769,
+			expected: 4*time.Second + 10416667*time.Nanosecond,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tm.Duration(test.tick)
+			if got != test.expected {
+				t.Fatalf("expected %v, got %v", test.expected, got)
+			}
+			if tick := tm.Tick(got); tick != test.tick {
+				t.Fatalf("expected tick %d, got %d", test.tick, tick)
+			}
+		})
+	}
+}
+
+func Test_TempoMap_Tick(t *testing.T) {
+	t.Parallel()
+	tm, err := NewTempoMap(96, tempoMapTestTrack())
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	tests := map[string]struct {
+		d        time.Duration
+		expected uint64
+	}{
+		"negative time": {
+			d:        -time.Second,
+			expected: 0,
+		},
+		"before tempo change": {
+			d:        time.Second,
+			expected: 192,
+		},
+		"after tempo change": {
+			d:        6 * time.Second,
+			expected: 960,
+		},
+		"between ticks rounds to the nearest tick": {
+			d:        4*time.Second + 6*time.Millisecond,
+			expected: 769,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tm.Tick(test.d)
+			if got != test.expected {
+				t.Fatalf("expected %d, got %d", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_TempoMap_SMPTE(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		framesPerSecond int
+		tick            uint64
+		expected        time.Duration
+	}{
+		"25 frames per second": {
+			framesPerSecond: 25,
+			tick:            2 * 25 * 40,
+			expected:        2 * time.Second,
+		},
+		"30 drop-frame frames per second": {
+			framesPerSecond: 29,
+			tick:            30 * 40,
+			expected:        1001 * time.Millisecond,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			division, err := NewSMPTEDivision(test.framesPerSecond, 40)
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			tm, err := NewTempoMap(division, tempoMapTestTrack())
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if got := tm.Duration(test.tick); got != test.expected {
+				t.Fatalf("expected %v, got %v", test.expected, got)
+			}
+			if _, err := tm.BarsBeatsTicks(test.tick); !errors.Is(err, ErrInvalidDivision) {
+				t.Fatalf("expected %v error, got %v", ErrInvalidDivision, err)
+			}
+		})
+	}
+}
+
+func Test_TempoMap_BarsBeatsTicks(t *testing.T) {
+	t.Parallel()
+	var track Track
+	track.AddEvent(0, &TimeSignatureMetaEvent{Numerator: 3, Denominator: 4})
+	track.AddEvent(600, &TimeSignatureMetaEvent{Numerator: 6, Denominator: 8})
+	track.AddEvent(0, &EndOfTrackMetaEvent{})
+	tm, err := NewTempoMap(96, tempoMapTestTrack(), track)
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	tests := map[string]struct {
+		tick     uint64
+		expected BarsBeatsTicks
+	}{
+		"start of sequence": {
+			tick:     0,
+			expected: BarsBeatsTicks{Bar: 1, Beat: 1, Tick: 0},
+		},
+		"later in the first bar": {
+			tick:     200,
+			expected: BarsBeatsTicks{Bar: 1, Beat: 3, Tick: 8},
+		},
+		"time signature change partway through a bar": {
+			tick:     600,
+			expected: BarsBeatsTicks{Bar: 4, Beat: 1, Tick: 0},
+		},
+		"eighth note beats": {
+			tick:     600 + 48*7 + 5,
+			expected: BarsBeatsTicks{Bar: 5, Beat: 2, Tick: 5},
+		},
+		"second time signature change partway through a bar": {
+			tick:     1536,
+			expected: BarsBeatsTicks{Bar: 8, Beat: 1, Tick: 0},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := tm.BarsBeatsTicks(test.tick)
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if got != test.expected {
+				t.Fatalf("expected %s, got %s", test.expected, got)
+			}
+			tick, err := tm.TickFromBarsBeatsTicks(got)
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if tick != test.tick {
+				t.Fatalf("expected tick %d, got %d", test.tick, tick)
+			}
+		})
+	}
+}
+
+func Test_TempoMap_TickFromBarsBeatsTicks(t *testing.T) {
+	t.Parallel()
+	tm, err := NewTempoMap(96, tempoMapTestTrack())
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	tests := map[string]struct {
+		bbt      BarsBeatsTicks
+		expected uint64
+		err      error
+	}{
+		"bar zero": {
+			bbt: BarsBeatsTicks{Bar: 0, Beat: 1},
+			err: ErrInvalidTempoMap,
+		},
+		"beat beyond the time signature": {
+			bbt: BarsBeatsTicks{Bar: 5, Beat: 7},
+			err: ErrInvalidTempoMap,
+		},
+		"tick beyond the beat": {
+			bbt: BarsBeatsTicks{Bar: 5, Beat: 1, Tick: 48},
+			err: ErrInvalidTempoMap,
+		},
+		"position after time signature change": {
+			bbt:      BarsBeatsTicks{Bar: 5, Beat: 6, Tick: 1},
+			expected: 1536 + 5*48 + 1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := tm.TickFromBarsBeatsTicks(test.bbt)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if got != test.expected {
+				t.Fatalf("expected %d, got %d", test.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,267 @@
+package smf
+
+import "fmt"
+
+const (
+	// TextMetaEventStringFormat represents the printf-compatible format for the string representation of a text-based meta event.
+	TextMetaEventStringFormat string = "%s:%s:%q"
+)
+
+// TextMetaEvent represents a Text meta event, which holds any text, such as comments about the sequence.
+type TextMetaEvent struct {
+	// Text represents the text of the event.
+	Text string
+}
+
+// GetMessageName returns the name of this Text meta event.
+func (tme *TextMetaEvent) GetMessageName() string {
+	return "Text"
+}
+
+// MarshalMIDI marshalls a TextMetaEvent into its raw track bytes.
+func (tme TextMetaEvent) MarshalMIDI() ([]byte, error) {
+	return marshalMetaEvent(TextMetaEventType, []byte(tme.Text))
+}
+
+// isFileEvent marks Text meta events as events that are written to tracks as-is.
+func (tme TextMetaEvent) isFileEvent() {}
+
+// String returns the human-readable representation of the meta event.
+func (tme *TextMetaEvent) String() string {
+	return fmt.Sprintf(TextMetaEventStringFormat, EventVersion, tme.GetMessageName(), tme.Text)
+}
+
+// UnmarshalMIDI unmarshalls raw track bytes into a TextMetaEvent struct pointer.
+func (tme *TextMetaEvent) UnmarshalMIDI(b []byte) error {
+	data, err := unmarshalMetaEvent("text", TextMetaEventType, -1, b)
+	if err != nil {
+		return err
+	}
+
+	*tme = TextMetaEvent{
+		Text: string(data),
+	}
+	return nil
+}
+
+// CopyrightMetaEvent represents a Copyright Notice meta event, which holds a copyright notice, which should be the first event of the first track.
+type CopyrightMetaEvent struct {
+	// Text represents the text of the event.
+	Text string
+}
+
+// GetMessageName returns the name of this Copyright Notice meta event.
+func (cme *CopyrightMetaEvent) GetMessageName() string {
+	return "Copyright Notice"
+}
+
+// MarshalMIDI marshalls a CopyrightMetaEvent into its raw track bytes.
+func (cme CopyrightMetaEvent) MarshalMIDI() ([]byte, error) {
+	return marshalMetaEvent(CopyrightMetaEventType, []byte(cme.Text))
+}
+
+// isFileEvent marks Copyright Notice meta events as events that are written to tracks as-is.
+func (cme CopyrightMetaEvent) isFileEvent() {}
+
+// String returns the human-readable representation of the meta event.
+func (cme *CopyrightMetaEvent) String() string {
+	return fmt.Sprintf(TextMetaEventStringFormat, EventVersion, cme.GetMessageName(), cme.Text)
+}
+
+// UnmarshalMIDI unmarshalls raw track bytes into a CopyrightMetaEvent struct pointer.
+func (cme *CopyrightMetaEvent) UnmarshalMIDI(b []byte) error {
+	data, err := unmarshalMetaEvent("copyright", CopyrightMetaEventType, -1, b)
+	if err != nil {
+		return err
+	}
+
+	*cme = CopyrightMetaEvent{
+		Text: string(data),
+	}
+	return nil
+}
+
+// TrackNameMetaEvent represents a Track Name meta event, which holds the name of the sequence (in a format 0 file or the first track of a format 1 file) or of the track.
+type TrackNameMetaEvent struct {
+	// Text represents the text of the event.
+	Text string
+}
+
+// GetMessageName returns the name of this Track Name meta event.
+func (tnme *TrackNameMetaEvent) GetMessageName() string {
+	return "Track Name"
+}
+
+// MarshalMIDI marshalls a TrackNameMetaEvent into its raw track bytes.
+func (tnme TrackNameMetaEvent) MarshalMIDI() ([]byte, error) {
+	return marshalMetaEvent(TrackNameMetaEventType, []byte(tnme.Text))
+}
+
+// isFileEvent marks Track Name meta events as events that are written to tracks as-is.
+func (tnme TrackNameMetaEvent) isFileEvent() {}
+
+// String returns the human-readable representation of the meta event.
+func (tnme *TrackNameMetaEvent) String() string {
+	return fmt.Sprintf(TextMetaEventStringFormat, EventVersion, tnme.GetMessageName(), tnme.Text)
+}
+
+// UnmarshalMIDI unmarshalls raw track bytes into a TrackNameMetaEvent struct pointer.
+func (tnme *TrackNameMetaEvent) UnmarshalMIDI(b []byte) error {
+	data, err := unmarshalMetaEvent("track name", TrackNameMetaEventType, -1, b)
+	if err != nil {
+		return err
+	}
+
+	*tnme = TrackNameMetaEvent{
+		Text: string(data),
+	}
+	return nil
+}
+
+// InstrumentNameMetaEvent represents a Instrument Name meta event, which holds a description of the instrumentation used by the track.
+type InstrumentNameMetaEvent struct {
+	// Text represents the text of the event.
+	Text string
+}
+
+// GetMessageName returns the name of this Instrument Name meta event.
+func (inme *InstrumentNameMetaEvent) GetMessageName() string {
+	return "Instrument Name"
+}
+
+// MarshalMIDI marshalls a InstrumentNameMetaEvent into its raw track bytes.
+func (inme InstrumentNameMetaEvent) MarshalMIDI() ([]byte, error) {
+	return marshalMetaEvent(InstrumentNameMetaEventType, []byte(inme.Text))
+}
+
+// isFileEvent marks Instrument Name meta events as events that are written to tracks as-is.
+func (inme InstrumentNameMetaEvent) isFileEvent() {}
+
+// String returns the human-readable representation of the meta event.
+func (inme *InstrumentNameMetaEvent) String() string {
+	return fmt.Sprintf(TextMetaEventStringFormat, EventVersion, inme.GetMessageName(), inme.Text)
+}
+
+// UnmarshalMIDI unmarshalls raw track bytes into a InstrumentNameMetaEvent struct pointer.
+func (inme *InstrumentNameMetaEvent) UnmarshalMIDI(b []byte) error {
+	data, err := unmarshalMetaEvent("instrument name", InstrumentNameMetaEventType, -1, b)
+	if err != nil {
+		return err
+	}
+
+	*inme = InstrumentNameMetaEvent{
+		Text: string(data),
+	}
+	return nil
+}
+
+// LyricMetaEvent represents a Lyric meta event, which holds a lyric to be sung, normally one syllable per event.
+type LyricMetaEvent struct {
+	// Text represents the text of the event.
+	Text string
+}
+
+// GetMessageName returns the name of this Lyric meta event.
+func (lme *LyricMetaEvent) GetMessageName() string {
+	return "Lyric"
+}
+
+// MarshalMIDI marshalls a LyricMetaEvent into its raw track bytes.
+func (lme LyricMetaEvent) MarshalMIDI() ([]byte, error) {
+	return marshalMetaEvent(LyricMetaEventType, []byte(lme.Text))
+}
+
+// isFileEvent marks Lyric meta events as events that are written to tracks as-is.
+func (lme LyricMetaEvent) isFileEvent() {}
+
+// String returns the human-readable representation of the meta event.
+func (lme *LyricMetaEvent) String() string {
+	return fmt.Sprintf(TextMetaEventStringFormat, EventVersion, lme.GetMessageName(), lme.Text)
+}
+
+// UnmarshalMIDI unmarshalls raw track bytes into a LyricMetaEvent struct pointer.
+func (lme *LyricMetaEvent) UnmarshalMIDI(b []byte) error {
+	data, err := unmarshalMetaEvent("lyric", LyricMetaEventType, -1, b)
+	if err != nil {
+		return err
+	}
+
+	*lme = LyricMetaEvent{
+		Text: string(data),
+	}
+	return nil
+}
+
+// MarkerMetaEvent represents a Marker meta event, which holds the name of a point in the sequence, such as a rehearsal letter or section name.
+type MarkerMetaEvent struct {
+	// Text represents the text of the event.
+	Text string
+}
+
+// GetMessageName returns the name of this Marker meta event.
+func (mme *MarkerMetaEvent) GetMessageName() string {
+	return "Marker"
+}
+
+// MarshalMIDI marshalls a MarkerMetaEvent into its raw track bytes.
+func (mme MarkerMetaEvent) MarshalMIDI() ([]byte, error) {
+	return marshalMetaEvent(MarkerMetaEventType, []byte(mme.Text))
+}
+
+// isFileEvent marks Marker meta events as events that are written to tracks as-is.
+func (mme MarkerMetaEvent) isFileEvent() {}
+
+// String returns the human-readable representation of the meta event.
+func (mme *MarkerMetaEvent) String() string {
+	return fmt.Sprintf(TextMetaEventStringFormat, EventVersion, mme.GetMessageName(), mme.Text)
+}
+
+// UnmarshalMIDI unmarshalls raw track bytes into a MarkerMetaEvent struct pointer.
+func (mme *MarkerMetaEvent) UnmarshalMIDI(b []byte) error {
+	data, err := unmarshalMetaEvent("marker", MarkerMetaEventType, -1, b)
+	if err != nil {
+		return err
+	}
+
+	*mme = MarkerMetaEvent{
+		Text: string(data),
+	}
+	return nil
+}
+
+// CuePointMetaEvent represents a Cue Point meta event, which holds a description of something happening on a film or video screen or stage at that point.
+type CuePointMetaEvent struct {
+	// Text represents the text of the event.
+	Text string
+}
+
+// GetMessageName returns the name of this Cue Point meta event.
+func (cpme *CuePointMetaEvent) GetMessageName() string {
+	return "Cue Point"
+}
+
+// MarshalMIDI marshalls a CuePointMetaEvent into its raw track bytes.
+func (cpme CuePointMetaEvent) MarshalMIDI() ([]byte, error) {
+	return marshalMetaEvent(CuePointMetaEventType, []byte(cpme.Text))
+}
+
+// isFileEvent marks Cue Point meta events as events that are written to tracks as-is.
+func (cpme CuePointMetaEvent) isFileEvent() {}
+
+// String returns the human-readable representation of the meta event.
+func (cpme *CuePointMetaEvent) String() string {
+	return fmt.Sprintf(TextMetaEventStringFormat, EventVersion, cpme.GetMessageName(), cpme.Text)
+}
+
+// UnmarshalMIDI unmarshalls raw track bytes into a CuePointMetaEvent struct pointer.
+func (cpme *CuePointMetaEvent) UnmarshalMIDI(b []byte) error {
+	data, err := unmarshalMetaEvent("cue point", CuePointMetaEventType, -1, b)
+	if err != nil {
+		return err
+	}
+
+	*cpme = CuePointMetaEvent{
+		Text: string(data),
+	}
+	return nil
+}
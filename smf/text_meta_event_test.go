@@ -0,0 +1,140 @@
+package smf
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func Test_TextMetaEvents_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		event interface {
+			MarshalMIDI() ([]byte, error)
+		}
+		expected []byte
+	}{
+		"text event marshalls into expected bytes": {
+			event:    TextMetaEvent{Text: "Hi"},
+			expected: []byte{0xFF, 0x01, 0x02, 0x48, 0x69},
+		},
+		"copyright event marshalls into expected bytes": {
+			event:    CopyrightMetaEvent{Text: "Hi"},
+			expected: []byte{0xFF, 0x02, 0x02, 0x48, 0x69},
+		},
+		"track name event marshalls into expected bytes": {
+			event:    TrackNameMetaEvent{Text: "Hi"},
+			expected: []byte{0xFF, 0x03, 0x02, 0x48, 0x69},
+		},
+		"instrument name event marshalls into expected bytes": {
+			event:    InstrumentNameMetaEvent{Text: "Hi"},
+			expected: []byte{0xFF, 0x04, 0x02, 0x48, 0x69},
+		},
+		"lyric event marshalls into expected bytes": {
+			event:    LyricMetaEvent{Text: "Hi"},
+			expected: []byte{0xFF, 0x05, 0x02, 0x48, 0x69},
+		},
+		"marker event marshalls into expected bytes": {
+			event:    MarkerMetaEvent{Text: "Hi"},
+			expected: []byte{0xFF, 0x06, 0x02, 0x48, 0x69},
+		},
+		"cue point event marshalls into expected bytes": {
+			event:    CuePointMetaEvent{Text: "Hi"},
+			expected: []byte{0xFF, 0x07, 0x02, 0x48, 0x69},
+		},
+		"empty text marshalls into expected bytes": {
+			event:    MarkerMetaEvent{},
+			expected: []byte{0xFF, 0x06, 0x00},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.event.MarshalMIDI()
+			if err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_TextMetaEvents_String(t *testing.T) {
+	t.Parallel()
+	event := LyricMetaEvent{Text: "la"}
+	expected := fmt.Sprintf("%s:%s:%s", EventVersion, "Lyric", `"la"`)
+	if event.String() != expected {
+		t.Fatalf("expected %s, got %s", expected, event.String())
+	}
+}
+
+func Test_TextMetaEvents_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b             []byte
+		event         interface{ UnmarshalMIDI([]byte) error }
+		expectedEvent interface{}
+		err           error
+	}{
+		"text bytes unmarshal into expected event": {
+			b:             []byte{0xFF, 0x01, 0x02, 0x48, 0x69},
+			event:         &TextMetaEvent{},
+			expectedEvent: &TextMetaEvent{Text: "Hi"},
+		},
+		"copyright bytes unmarshal into expected event": {
+			b:             []byte{0xFF, 0x02, 0x02, 0x48, 0x69},
+			event:         &CopyrightMetaEvent{},
+			expectedEvent: &CopyrightMetaEvent{Text: "Hi"},
+		},
+		"track name bytes unmarshal into expected event": {
+			b:             []byte{0xFF, 0x03, 0x02, 0x48, 0x69},
+			event:         &TrackNameMetaEvent{},
+			expectedEvent: &TrackNameMetaEvent{Text: "Hi"},
+		},
+		"instrument name bytes unmarshal into expected event": {
+			b:             []byte{0xFF, 0x04, 0x02, 0x48, 0x69},
+			event:         &InstrumentNameMetaEvent{},
+			expectedEvent: &InstrumentNameMetaEvent{Text: "Hi"},
+		},
+		"lyric bytes unmarshal into expected event": {
+			b:             []byte{0xFF, 0x05, 0x02, 0x48, 0x69},
+			event:         &LyricMetaEvent{},
+			expectedEvent: &LyricMetaEvent{Text: "Hi"},
+		},
+		"marker bytes unmarshal into expected event": {
+			b:             []byte{0xFF, 0x06, 0x02, 0x48, 0x69},
+			event:         &MarkerMetaEvent{},
+			expectedEvent: &MarkerMetaEvent{Text: "Hi"},
+		},
+		"cue point bytes unmarshal into expected event": {
+			b:             []byte{0xFF, 0x07, 0x02, 0x48, 0x69},
+			event:         &CuePointMetaEvent{},
+			expectedEvent: &CuePointMetaEvent{Text: "Hi"},
+		},
+		"type does not match the event": {
+			b:             []byte{0xFF, 0x05, 0x02, 0x48, 0x69},
+			event:         &MarkerMetaEvent{},
+			expectedEvent: &MarkerMetaEvent{},
+			err:           ErrUnmarshallingFile,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.event.UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedEvent, test.event) {
+				t.Fatalf("expected %+v, got %+v", test.expectedEvent, test.event)
+			}
+		})
+	}
+}
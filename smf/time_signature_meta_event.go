@@ -0,0 +1,107 @@
+package smf
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+const (
+	// DefaultClocksPerClick represents the usual number of MIDI clocks in a metronome click (one quarter note).
+	DefaultClocksPerClick int = 24
+
+	// DefaultThirtySecondNotesPerQuarterNote represents the usual number of notated 32nd notes in a MIDI quarter note.
+	DefaultThirtySecondNotesPerQuarterNote int = 8
+
+	// MaxTimeSignatureDenominator represents the largest denominator that the Time Signature meta event can hold.
+	MaxTimeSignatureDenominator int = 1 << 7
+)
+
+// TimeSignatureMetaEvent represents a Time Signature meta event. A track without one is assumed to be in 4/4.
+type TimeSignatureMetaEvent struct {
+	// Numerator represents the number of beats in a bar, between 1 and 255 inclusive.
+	Numerator int
+
+	// Denominator represents the note value of a beat, such as 4 for a quarter note. It must be a power of two, and is
+	// stored within the event as its exponent.
+	Denominator int
+
+	// ClocksPerClick represents the number of MIDI clocks in a metronome click.
+	ClocksPerClick int
+
+	// ThirtySecondNotesPerQuarterNote represents the number of notated 32nd notes in a MIDI quarter note (24 MIDI clocks).
+	ThirtySecondNotesPerQuarterNote int
+}
+
+// NewTimeSignatureMetaEvent returns a TimeSignatureMetaEvent with the supplied numerator and denominator and the usual
+// metronome settings.
+func NewTimeSignatureMetaEvent(numerator, denominator int) TimeSignatureMetaEvent {
+	return TimeSignatureMetaEvent{
+		Numerator:                       numerator,
+		Denominator:                     denominator,
+		ClocksPerClick:                  DefaultClocksPerClick,
+		ThirtySecondNotesPerQuarterNote: DefaultThirtySecondNotesPerQuarterNote,
+	}
+}
+
+// GetMessageName returns the name of this Time Signature meta event.
+func (tsme *TimeSignatureMetaEvent) GetMessageName() string {
+	return "Time Signature"
+}
+
+// MarshalMIDI marshalls a TimeSignatureMetaEvent into its raw track bytes.
+func (tsme TimeSignatureMetaEvent) MarshalMIDI() ([]byte, error) {
+	if tsme.Numerator < 1 || tsme.Numerator > 255 {
+		return nil, fmt.Errorf("time signature numerators must be between 1 and 255, inclusive, received %d: %w", tsme.Numerator, ErrMarshallingFile)
+	}
+	if tsme.Denominator < 1 || tsme.Denominator > MaxTimeSignatureDenominator || tsme.Denominator&(tsme.Denominator-1) != 0 {
+		return nil, fmt.Errorf("time signature denominators must be a power of two up to %d, received %d: %w", MaxTimeSignatureDenominator, tsme.Denominator, ErrMarshallingFile)
+	}
+	if tsme.ClocksPerClick < 0 || tsme.ClocksPerClick > 255 {
+		return nil, fmt.Errorf("clocks per click must be between 0 and 255, inclusive, received %d: %w", tsme.ClocksPerClick, ErrMarshallingFile)
+	}
+	if tsme.ThirtySecondNotesPerQuarterNote < 0 || tsme.ThirtySecondNotesPerQuarterNote > 255 {
+		return nil, fmt.Errorf("32nd notes per quarter note must be between 0 and 255, inclusive, received %d: %w", tsme.ThirtySecondNotesPerQuarterNote, ErrMarshallingFile)
+	}
+	return marshalMetaEvent(TimeSignatureMetaEventType, []byte{
+		byte(tsme.Numerator),
+		byte(bits.TrailingZeros(uint(tsme.Denominator))),
+		byte(tsme.ClocksPerClick),
+		byte(tsme.ThirtySecondNotesPerQuarterNote),
+	})
+}
+
+// isFileEvent marks Time Signature meta events as events that are written to tracks as-is.
+func (tsme TimeSignatureMetaEvent) isFileEvent() {}
+
+// String returns the human-readable representation of the meta event.
+func (tsme *TimeSignatureMetaEvent) String() string {
+	return fmt.Sprintf("%s:%s:%d/%d:%d:%d", EventVersion, tsme.GetMessageName(), tsme.Numerator, tsme.Denominator, tsme.ClocksPerClick, tsme.ThirtySecondNotesPerQuarterNote)
+}
+
+// UnmarshalMIDI unmarshalls raw track bytes into a TimeSignatureMetaEvent struct pointer. Time Signature meta events
+// are represented by (left to right): 0xFF, 0x58, 0x04, numerator, denominator exponent, clocks per click, 32nd notes
+// per quarter note.
+//
+// Example: []byte{0xFF, 0x58, 0x04, 0x06, 0x03, 0x24, 0x08}
+//
+// The example forms a Time Signature meta event of 6/8 with a click every dotted quarter note.
+func (tsme *TimeSignatureMetaEvent) UnmarshalMIDI(b []byte) error {
+	data, err := unmarshalMetaEvent("time signature", TimeSignatureMetaEventType, 4, b)
+	if err != nil {
+		return err
+	}
+	if data[0] == 0 {
+		return fmt.Errorf("time signature numerators must be at least 1: %w", ErrUnmarshallingFile)
+	}
+	if int(data[1]) > bits.TrailingZeros(uint(MaxTimeSignatureDenominator)) {
+		return fmt.Errorf("time signature denominator exponents must be at most 7, received %d: %w", data[1], ErrUnmarshallingFile)
+	}
+
+	*tsme = TimeSignatureMetaEvent{
+		Numerator:                       int(data[0]),
+		Denominator:                     1 << data[1],
+		ClocksPerClick:                  int(data[2]),
+		ThirtySecondNotesPerQuarterNote: int(data[3]),
+	}
+	return nil
+}
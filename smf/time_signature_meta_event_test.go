@@ -0,0 +1,100 @@
+package smf
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_TimeSignatureMetaEvent_GetMessageName(t *testing.T) {
+	t.Parallel()
+	event := TimeSignatureMetaEvent{}
+	expected := "Time Signature"
+	if event.GetMessageName() != expected {
+		t.Fatalf("expected %s, got %s", expected, event.GetMessageName())
+	}
+}
+
+func Test_TimeSignatureMetaEvent_MarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		event    TimeSignatureMetaEvent
+		expected []byte
+		err      error
+	}{
+		"numerator is zero": {
+			event: TimeSignatureMetaEvent{Denominator: 4},
+			err:   ErrMarshallingFile,
+		},
+		"denominator is not a power of two": {
+			event: NewTimeSignatureMetaEvent(3, 6),
+			err:   ErrMarshallingFile,
+		},
+		"denominator is too large": {
+			event: NewTimeSignatureMetaEvent(3, 256),
+			err:   ErrMarshallingFile,
+		},
+		"event marshalls into expected bytes": {
+			event:    TimeSignatureMetaEvent{Numerator: 6, Denominator: 8, ClocksPerClick: 36, ThirtySecondNotesPerQuarterNote: 8},
+			expected: []byte{0xFF, 0x58, 0x04, 0x06, 0x03, 0x24, 0x08},
+		},
+		"default metronome marshalls into expected bytes": {
+			event:    NewTimeSignatureMetaEvent(4, 4),
+			expected: []byte{0xFF, 0x58, 0x04, 0x04, 0x02, 0x18, 0x08},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.event.MarshalMIDI()
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}
+
+func Test_TimeSignatureMetaEvent_UnmarshalMIDI(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b             []byte
+		expectedEvent TimeSignatureMetaEvent
+		err           error
+	}{
+		"numerator is zero": {
+			b:   []byte{0xFF, 0x58, 0x04, 0x00, 0x02, 0x18, 0x08},
+			err: ErrUnmarshallingFile,
+		},
+		"denominator exponent is too large": {
+			b:   []byte{0xFF, 0x58, 0x04, 0x04, 0x08, 0x18, 0x08},
+			err: ErrUnmarshallingFile,
+		},
+		"bytes unmarshal into expected event": {
+			b:             []byte{0xFF, 0x58, 0x04, 0x06, 0x03, 0x24, 0x08},
+			expectedEvent: TimeSignatureMetaEvent{Numerator: 6, Denominator: 8, ClocksPerClick: 36, ThirtySecondNotesPerQuarterNote: 8},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got TimeSignatureMetaEvent
+			err := (&got).UnmarshalMIDI(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(test.expectedEvent, got) {
+				t.Fatalf("expected %+v, got %+v", test.expectedEvent, got)
+			}
+		})
+	}
+}
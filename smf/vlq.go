@@ -0,0 +1,57 @@
+package smf
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidVariableLengthQuantity represents an invalid variable-length quantity.
+	ErrInvalidVariableLengthQuantity error = errors.New("invalid variable-length quantity")
+)
+
+const (
+	// MaxVariableLengthQuantity represents the largest value that a variable-length quantity can hold (four bytes).
+	MaxVariableLengthQuantity uint32 = 0x0FFFFFFF
+
+	// maxVariableLengthQuantityBytes represents the largest number of bytes in a variable-length quantity.
+	maxVariableLengthQuantityBytes int = 4
+)
+
+// ParseVariableLengthQuantity returns the value of the variable-length quantity at the start of b and the number of
+// bytes it occupies. Each byte holds seven bits of the value, most-significant first, and every byte except the last
+// has its MSB set.
+//
+// Example: []byte{0x81, 0x00}
+//
+// The example is the value 128 and occupies two bytes.
+func ParseVariableLengthQuantity(b []byte) (uint32, int, error) {
+	var value uint32
+	for i := 0; i < len(b) && i < maxVariableLengthQuantityBytes; i++ {
+		value = value<<7 | uint32(b[i]&0x7F)
+		if b[i]&0x80 == 0 {
+			return value, i + 1, nil
+		}
+	}
+	if len(b) < maxVariableLengthQuantityBytes {
+		return 0, 0, fmt.Errorf("variable-length quantity is truncated: %w", ErrInvalidVariableLengthQuantity)
+	}
+	return 0, 0, fmt.Errorf("variable-length quantities are made up of at most %d bytes: %w", maxVariableLengthQuantityBytes, ErrInvalidVariableLengthQuantity)
+}
+
+// AppendVariableLengthQuantity appends the variable-length quantity encoding of the value to b.
+func AppendVariableLengthQuantity(b []byte, value uint32) ([]byte, error) {
+	if value > MaxVariableLengthQuantity {
+		return b, fmt.Errorf("variable-length quantities must be at most %#x, received %#x: %w", MaxVariableLengthQuantity, value, ErrInvalidVariableLengthQuantity)
+	}
+
+	// find the highest seven-bit group, then emit groups from most- to least-significant
+	shift := 0
+	for shift < 21 && value>>(shift+7) != 0 {
+		shift += 7
+	}
+	for ; shift > 0; shift -= 7 {
+		b = append(b, byte(value>>shift)&0x7F|0x80)
+	}
+	return append(b, byte(value)&0x7F), nil
+}
@@ -0,0 +1,109 @@
+package smf
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func Test_ParseVariableLengthQuantity(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		b             []byte
+		expectedValue uint32
+		expectedN     int
+		err           error
+	}{
+		"byte slice is empty": {
+			b:   []byte{},
+			err: ErrInvalidVariableLengthQuantity,
+		},
+		"quantity is truncated": {
+			b:   []byte{0x81},
+			err: ErrInvalidVariableLengthQuantity,
+		},
+		"quantity is longer than four bytes": {
+			b:   []byte{0xFF, 0xFF, 0xFF, 0xFF, 0x7F},
+			err: ErrInvalidVariableLengthQuantity,
+		},
+		"single byte quantity": {
+			b:             []byte{0x40, 0x90},
+			expectedValue: 0x40,
+			expectedN:     1,
+		},
+		"two byte quantity": {
+			b:             []byte{0x81, 0x00},
+			expectedValue: 0x80,
+			expectedN:     2,
+		},
+		"largest quantity": {
+			b:             []byte{0xFF, 0xFF, 0xFF, 0x7F},
+			expectedValue: MaxVariableLengthQuantity,
+			expectedN:     4,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, n, err := ParseVariableLengthQuantity(test.b)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if got != test.expectedValue || n != test.expectedN {
+				t.Fatalf("expected %#x (%d bytes), got %#x (%d bytes)", test.expectedValue, test.expectedN, got, n)
+			}
+		})
+	}
+}
+
+func Test_AppendVariableLengthQuantity(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		value    uint32
+		expected []byte
+		err      error
+	}{
+		"value is too large": {
+			value: MaxVariableLengthQuantity + 1,
+			err:   ErrInvalidVariableLengthQuantity,
+		},
+		"zero": {
+			value:    0,
+			expected: []byte{0x00},
+		},
+		"largest single byte value": {
+			value:    0x7F,
+			expected: []byte{0x7F},
+		},
+		"smallest two byte value": {
+			value:    0x80,
+			expected: []byte{0x81, 0x00},
+		},
+		"three byte value": {
+			value:    0x100000,
+			expected: []byte{0xC0, 0x80, 0x00},
+		},
+		"largest value": {
+			value:    MaxVariableLengthQuantity,
+			expected: []byte{0xFF, 0xFF, 0xFF, 0x7F},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := AppendVariableLengthQuantity(nil, test.value)
+			if test.err == nil && err != nil {
+				t.Fatalf("expected nil error, got %v", err)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Fatalf("expected %v error, got %v", test.err, err)
+			}
+			if !bytes.Equal(test.expected, got) {
+				t.Fatalf("expected %#v, got %#v", test.expected, got)
+			}
+		})
+	}
+}